@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+// document is an open text document and the result of its last parse.
+type document struct {
+	uri     string
+	version int
+	text    []byte
+	// lineStarts holds the byte offset of every line of text
+	lineStarts []int
+	result     *parser.ParseResult
+}
+
+func newDocument(uri string, version int, text string) *document {
+	d := &document{uri: uri, version: version}
+	d.setText([]byte(text))
+
+	return d
+}
+
+func (d *document) setText(text []byte) {
+	d.text = text
+	d.lineStarts = append(d.lineStarts[:0], 0)
+	for i, c := range text {
+		if c == '\n' {
+			d.lineStarts = append(d.lineStarts, i+1)
+		}
+	}
+}
+
+// apply applies the changes of a didChange notification in order.
+func (d *document) apply(changes []textDocumentContentChangeEvent) {
+	for _, change := range changes {
+		if change.Range == nil {
+			d.setText([]byte(change.Text))
+			continue
+		}
+
+		start, end := d.offsetAt(change.Range.Start), d.offsetAt(change.Range.End)
+		if end < start {
+			start, end = end, start
+		}
+
+		text := make([]byte, 0, len(d.text)-(end-start)+len(change.Text))
+		text = append(text, d.text[:start]...)
+		text = append(text, change.Text...)
+		text = append(text, d.text[end:]...)
+		d.setText(text)
+	}
+}
+
+// parse parses the current text, keeping the result for later requests.
+func (d *document) parse(ctx context.Context, p *parser.Parser) error {
+	result, err := p.Parse(ctx, d.text)
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %w", d.uri, err)
+	}
+
+	d.result = result
+	return nil
+}
+
+// offsetAt converts an LSP position, counted in UTF-16 code units, to a byte
+// offset. Positions past the end of a line or of the text are clamped.
+func (d *document) offsetAt(pos position) int {
+	if pos.Line < 0 {
+		return 0
+	}
+	if pos.Line >= len(d.lineStarts) {
+		return len(d.text)
+	}
+
+	offset := d.lineStarts[pos.Line]
+	for units := 0; units < pos.Character && offset < len(d.text) && d.text[offset] != '\n'; {
+		r, size := utf8.DecodeRune(d.text[offset:])
+		if r >= 0x10000 {
+			units += 2
+		} else {
+			units++
+		}
+		offset += size
+	}
+
+	return offset
+}
+
+// positionOf converts a byte offset of the parsed source to an LSP position.
+func (d *document) positionOf(offset uint32) position {
+	source := d.result.Source
+	return position{
+		Line:      source.Line(offset) - int(source.StartLine),
+		Character: source.CodeUnitsColumn(offset),
+	}
+}
+
+func (d *document) rangeOf(loc *parser.Location) lspRange {
+	return lspRange{Start: d.positionOf(loc.StartOffset), End: d.positionOf(loc.EndOffset())}
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"sort"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+// diagnostics converts the syntax errors and warnings of the last parse.
+func (d *document) diagnostics() []diagnostic {
+	diagnostics := make([]diagnostic, 0, len(d.result.SynError)+len(d.result.SynWarnings))
+
+	for _, synError := range d.result.SynError {
+		diagnostics = append(diagnostics, diagnostic{
+			Range:    d.rangeOf(synError.Location),
+			Severity: severityError,
+			Code:     synError.Type.String(),
+			Source:   "prism",
+			Message:  synError.Message,
+		})
+	}
+
+	for _, synWarning := range d.result.SynWarnings {
+		diagnostics = append(diagnostics, diagnostic{
+			Range:    d.rangeOf(synWarning.Location),
+			Severity: severityWarning,
+			Code:     synWarning.Type.String(),
+			Source:   "prism",
+			Message:  synWarning.Message,
+		})
+	}
+
+	return diagnostics
+}
+
+type symbolFrame struct {
+	node   parser.Node
+	symbol documentSymbol
+}
+
+// symbols returns the classes, modules, methods and constants of the
+// document, nested as they are in the source.
+func (d *document) symbols() []documentSymbol {
+	roots := []documentSymbol{}
+	var stack []*symbolFrame
+
+	parser.Walk(d.result.Value, func(node parser.Node) parser.WalkAction {
+		if symbol, ok := d.symbolOf(node); ok {
+			stack = append(stack, &symbolFrame{node: node, symbol: symbol})
+		}
+
+		return parser.WalkContinue
+	}, func(node parser.Node) parser.WalkAction {
+		if len(stack) == 0 || stack[len(stack)-1].node != node {
+			return parser.WalkContinue
+		}
+
+		symbol := stack[len(stack)-1].symbol
+		stack = stack[:len(stack)-1]
+
+		if len(stack) == 0 {
+			roots = append(roots, symbol)
+		} else {
+			parent := &stack[len(stack)-1].symbol
+			parent.Children = append(parent.Children, symbol)
+		}
+
+		return parser.WalkContinue
+	})
+
+	return roots
+}
+
+func (d *document) symbolOf(node parser.Node) (documentSymbol, bool) {
+	var symbol documentSymbol
+
+	switch node := node.(type) {
+	case *parser.ClassNode:
+		symbol = documentSymbol{
+			Name:           d.constantName(node.Constantpath, node.Name),
+			Kind:           symbolClass,
+			SelectionRange: d.rangeOf(node.Constantpath.Location()),
+		}
+		if node.Superclass != nil {
+			symbol.Detail = "< " + string(d.result.Source.Slice(node.Superclass.Location()))
+		}
+	case *parser.ModuleNode:
+		symbol = documentSymbol{
+			Name:           d.constantName(node.Constantpath, node.Name),
+			Kind:           symbolModule,
+			SelectionRange: d.rangeOf(node.Constantpath.Location()),
+		}
+	case *parser.DefNode:
+		name := node.Name
+		if node.Receiver != nil {
+			name = string(d.result.Source.Slice(node.Receiver.Location())) + "." + name
+		}
+
+		symbol = documentSymbol{
+			Name:           name,
+			Kind:           symbolMethod,
+			SelectionRange: d.rangeOf(node.Nameloc),
+		}
+	case *parser.ConstantWriteNode:
+		symbol = documentSymbol{
+			Name:           node.Name,
+			Kind:           symbolConstant,
+			SelectionRange: d.rangeOf(node.Nameloc),
+		}
+	default:
+		return documentSymbol{}, false
+	}
+
+	symbol.Range = d.rangeOf(node.Location())
+	return symbol, true
+}
+
+// constantName spells the constant path of a class or module as written,
+// falling back to the name prism found when the path is missing.
+func (d *document) constantName(path parser.Node, name string) string {
+	if written := d.result.Source.Slice(path.Location()); len(written) > 0 {
+		return string(written)
+	}
+
+	if name != "" {
+		return name
+	}
+
+	return "<missing>"
+}
+
+// foldingRanges folds every node spanning several lines, leaving its last
+// line visible so that closing keywords and brackets stay on screen, and
+// every run of comments on consecutive lines.
+func (d *document) foldingRanges() []foldingRange {
+	// widest range starting on each line
+	ends := map[int]foldingRange{}
+	add := func(r foldingRange) {
+		if r.EndLine <= r.StartLine {
+			return
+		}
+
+		if current, ok := ends[r.StartLine]; !ok || r.EndLine > current.EndLine {
+			ends[r.StartLine] = r
+		}
+	}
+
+	parser.Inspect(d.result.Value, func(node parser.Node) bool {
+		switch node.(type) {
+		case *parser.ProgramNode, *parser.StatementsNode:
+			return true
+		}
+
+		loc := node.Location()
+		add(foldingRange{StartLine: d.positionOf(loc.StartOffset).Line, EndLine: d.positionOf(loc.EndOffset()).Line - 1})
+		return true
+	})
+
+	start, end := -1, -1
+	for _, comment := range d.result.Comments {
+		line, last := d.positionOf(comment.Loc.StartOffset).Line, d.lastLineOf(comment.Loc)
+		if start >= 0 && line == end+1 {
+			end = last
+			continue
+		}
+
+		add(foldingRange{StartLine: start, EndLine: end, Kind: "comment"})
+		start, end = line, last
+	}
+	add(foldingRange{StartLine: start, EndLine: end, Kind: "comment"})
+
+	ranges := make([]foldingRange, 0, len(ends))
+	for _, r := range ends {
+		ranges = append(ranges, r)
+	}
+
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].StartLine < ranges[j].StartLine
+	})
+
+	return ranges
+}
+
+// lastLineOf returns the line of the last character of loc, which differs
+// from the line of its end when loc ends with a newline.
+func (d *document) lastLineOf(loc *parser.Location) int {
+	if loc.Length == 0 {
+		return d.positionOf(loc.StartOffset).Line
+	}
+
+	return d.positionOf(loc.EndOffset() - 1).Line
+}
+
+// selectionRange expands the selection at pos through the nodes covering
+// it, from the innermost one outwards. Nodes covering the same range as
+// their parent are skipped, as are parents that do not cover pos, like the
+// call around a heredoc body.
+func (d *document) selectionRange(pos position) selectionRange {
+	offset := uint32(d.offsetAt(pos))
+
+	var current *selectionRange
+	for _, node := range d.result.NodesCovering(offset) {
+		r := d.rangeOf(node.Location())
+		if current != nil && current.Range == r {
+			continue
+		}
+
+		current = &selectionRange{Range: r, Parent: current}
+	}
+
+	if current == nil {
+		at := d.positionOf(offset)
+		return selectionRange{Range: lspRange{Start: at, End: at}}
+	}
+
+	return *current
+}
@@ -0,0 +1,38 @@
+// Command rbprism-lsp is a language server for ruby speaking LSP over stdio.
+// It parses documents with prism as they are opened and edited, without
+// needing ruby installed, and provides:
+//
+//   - syntax errors and warnings as diagnostics
+//   - document symbols for classes, modules, methods and constants
+//   - folding ranges for multi-line nodes and comment blocks
+//   - selection ranges expanding through the enclosing nodes
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "rbprism-lsp: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context) error {
+	p, err := parser.NewParser(ctx)
+	if err != nil {
+		return err
+	}
+	defer p.Close(ctx)
+
+	return newServer(p, os.Stdin, os.Stdout, os.Stderr).serve(ctx)
+}
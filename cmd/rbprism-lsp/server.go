@@ -0,0 +1,271 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+// errExitWithoutShutdown is returned by serve when the client sends exit
+// without asking the server to shut down first.
+var errExitWithoutShutdown = errors.New("exit notification received before shutdown")
+
+type requestHandler func(s *server, ctx context.Context, params json.RawMessage) (interface{}, *responseError)
+
+type notificationHandler func(s *server, ctx context.Context, params json.RawMessage) error
+
+var requestHandlers = map[string]requestHandler{
+	"initialize":                  (*server).initialize,
+	"shutdown":                    (*server).shutdown,
+	"textDocument/documentSymbol": (*server).documentSymbol,
+	"textDocument/foldingRange":   (*server).foldingRange,
+	"textDocument/selectionRange": (*server).selectionRange,
+}
+
+var notificationHandlers = map[string]notificationHandler{
+	"initialized":            nil,
+	"textDocument/didOpen":   (*server).didOpen,
+	"textDocument/didChange": (*server).didChange,
+	"textDocument/didClose":  (*server).didClose,
+}
+
+// server handles the messages of one client, one at a time, re-parsing
+// documents whenever they change.
+type server struct {
+	conn   *conn
+	parser *parser.Parser
+	log    io.Writer
+
+	documents   map[string]*document
+	initialized bool
+	shutDown    bool
+}
+
+func newServer(p *parser.Parser, r io.Reader, w io.Writer, log io.Writer) *server {
+	return &server{
+		conn:      newConn(r, w),
+		parser:    p,
+		log:       log,
+		documents: map[string]*document{},
+	}
+}
+
+// serve handles messages until the client sends exit or closes the stream.
+func (s *server) serve(ctx context.Context) error {
+	for {
+		msg, err := s.conn.read()
+
+		var rerr *responseError
+		switch {
+		case errors.As(err, &rerr):
+			if err := s.conn.reply(nil, nil, rerr); err != nil {
+				return err
+			}
+			continue
+		case errors.Is(err, io.EOF):
+			return nil
+		case err != nil:
+			return err
+		}
+
+		if msg.Method == "exit" {
+			if !s.shutDown {
+				return errExitWithoutShutdown
+			}
+
+			return nil
+		}
+
+		if err := s.handle(ctx, msg); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *server) handle(ctx context.Context, msg *message) error {
+	// responses to requests the server never sends
+	if msg.Method == "" {
+		return nil
+	}
+
+	if msg.isNotification() {
+		handler, ok := notificationHandlers[msg.Method]
+		if !ok || handler == nil || !s.initialized {
+			return nil
+		}
+
+		if err := handler(s, ctx, msg.Params); err != nil {
+			fmt.Fprintf(s.log, "rbprism-lsp: %s: %s\n", msg.Method, err)
+		}
+
+		return nil
+	}
+
+	handler, ok := requestHandlers[msg.Method]
+
+	var result interface{}
+	var rerr *responseError
+	switch {
+	case !ok:
+		rerr = &responseError{Code: codeMethodNotFound, Message: fmt.Sprintf("method not found: %s", msg.Method)}
+	case !s.initialized && msg.Method != "initialize":
+		rerr = &responseError{Code: codeServerNotInitialized, Message: "server not initialized"}
+	case s.shutDown:
+		rerr = &responseError{Code: codeInvalidRequest, Message: "server is shutting down"}
+	default:
+		result, rerr = handler(s, ctx, msg.Params)
+	}
+
+	return s.conn.reply(msg.ID, result, rerr)
+}
+
+func (s *server) initialize(_ context.Context, _ json.RawMessage) (interface{}, *responseError) {
+	if s.initialized {
+		return nil, &responseError{Code: codeInvalidRequest, Message: "server already initialized"}
+	}
+
+	s.initialized = true
+
+	return initializeResult{
+		Capabilities: serverCapabilities{
+			PositionEncoding: "utf-16",
+			TextDocumentSync: textDocumentSyncOptions{
+				OpenClose: true,
+				Change:    syncIncremental,
+			},
+			DocumentSymbolProvider: true,
+			FoldingRangeProvider:   true,
+			SelectionRangeProvider: true,
+		},
+		ServerInfo: serverInfo{Name: "rbprism-lsp"},
+	}, nil
+}
+
+func (s *server) shutdown(_ context.Context, _ json.RawMessage) (interface{}, *responseError) {
+	s.shutDown = true
+	s.documents = map[string]*document{}
+
+	return nil, nil
+}
+
+func (s *server) didOpen(ctx context.Context, params json.RawMessage) error {
+	var p didOpenTextDocumentParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+
+	d := newDocument(p.TextDocument.URI, p.TextDocument.Version, p.TextDocument.Text)
+	s.documents[d.uri] = d
+
+	return s.update(ctx, d)
+}
+
+func (s *server) didChange(ctx context.Context, params json.RawMessage) error {
+	var p didChangeTextDocumentParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+
+	d, ok := s.documents[p.TextDocument.URI]
+	if !ok {
+		return fmt.Errorf("unknown document %s", p.TextDocument.URI)
+	}
+
+	d.version = p.TextDocument.Version
+	d.apply(p.ContentChanges)
+
+	return s.update(ctx, d)
+}
+
+func (s *server) didClose(_ context.Context, params json.RawMessage) error {
+	var p didCloseTextDocumentParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+
+	delete(s.documents, p.TextDocument.URI)
+
+	// clear the diagnostics of the closed document
+	return s.conn.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+		URI:         p.TextDocument.URI,
+		Diagnostics: []diagnostic{},
+	})
+}
+
+// update re-parses d and publishes its diagnostics.
+func (s *server) update(ctx context.Context, d *document) error {
+	if err := d.parse(ctx, s.parser); err != nil {
+		return err
+	}
+
+	return s.conn.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+		URI:         d.uri,
+		Version:     d.version,
+		Diagnostics: d.diagnostics(),
+	})
+}
+
+// document returns the parsed document at uri.
+func (s *server) document(uri string) (*document, *responseError) {
+	d, ok := s.documents[uri]
+	if !ok || d.result == nil {
+		return nil, &responseError{Code: codeInvalidParams, Message: fmt.Sprintf("unknown document %s", uri)}
+	}
+
+	return d, nil
+}
+
+func invalidParams(err error) *responseError {
+	return &responseError{Code: codeInvalidParams, Message: err.Error()}
+}
+
+func (s *server) documentSymbol(_ context.Context, params json.RawMessage) (interface{}, *responseError) {
+	var p documentSymbolParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, invalidParams(err)
+	}
+
+	d, rerr := s.document(p.TextDocument.URI)
+	if rerr != nil {
+		return nil, rerr
+	}
+
+	return d.symbols(), nil
+}
+
+func (s *server) foldingRange(_ context.Context, params json.RawMessage) (interface{}, *responseError) {
+	var p foldingRangeParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, invalidParams(err)
+	}
+
+	d, rerr := s.document(p.TextDocument.URI)
+	if rerr != nil {
+		return nil, rerr
+	}
+
+	return d.foldingRanges(), nil
+}
+
+func (s *server) selectionRange(_ context.Context, params json.RawMessage) (interface{}, *responseError) {
+	var p selectionRangeParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, invalidParams(err)
+	}
+
+	d, rerr := s.document(p.TextDocument.URI)
+	if rerr != nil {
+		return nil, rerr
+	}
+
+	ranges := make([]selectionRange, 0, len(p.Positions))
+	for _, pos := range p.Positions {
+		ranges = append(ranges, d.selectionRange(pos))
+	}
+
+	return ranges, nil
+}
@@ -0,0 +1,366 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+// client drives a server running in process over pipes, like an editor
+// would over stdio.
+type client struct {
+	t      *testing.T
+	conn   *conn
+	nextID int
+	done   chan error
+}
+
+func newClient(t *testing.T) *client {
+	t.Helper()
+
+	ctx := context.Background()
+	p, err := parser.NewParser(ctx)
+	if err != nil {
+		t.Fatalf("failed to create parser: %s", err)
+	}
+	t.Cleanup(func() { p.Close(ctx) })
+
+	serverIn, clientOut := io.Pipe()
+	clientIn, serverOut := io.Pipe()
+
+	c := &client{t: t, conn: newConn(clientIn, clientOut), done: make(chan error, 1)}
+
+	go func() {
+		c.done <- newServer(p, serverIn, serverOut, io.Discard).serve(ctx)
+		serverOut.Close()
+	}()
+
+	t.Cleanup(func() { clientOut.Close() })
+
+	return c
+}
+
+func (c *client) initialize() {
+	c.t.Helper()
+
+	var result initializeResult
+	if err := c.call("initialize", map[string]interface{}{"capabilities": map[string]interface{}{}}, &result); err != nil {
+		c.t.Fatalf("failed to initialize: %s", err)
+	}
+
+	if !result.Capabilities.DocumentSymbolProvider || result.Capabilities.PositionEncoding != "utf-16" {
+		c.t.Fatalf("unexpected capabilities %+v", result.Capabilities)
+	}
+
+	c.notify("initialized", struct{}{})
+}
+
+// call sends a request and decodes its result, failing on notifications
+// that arrive in between.
+func (c *client) call(method string, params interface{}, result interface{}) *responseError {
+	c.t.Helper()
+
+	c.nextID++
+	id := json.RawMessage(mustMarshal(c.t, c.nextID))
+	if err := c.conn.write(&message{ID: &id, Method: method, Params: mustMarshal(c.t, params)}); err != nil {
+		c.t.Fatalf("failed to send %s: %s", method, err)
+	}
+
+	msg := c.read()
+	if msg.ID == nil || string(*msg.ID) != string(id) {
+		c.t.Fatalf("expected the response to %s, got %+v", method, msg)
+	}
+
+	if msg.Error != nil {
+		return msg.Error
+	}
+
+	if result != nil {
+		if err := json.Unmarshal(msg.Result, result); err != nil {
+			c.t.Fatalf("failed to decode the result of %s: %s", method, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *client) notify(method string, params interface{}) {
+	c.t.Helper()
+
+	if err := c.conn.notify(method, params); err != nil {
+		c.t.Fatalf("failed to send %s: %s", method, err)
+	}
+}
+
+// diagnostics sends a notification and returns the diagnostics the server
+// publishes in answer.
+func (c *client) diagnostics(method string, params interface{}) publishDiagnosticsParams {
+	c.t.Helper()
+
+	c.notify(method, params)
+
+	msg := c.read()
+	if msg.Method != "textDocument/publishDiagnostics" {
+		c.t.Fatalf("expected diagnostics, got %+v", msg)
+	}
+
+	var published publishDiagnosticsParams
+	if err := json.Unmarshal(msg.Params, &published); err != nil {
+		c.t.Fatalf("failed to decode diagnostics: %s", err)
+	}
+
+	return published
+}
+
+func (c *client) open(uri string, text string) publishDiagnosticsParams {
+	c.t.Helper()
+
+	return c.diagnostics("textDocument/didOpen", didOpenTextDocumentParams{
+		TextDocument: textDocumentItem{URI: uri, LanguageID: "ruby", Version: 1, Text: text},
+	})
+}
+
+func (c *client) read() *message {
+	c.t.Helper()
+
+	msg, err := c.conn.read()
+	if err != nil {
+		c.t.Fatalf("failed to read message: %s", err)
+	}
+
+	return msg
+}
+
+func (c *client) shutdown() {
+	c.t.Helper()
+
+	if err := c.call("shutdown", nil, nil); err != nil {
+		c.t.Fatalf("failed to shut down: %s", err)
+	}
+
+	c.notify("exit", nil)
+
+	if err := <-c.done; err != nil {
+		c.t.Fatalf("expected a clean exit, got %s", err)
+	}
+}
+
+func mustMarshal(t *testing.T, v interface{}) json.RawMessage {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+func textDocument(uri string) textDocumentIdentifier {
+	return textDocumentIdentifier{URI: uri}
+}
+
+func TestDiagnosticsFollowChanges(t *testing.T) {
+	c := newClient(t)
+	c.initialize()
+
+	const uri = "file:///app.rb"
+	published := c.open(uri, "x = \"😀\"; foo(\n")
+
+	if published.URI != uri || published.Version != 1 || len(published.Diagnostics) == 0 {
+		t.Fatalf("expected errors for version 1, got %+v", published)
+	}
+
+	// the emoji takes two UTF-16 code units, so foo( ends at 14
+	expected := lspRange{Start: position{0, 14}, End: position{0, 14}}
+	if first := published.Diagnostics[0]; first.Range != expected || first.Severity != severityError || first.Source != "prism" {
+		t.Errorf("expected an error at %+v, got %+v", expected, first)
+	}
+
+	published = c.diagnostics("textDocument/didChange", didChangeTextDocumentParams{
+		TextDocument: versionedTextDocumentIdentifier{URI: uri, Version: 2},
+		ContentChanges: []textDocumentContentChangeEvent{
+			{Range: &lspRange{Start: position{0, 14}, End: position{0, 14}}, Text: ")"},
+		},
+	})
+
+	if published.Version != 2 || len(published.Diagnostics) != 0 {
+		t.Errorf("expected no diagnostics for version 2, got %+v", published)
+	}
+
+	published = c.diagnostics("textDocument/didChange", didChangeTextDocumentParams{
+		TextDocument:   versionedTextDocumentIdentifier{URI: uri, Version: 3},
+		ContentChanges: []textDocumentContentChangeEvent{{Text: "foo -1\n"}},
+	})
+
+	if published.Version != 3 || len(published.Diagnostics) != 1 || published.Diagnostics[0].Severity != severityWarning {
+		t.Errorf("expected the ambiguous argument warning for version 3, got %+v", published)
+	}
+
+	published = c.diagnostics("textDocument/didClose", didCloseTextDocumentParams{TextDocument: textDocument(uri)})
+	if len(published.Diagnostics) != 0 {
+		t.Errorf("expected the diagnostics to be cleared, got %+v", published)
+	}
+
+	c.shutdown()
+}
+
+const symbolsSource = `module Shop
+  VERSION = "1.0"
+
+  class Cart < Base
+    def add(item)
+      items << item
+    end
+
+    def self.build = new
+  end
+end
+`
+
+func TestDocumentSymbol(t *testing.T) {
+	c := newClient(t)
+	c.initialize()
+	c.open("file:///shop.rb", symbolsSource)
+
+	var symbols []documentSymbol
+	if err := c.call("textDocument/documentSymbol", documentSymbolParams{TextDocument: textDocument("file:///shop.rb")}, &symbols); err != nil {
+		t.Fatalf("failed to get symbols: %s", err)
+	}
+
+	expected := []documentSymbol{{
+		Name:           "Shop",
+		Kind:           symbolModule,
+		Range:          lspRange{Start: position{0, 0}, End: position{10, 3}},
+		SelectionRange: lspRange{Start: position{0, 7}, End: position{0, 11}},
+		Children: []documentSymbol{
+			{
+				Name:           "VERSION",
+				Kind:           symbolConstant,
+				Range:          lspRange{Start: position{1, 2}, End: position{1, 17}},
+				SelectionRange: lspRange{Start: position{1, 2}, End: position{1, 9}},
+			},
+			{
+				Name:           "Cart",
+				Detail:         "< Base",
+				Kind:           symbolClass,
+				Range:          lspRange{Start: position{3, 2}, End: position{9, 5}},
+				SelectionRange: lspRange{Start: position{3, 8}, End: position{3, 12}},
+				Children: []documentSymbol{
+					{
+						Name:           "add",
+						Kind:           symbolMethod,
+						Range:          lspRange{Start: position{4, 4}, End: position{6, 7}},
+						SelectionRange: lspRange{Start: position{4, 8}, End: position{4, 11}},
+					},
+					{
+						Name:           "self.build",
+						Kind:           symbolMethod,
+						Range:          lspRange{Start: position{8, 4}, End: position{8, 24}},
+						SelectionRange: lspRange{Start: position{8, 13}, End: position{8, 18}},
+					},
+				},
+			},
+		},
+	}}
+
+	if !reflect.DeepEqual(symbols, expected) {
+		t.Errorf("expected symbols\n%+v\ngot\n%+v", expected, symbols)
+	}
+
+	c.shutdown()
+}
+
+func TestFoldingRange(t *testing.T) {
+	c := newClient(t)
+	c.initialize()
+	c.open("file:///shop.rb", "# one\n# two\n# three\n"+symbolsSource)
+
+	var ranges []foldingRange
+	if err := c.call("textDocument/foldingRange", foldingRangeParams{TextDocument: textDocument("file:///shop.rb")}, &ranges); err != nil {
+		t.Fatalf("failed to get folding ranges: %s", err)
+	}
+
+	expected := []foldingRange{
+		{StartLine: 0, EndLine: 2, Kind: "comment"},
+		{StartLine: 3, EndLine: 12},
+		{StartLine: 6, EndLine: 11},
+		{StartLine: 7, EndLine: 8},
+	}
+
+	if !reflect.DeepEqual(ranges, expected) {
+		t.Errorf("expected folding ranges %+v, got %+v", expected, ranges)
+	}
+
+	c.shutdown()
+}
+
+func TestSelectionRange(t *testing.T) {
+	c := newClient(t)
+	c.initialize()
+	c.open("file:///calc.rb", "def sum(a) = a + 1\n")
+
+	var ranges []selectionRange
+	err := c.call("textDocument/selectionRange", selectionRangeParams{
+		TextDocument: textDocument("file:///calc.rb"),
+		Positions:    []position{{0, 17}},
+	}, &ranges)
+	if err != nil {
+		t.Fatalf("failed to get selection ranges: %s", err)
+	}
+
+	if len(ranges) != 1 {
+		t.Fatalf("expected one selection range, got %d", len(ranges))
+	}
+
+	var got []lspRange
+	for r := &ranges[0]; r != nil; r = r.Parent {
+		got = append(got, r.Range)
+	}
+
+	expected := []lspRange{
+		{Start: position{0, 17}, End: position{0, 18}}, // 1
+		{Start: position{0, 13}, End: position{0, 18}}, // a + 1
+		{Start: position{0, 0}, End: position{0, 18}},  // def
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected selection ranges %+v, got %+v", expected, got)
+	}
+
+	c.shutdown()
+}
+
+func TestRequestErrors(t *testing.T) {
+	c := newClient(t)
+
+	if err := c.call("textDocument/documentSymbol", documentSymbolParams{}, nil); err == nil || err.Code != codeServerNotInitialized {
+		t.Errorf("expected a not initialized error, got %v", err)
+	}
+
+	c.initialize()
+
+	if err := c.call("textDocument/hover", struct{}{}, nil); err == nil || err.Code != codeMethodNotFound {
+		t.Errorf("expected a method not found error, got %v", err)
+	}
+
+	if err := c.call("textDocument/foldingRange", foldingRangeParams{TextDocument: textDocument("file:///missing.rb")}, nil); err == nil || err.Code != codeInvalidParams {
+		t.Errorf("expected an invalid params error, got %v", err)
+	}
+
+	c.shutdown()
+}
+
+func TestExitWithoutShutdown(t *testing.T) {
+	c := newClient(t)
+	c.initialize()
+	c.notify("exit", nil)
+
+	if err := <-c.done; err != errExitWithoutShutdown {
+		t.Errorf("expected %v, got %v", errExitWithoutShutdown, err)
+	}
+}
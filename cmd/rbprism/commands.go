@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+// parseFlags parses the flags of a command and collects its inputs, printing
+// the problem and returning false if either fails.
+func parseFlags(env *env, fs *flag.FlagSet, args []string) ([]input, bool) {
+	if err := fs.Parse(args); err != nil {
+		return nil, false
+	}
+
+	inputs, err := collectInputs(env, fs.Args())
+	if err != nil {
+		fmt.Fprintf(env.stderr, "rbprism: %s\n", err)
+		return nil, false
+	}
+
+	return inputs, true
+}
+
+func runParse(ctx context.Context, env *env, args []string) int {
+	fs, flags := newFlagSet(env, "parse", "Prints the syntax errors and warnings of every source with the lines they\nare on, and fails if a source has syntax errors.")
+	color := fs.Bool("color", false, "highlight the diagnostics with ANSI escape sequences")
+	warnings := fs.Bool("warnings", true, "print warnings as well as errors")
+
+	inputs, ok := parseFlags(env, fs, args)
+	if !ok {
+		return exitFailure
+	}
+
+	return process(ctx, env, flags, inputs, func(ctx context.Context, pool *parser.ParserPool, _ int, in input, source []byte, opts []parser.ParseOption, w io.Writer) (bool, error) {
+		result, err := pool.Parse(ctx, source, opts...)
+		if err != nil {
+			return false, err
+		}
+
+		if !*warnings {
+			result.SynWarnings = nil
+		}
+
+		renderer := &parser.DiagnosticRenderer{Filename: in.name(), Color: *color}
+		if err := renderer.Render(w, result); err != nil {
+			return false, err
+		}
+
+		return len(result.SynError) > 0, nil
+	})
+}
+
+func runCheck(ctx context.Context, env *env, args []string) int {
+	fs, flags := newFlagSet(env, "check", "Lists the syntax errors of every source, one per line, and fails if a\nsource has syntax errors. The jsonl and sarif formats include warnings.")
+	format := fs.String("format", "text", "output format, text, jsonl or sarif")
+	warnings := fs.Bool("warnings", false, "list warnings as well as errors in the text format")
+
+	inputs, ok := parseFlags(env, fs, args)
+	if !ok {
+		return exitFailure
+	}
+
+	switch *format {
+	case "text", "jsonl", "sarif":
+	default:
+		fmt.Fprintf(env.stderr, "rbprism: unknown format %q\n", *format)
+		return exitFailure
+	}
+
+	// SARIF holds all results in a single log written at the end
+	var mu sync.Mutex
+	files := make([]parser.FileResult, len(inputs))
+
+	status := process(ctx, env, flags, inputs, func(ctx context.Context, pool *parser.ParserPool, index int, in input, source []byte, opts []parser.ParseOption, w io.Writer) (bool, error) {
+		result, err := pool.Parse(ctx, source, opts...)
+		if err != nil {
+			return false, err
+		}
+
+		file := parser.FileResult{Path: in.name(), Result: result}
+
+		switch *format {
+		case "text":
+			for _, synError := range result.SynError {
+				printDiagnostic(w, file, "error", synError.Location, synError.Message)
+			}
+
+			if *warnings {
+				for _, synWarning := range result.SynWarnings {
+					printDiagnostic(w, file, "warning", synWarning.Location, synWarning.Message)
+				}
+			}
+		case "jsonl":
+			if err := parser.ExportJSONLines(w, file); err != nil {
+				return false, err
+			}
+		case "sarif":
+			mu.Lock()
+			files[index] = file
+			mu.Unlock()
+		}
+
+		return len(result.SynError) > 0, nil
+	})
+
+	if *format == "sarif" && status != exitFailure {
+		if err := parser.ExportSARIF(env.stdout, files...); err != nil {
+			fmt.Fprintf(env.stderr, "rbprism: %s\n", err)
+			return exitFailure
+		}
+	}
+
+	return status
+}
+
+func printDiagnostic(w io.Writer, file parser.FileResult, severity string, loc *parser.Location, message string) {
+	source := file.Result.Source
+	fmt.Fprintf(w, "%s:%d:%d: %s: %s\n", file.Path, source.StartLineOf(loc), source.StartCharacterColumnOf(loc)+1, severity, message)
+}
+
+func runDump(ctx context.Context, env *env, args []string) int {
+	fs, flags := newFlagSet(env, "dump", "Prints the tree of every source. The json format prints one object per\nline holding the path and the parse result.")
+	format := fs.String("format", "inspect", "output format, json, inspect, dot or mermaid")
+	collapse := fs.Bool("collapse", false, "leave StatementsNode and ArgumentsNode out of dot and mermaid graphs")
+
+	inputs, ok := parseFlags(env, fs, args)
+	if !ok {
+		return exitFailure
+	}
+
+	switch *format {
+	case "json", "inspect", "dot", "mermaid":
+	default:
+		fmt.Fprintf(env.stderr, "rbprism: unknown format %q\n", *format)
+		return exitFailure
+	}
+
+	return process(ctx, env, flags, inputs, func(ctx context.Context, pool *parser.ParserPool, _ int, in input, source []byte, opts []parser.ParseOption, w io.Writer) (bool, error) {
+		result, err := pool.Parse(ctx, source, opts...)
+		if err != nil {
+			return false, err
+		}
+
+		if *format == "json" {
+			encoder := json.NewEncoder(w)
+			encoder.SetEscapeHTML(false)
+
+			return false, encoder.Encode(map[string]interface{}{
+				"path":   in.name(),
+				"result": result,
+			})
+		}
+
+		header(w, len(inputs), in)
+
+		switch *format {
+		case "dot":
+			err = parser.ExportDOT(w, result.Value, parser.WithCollapsedWrappers(*collapse))
+		case "mermaid":
+			err = parser.ExportMermaid(w, result.Value, parser.WithCollapsedWrappers(*collapse))
+		default:
+			_, err = io.WriteString(w, result.Inspect())
+		}
+
+		return false, err
+	})
+}
+
+func runTokens(ctx context.Context, env *env, args []string) int {
+	fs, flags := newFlagSet(env, "tokens", "Prints the tokens of every source, one per line with their location,\ntype, text and the lexer state after them.")
+
+	inputs, ok := parseFlags(env, fs, args)
+	if !ok {
+		return exitFailure
+	}
+
+	return process(ctx, env, flags, inputs, func(ctx context.Context, pool *parser.ParserPool, _ int, in input, source []byte, opts []parser.ParseOption, w io.Writer) (bool, error) {
+		result, err := pool.Lex(ctx, source, opts...)
+		if err != nil {
+			return false, err
+		}
+
+		header(w, len(inputs), in)
+
+		for _, token := range result.Tokens {
+			loc := token.Loc
+			text := source[loc.StartOffset:loc.EndOffset()]
+
+			fmt.Fprintf(w, "%d:%d-%d:%d\t%s\t%s\t%s\n",
+				result.Source.Line(loc.StartOffset), result.Source.Column(loc.StartOffset),
+				result.Source.Line(loc.EndOffset()), result.Source.Column(loc.EndOffset()),
+				token.Type, strconv.Quote(string(text)), token.LexState,
+			)
+		}
+
+		return false, nil
+	})
+}
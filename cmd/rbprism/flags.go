@@ -0,0 +1,110 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+// commonFlags are the flags every command accepts: the parse options and the
+// number of sources parsed in parallel.
+type commonFlags struct {
+	jobs                int
+	line                int
+	encoding            string
+	frozenStringLiteral bool
+	commandLine         string
+	version             string
+	scopes              scopesFlag
+}
+
+// scopesFlag collects one scope per occurrence of the flag, each a comma
+// separated list of local variable names.
+type scopesFlag [][]string
+
+func (s *scopesFlag) String() string {
+	scopes := make([]string, 0, len(*s))
+	for _, scope := range *s {
+		scopes = append(scopes, strings.Join(scope, ","))
+	}
+
+	return strings.Join(scopes, " ")
+}
+
+func (s *scopesFlag) Set(value string) error {
+	*s = append(*s, strings.Split(value, ","))
+	return nil
+}
+
+var commandLineFlags = map[rune]parser.CommandLineFlag{
+	'a': parser.CommandLineA,
+	'e': parser.CommandLineE,
+	'l': parser.CommandLineL,
+	'n': parser.CommandLineN,
+	'p': parser.CommandLineP,
+}
+
+func newFlagSet(env *env, name string, description string) (*flag.FlagSet, *commonFlags) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(env.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: rbprism %s [flags] [path ...]\n\n%s\n\nFlags:\n", name, description)
+		fs.PrintDefaults()
+	}
+
+	f := &commonFlags{}
+	fs.IntVar(&f.jobs, "j", runtime.NumCPU(), "number of sources parsed in parallel")
+	fs.IntVar(&f.line, "line", 1, "line number the sources start at")
+	fs.StringVar(&f.encoding, "encoding", "", "encoding the sources are assumed to be in, e.g. ASCII-8BIT")
+	fs.BoolVar(&f.frozenStringLiteral, "frozen-string-literal", false, "parse as if the sources started with frozen_string_literal: true")
+	fs.StringVar(&f.commandLine, "command-line", "", "ruby command line switches the sources run with, any of `aelnp`")
+	fs.StringVar(&f.version, "ruby-version", "latest", "ruby version whose syntax the sources are parsed with, latest or 3.3.0")
+	fs.Var(&f.scopes, "scope", "comma separated locals in scope around the sources, repeat for nested scopes")
+
+	return fs, f
+}
+
+// parseOptions returns the parse options for the source at path, "" for
+// stdin, or the error of the first invalid one.
+func (f *commonFlags) parseOptions(path string) ([]parser.ParseOption, error) {
+	opts := []parser.ParseOption{
+		parser.WithStartLine(f.line),
+		parser.WithFrozenStringLiteral(f.frozenStringLiteral),
+		parser.WithVersion(f.version),
+	}
+
+	if path != "" {
+		opts = append(opts, parser.WithFilepath(path))
+	}
+
+	if f.encoding != "" {
+		opts = append(opts, parser.WithEncoding(f.encoding))
+	}
+
+	if f.commandLine != "" {
+		switches := make([]parser.CommandLineFlag, 0, len(f.commandLine))
+		for _, c := range f.commandLine {
+			flag, ok := commandLineFlags[c]
+			if !ok {
+				return nil, fmt.Errorf("unknown command line switch %q", c)
+			}
+
+			switches = append(switches, flag)
+		}
+
+		opts = append(opts, parser.WithCommandLine(switches...))
+	}
+
+	if len(f.scopes) > 0 {
+		opts = append(opts, parser.WithScopes(f.scopes...))
+	}
+
+	if err := parser.CheckParseOptions(opts...); err != nil {
+		return nil, err
+	}
+
+	return opts, nil
+}
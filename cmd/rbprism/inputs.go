@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const stdinName = "<stdin>"
+
+// input is a source to parse, a file or stdin.
+type input struct {
+	// path is the file to read, empty for stdin
+	path   string
+	source []byte
+}
+
+func (in input) name() string {
+	if in.path == "" {
+		return stdinName
+	}
+
+	return in.path
+}
+
+func (in input) read() ([]byte, error) {
+	if in.path == "" {
+		return in.source, nil
+	}
+
+	source, err := os.ReadFile(in.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", in.path, err)
+	}
+
+	return source, nil
+}
+
+var rubyExtensions = map[string]bool{
+	".rb":      true,
+	".rake":    true,
+	".gemspec": true,
+	".ru":      true,
+}
+
+var rubyFileNames = map[string]bool{
+	"Gemfile":  true,
+	"Rakefile": true,
+}
+
+func isRubyFile(path string) bool {
+	return rubyExtensions[filepath.Ext(path)] || rubyFileNames[filepath.Base(path)]
+}
+
+// collectInputs expands args into the sources to parse. Files are taken as
+// they are, directories are searched for ruby files, skipping hidden ones.
+func collectInputs(env *env, args []string) ([]input, error) {
+	if len(args) == 0 {
+		args = []string{"-"}
+	}
+
+	var stdin []byte
+	inputs := make([]input, 0, len(args))
+
+	for _, arg := range args {
+		if arg == "-" {
+			if stdin == nil {
+				source, err := io.ReadAll(env.stdin)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read stdin: %w", err)
+				}
+
+				stdin = source
+			}
+
+			inputs = append(inputs, input{source: stdin})
+			continue
+		}
+
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			inputs = append(inputs, input{path: arg})
+			continue
+		}
+
+		err = filepath.WalkDir(arg, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			hidden := path != arg && strings.HasPrefix(entry.Name(), ".")
+
+			if entry.IsDir() {
+				if hidden {
+					return filepath.SkipDir
+				}
+
+				return nil
+			}
+
+			if !hidden && entry.Type().IsRegular() && isRubyFile(path) {
+				inputs = append(inputs, input{path: path})
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to search %s: %w", arg, err)
+		}
+	}
+
+	return inputs, nil
+}
@@ -0,0 +1,88 @@
+// Command rbprism parses ruby files with prism and prints what it found.
+//
+//	rbprism parse [flags] [path ...]
+//	rbprism check [flags] [path ...]
+//	rbprism dump [flags] [path ...]
+//	rbprism tokens [flags] [path ...]
+//
+// Paths are files or directories, which are searched for ruby files. Without
+// paths, or with "-", the source is read from stdin.
+//
+// The exit status is 0 if every source parsed without errors, 1 if one of
+// them has syntax errors and 2 if the sources couldn't be read or parsed.
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+)
+
+const (
+	exitOK          = 0
+	exitSyntaxError = 1
+	exitFailure     = 2
+)
+
+const usage = `usage: rbprism <command> [flags] [path ...]
+
+Commands:
+  parse   print the syntax errors and warnings of every source with excerpts
+  check   list the syntax errors of every source, as text, JSON lines or SARIF
+  dump    print the tree of every source as JSON, inspect output, DOT or Mermaid
+  tokens  print the tokens of every source
+
+Paths are files or directories searched for ruby files. Without paths, or
+with "-", the source is read from stdin. Run rbprism <command> -h for the
+flags of a command.
+`
+
+type command struct {
+	name string
+	run  func(ctx context.Context, env *env, args []string) int
+}
+
+var commands = []command{
+	{name: "parse", run: runParse},
+	{name: "check", run: runCheck},
+	{name: "dump", run: runDump},
+	{name: "tokens", run: runTokens},
+}
+
+// env holds the standard streams, so that tests can run commands in process.
+type env struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	os.Exit(run(ctx, &env{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}, os.Args[1:]))
+}
+
+func run(ctx context.Context, env *env, args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(env.stderr, usage)
+		return exitFailure
+	}
+
+	switch args[0] {
+	case "-h", "-help", "--help", "help":
+		fmt.Fprint(env.stdout, usage)
+		return exitOK
+	}
+
+	for _, c := range commands {
+		if c.name == args[0] {
+			return c.run(ctx, env, args[1:])
+		}
+	}
+
+	fmt.Fprintf(env.stderr, "rbprism: unknown command %q\n\n%s", args[0], usage)
+	return exitFailure
+}
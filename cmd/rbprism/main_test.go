@@ -0,0 +1,196 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func runCommand(t *testing.T, stdin string, args ...string) (int, string, string) {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	status := run(context.Background(), &env{
+		stdin:  strings.NewReader(stdin),
+		stdout: &stdout,
+		stderr: &stderr,
+	}, args)
+
+	return status, stdout.String(), stderr.String()
+}
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestParseExitStatus(t *testing.T) {
+	status, stdout, _ := runCommand(t, "puts 1\n", "parse")
+	if status != exitOK || stdout != "" {
+		t.Errorf("expected a clean exit, got %d with %q", status, stdout)
+	}
+
+	status, stdout, _ = runCommand(t, "foo(\n", "parse")
+	if status != exitSyntaxError {
+		t.Errorf("expected status %d, got %d", exitSyntaxError, status)
+	}
+
+	if !strings.Contains(stdout, "<stdin>:") {
+		t.Errorf("expected the diagnostics to name stdin, got:\n%s", stdout)
+	}
+}
+
+func TestCheckDirectory(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"a.rb":            "foo(\n",
+		"b.rb":            "bar\n",
+		"lib/c.rake":      "def (\n",
+		"notes.txt":       "def (\n",
+		".hidden/d.rb":    "def (\n",
+		"Gemfile":         "gem 'x'\n",
+		"lib/.skipped.rb": "def (\n",
+	})
+
+	status, stdout, stderr := runCommand(t, "", "check", "-j", "2", dir)
+	if status != exitSyntaxError {
+		t.Fatalf("expected status %d, got %d: %s", exitSyntaxError, status, stderr)
+	}
+
+	var files []string
+	for _, line := range strings.Split(strings.TrimSpace(stdout), "\n") {
+		path, _, _ := strings.Cut(line, ":")
+		if len(files) == 0 || files[len(files)-1] != path {
+			files = append(files, path)
+		}
+	}
+
+	expected := []string{filepath.Join(dir, "a.rb"), filepath.Join(dir, "lib", "c.rake")}
+	if strings.Join(files, " ") != strings.Join(expected, " ") {
+		t.Errorf("expected errors in %v, got:\n%s", expected, stdout)
+	}
+}
+
+func TestCheckJSONLines(t *testing.T) {
+	status, stdout, _ := runCommand(t, "foo(\n", "check", "-format", "jsonl")
+	if status != exitSyntaxError {
+		t.Fatalf("expected status %d, got %d", exitSyntaxError, status)
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(stdout), "\n") {
+		var diagnostic map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &diagnostic); err != nil {
+			t.Fatalf("expected JSON, got %q: %s", line, err)
+		}
+
+		if diagnostic["path"] != "<stdin>" || diagnostic["severity"] != "error" {
+			t.Errorf("unexpected diagnostic %v", diagnostic)
+		}
+	}
+}
+
+func TestDumpFormats(t *testing.T) {
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{"inspect", "@ ProgramNode (location: (1,0)-(1,3))"},
+		{"dot", "digraph AST {"},
+		{"mermaid", "flowchart TD"},
+		{"json", `"path":"<stdin>"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			status, stdout, stderr := runCommand(t, "foo\n", "dump", "-format", tt.format)
+			if status != exitOK {
+				t.Fatalf("expected a clean exit, got %d: %s", status, stderr)
+			}
+
+			if !strings.Contains(stdout, tt.expected) {
+				t.Errorf("expected %q in:\n%s", tt.expected, stdout)
+			}
+		})
+	}
+}
+
+func TestDumpMultipleInputsInOrder(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"a.rb": "a\n",
+		"b.rb": "b\n",
+		"c.rb": "c\n",
+	})
+
+	status, stdout, _ := runCommand(t, "", "dump", "-j", "3",
+		filepath.Join(dir, "c.rb"), filepath.Join(dir, "a.rb"), filepath.Join(dir, "b.rb"))
+	if status != exitOK {
+		t.Fatalf("expected a clean exit, got %d", status)
+	}
+
+	last := -1
+	for _, name := range []string{"c.rb", "a.rb", "b.rb"} {
+		index := strings.Index(stdout, "==> "+filepath.Join(dir, name)+" <==")
+		if index <= last {
+			t.Fatalf("expected %s after the previous input, got:\n%s", name, stdout)
+		}
+
+		last = index
+	}
+}
+
+func TestTokens(t *testing.T) {
+	status, stdout, _ := runCommand(t, "a = 1\n", "tokens")
+	if status != exitOK {
+		t.Fatalf("expected a clean exit, got %d", status)
+	}
+
+	expected := "1:0-1:1\tIDENTIFIER\t\"a\"\tCMDARG\n"
+	if !strings.HasPrefix(stdout, expected) {
+		t.Errorf("expected output to start with %q, got:\n%s", expected, stdout)
+	}
+}
+
+func TestFailures(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"no command", nil},
+		{"unknown command", []string{"lint"}},
+		{"missing file", []string{"parse", "does-not-exist.rb"}},
+		{"unknown format", []string{"dump", "-format", "yaml"}},
+		{"invalid version", []string{"parse", "-ruby-version", "2.0"}},
+		{"invalid switch", []string{"parse", "-command-line", "x"}},
+		{"invalid scope", []string{"parse", "-scope", "a,"}},
+		{"invalid jobs", []string{"parse", "-j", "0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, _, stderr := runCommand(t, "", tt.args...)
+			if status != exitFailure {
+				t.Errorf("expected status %d, got %d", exitFailure, status)
+			}
+
+			if stderr == "" {
+				t.Error("expected the problem on stderr")
+			}
+		})
+	}
+}
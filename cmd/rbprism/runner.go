@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+// handler processes the source of one input, writing what the command prints
+// for it to w. It reports whether the source has syntax errors that should
+// make the command fail.
+type handler func(ctx context.Context, pool *parser.ParserPool, index int, in input, source []byte, opts []parser.ParseOption, w io.Writer) (bool, error)
+
+type job struct {
+	in           input
+	output       bytes.Buffer
+	syntaxErrors bool
+	err          error
+	done         chan struct{}
+}
+
+// process runs handle over inputs on up to flags.jobs parser instances and
+// prints the output in the order of inputs. It returns the exit status.
+func process(ctx context.Context, env *env, flags *commonFlags, inputs []input, handle handler) int {
+	if flags.jobs < 1 {
+		fmt.Fprintf(env.stderr, "rbprism: invalid number of jobs: %d\n", flags.jobs)
+		return exitFailure
+	}
+
+	// reject invalid parse options once rather than for every source
+	if _, err := flags.parseOptions(""); err != nil {
+		fmt.Fprintf(env.stderr, "rbprism: %s\n", err)
+		return exitFailure
+	}
+
+	pool, err := parser.NewParserPool(ctx, flags.jobs)
+	if err != nil {
+		fmt.Fprintf(env.stderr, "rbprism: %s\n", err)
+		return exitFailure
+	}
+	defer pool.Close(ctx)
+
+	jobs := make([]*job, len(inputs))
+	for i, in := range inputs {
+		jobs[i] = &job{in: in, done: make(chan struct{})}
+	}
+
+	// bounds the outputs waiting to be printed behind a slow source
+	window := make(chan struct{}, 2*flags.jobs)
+
+	go func() {
+		for i, j := range jobs {
+			window <- struct{}{}
+
+			go func() {
+				defer close(j.done)
+
+				source, err := j.in.read()
+				if err != nil {
+					j.err = err
+					return
+				}
+
+				opts, err := flags.parseOptions(j.in.path)
+				if err != nil {
+					j.err = err
+					return
+				}
+
+				j.syntaxErrors, j.err = handle(ctx, pool, i, j.in, source, opts, &j.output)
+			}()
+		}
+	}()
+
+	status := exitOK
+	for _, j := range jobs {
+		<-j.done
+
+		if _, err := env.stdout.Write(j.output.Bytes()); err != nil {
+			fmt.Fprintf(env.stderr, "rbprism: failed to write the output: %s\n", err)
+			status = exitFailure
+		}
+
+		if j.err != nil {
+			fmt.Fprintf(env.stderr, "rbprism: %s: %s\n", j.in.name(), j.err)
+			status = exitFailure
+		} else if j.syntaxErrors && status == exitOK {
+			status = exitSyntaxError
+		}
+
+		// drop the output as soon as it is printed
+		j.output = bytes.Buffer{}
+		<-window
+	}
+
+	return status
+}
+
+// header separates the outputs of several inputs.
+func header(w io.Writer, inputs int, in input) {
+	if inputs > 1 {
+		fmt.Fprintf(w, "==> %s <==\n", in.name())
+	}
+}
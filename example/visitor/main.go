@@ -8,21 +8,23 @@ import (
 	parser "github.com/tjgurwara99/go-ruby-prism/parser"
 )
 
-type visitor struct{}
+type visitor struct {
+	*parser.BaseVisitor
+}
 
 func newVisitor() *visitor {
-	return &visitor{}
+	v := &visitor{}
+	v.BaseVisitor = parser.NewBaseVisitor(v)
+	return v
 }
 
-func (v *visitor) Visit(node parser.Node) {
-	fmt.Printf("%T\n", node)
+func (v *visitor) VisitCallNode(node *parser.CallNode) {
+	fmt.Printf("call %s\n", node.Name)
+	v.VisitChildren(node)
 }
 
-func (v *visitor) traverse(node parser.Node) {
-	node.Accept(v)
-	for _, child := range node.Children() {
-		v.traverse(child)
-	}
+func (v *visitor) VisitStringNode(node *parser.StringNode) {
+	fmt.Printf("string %q\n", node.Unescaped)
 }
 
 func main() {
@@ -39,5 +41,5 @@ func main() {
 	}
 
 	visitor := newVisitor()
-	visitor.traverse(result.Value)
+	result.Value.Accept(visitor)
 }
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	parser "github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+func main() {
+	ctx := context.Background()
+
+	p, _ := parser.NewParser(ctx)
+	defer p.Close(ctx)
+
+	source := "def greet(name)\n  puts \"Hello, #{name}!\"\nend"
+	result, err := p.Parse(ctx, []byte(source))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	depth := 0
+	parser.Walk(result.Value, func(node parser.Node) parser.WalkAction {
+		fmt.Printf("%s%T\n", strings.Repeat("  ", depth), node)
+		depth++
+
+		// don't descend into string interpolation
+		if _, ok := node.(*parser.InterpolatedStringNode); ok {
+			return parser.WalkSkipChildren
+		}
+
+		return parser.WalkContinue
+	}, func(node parser.Node) parser.WalkAction {
+		depth--
+		return parser.WalkContinue
+	})
+}
@@ -2,12 +2,17 @@ package parser
 
 import (
 	"encoding/binary"
+	"fmt"
+	"io"
 	"math"
 )
 
 type buffer struct {
 	data  []byte
 	index int
+	// onlySemanticFields is set when the blob leaves out the location fields
+	// of nodes
+	onlySemanticFields bool
 }
 
 func newBuffer(data []byte) *buffer {
@@ -16,52 +21,70 @@ func newBuffer(data []byte) *buffer {
 	}
 }
 
+// next returns the next n bytes and advances past them, or fails with
+// io.ErrUnexpectedEOF if fewer than n bytes are left.
+func (b *buffer) next(n int) ([]byte, error) {
+	if n < 0 || n > b.remaining() {
+		return nil, fmt.Errorf("reading %d bytes at offset %d of %d: %w", n, b.index, len(b.data), io.ErrUnexpectedEOF)
+	}
+
+	bytes := b.data[b.index : b.index+n]
+	b.index += n
+	return bytes, nil
+}
+
 func (b *buffer) readByte() (byte, error) {
-	if len(b.data) == 0 {
-		return 0, nil
+	bytes, err := b.next(1)
+	if err != nil {
+		return 0, err
 	}
 
-	b.index++
-	return b.data[b.index-1], nil
+	return bytes[0], nil
 }
 
+// read fills p completely.
 func (b *buffer) read(p []byte) (int, error) {
-	if len(b.data) == 0 {
-		return 0, nil
+	bytes, err := b.next(len(p))
+	if err != nil {
+		return 0, err
 	}
 
-	n := copy(p, b.data[b.index:])
-	b.index += n
-	return n, nil
+	return copy(p, bytes), nil
 }
 
-func (b *buffer) readUInt32() uint32 {
-	if len(b.data) == 0 {
-		return 0
+func (b *buffer) readUInt32() (uint32, error) {
+	bytes, err := b.next(4)
+	if err != nil {
+		return 0, err
 	}
 
-	bytes := b.data[b.index : b.index+4]
-	b.index += 4
-	return binary.LittleEndian.Uint32(bytes)
+	return binary.LittleEndian.Uint32(bytes), nil
 }
 
-func (b *buffer) readFloat64() float64 {
-	if len(b.data) == 0 {
-		return 0
+func (b *buffer) readFloat64() (float64, error) {
+	bytes, err := b.next(8)
+	if err != nil {
+		return 0, err
 	}
 
-	bytes := b.data[b.index : b.index+8]
-	b.index += 8
-
 	bits := binary.LittleEndian.Uint64(bytes)
 	float := math.Float64frombits(bits)
-	return float
+	return float, nil
+}
+
+func (b *buffer) remaining() int {
+	return len(b.data) - b.index
 }
 
 func (b *buffer) position() int {
 	return b.index
 }
 
-func (b *buffer) setPosition(index int) {
+func (b *buffer) setPosition(index int) error {
+	if index < 0 || index > len(b.data) {
+		return fmt.Errorf("seeking to offset %d of %d: %w", index, len(b.data), io.ErrUnexpectedEOF)
+	}
+
 	b.index = index
+	return nil
 }
@@ -0,0 +1,369 @@
+package parser
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	cacheMagic         = "RBPC"
+	cacheFormatVersion = 1
+	cacheFileExt       = ".prism"
+	// magic, format version, prism version, key and checksum
+	cacheHeaderSize = len(cacheMagic) + 1 + 3 + sha256.Size + 4
+)
+
+var errCorruptedCacheEntry = errors.New("corrupted cache entry")
+
+var crc32Table = crc32.MakeTable(crc32.Castagnoli)
+
+// ParseCache stores what prism serializes for a source on disk, so that
+// parsing the same source with the same options again only deserializes it,
+// without calling into wasm. Entries are keyed by a hash of the source, the
+// options, the prism version and the wasm binary, and carry a checksum;
+// entries that fail to load are removed and parsed again. Failing to store an
+// entry doesn't fail the parse, see WithCacheStoreErrorHandler.
+//
+// Like Parser, a ParseCache is not safe for concurrent use. Several caches,
+// in one or more processes, may share a directory.
+type ParseCache struct {
+	parser       *Parser
+	dir          string
+	maxBytes     int64
+	onStoreError func(error)
+
+	entries map[string]*cacheEntry
+	size    int64
+	stats   CacheStats
+}
+
+type cacheEntry struct {
+	size int64
+	used time.Time
+}
+
+// CacheStats counts the lookups of a ParseCache.
+type CacheStats struct {
+	Hits   int
+	Misses int
+	// Corrupted counts entries that were found but failed to load.
+	Corrupted int
+	Evictions int
+	// StoreFailures counts results that couldn't be written to the cache.
+	StoreFailures int
+}
+
+type CacheOption func(*cacheOptions) error
+
+type cacheOptions struct {
+	maxBytes     int64
+	onStoreError func(error)
+}
+
+// WithCacheMaxBytes bounds the total size of the entries in the cache
+// directory. The least recently used entries are removed once it is exceeded.
+// By default the cache grows without bound.
+func WithCacheMaxBytes(maxBytes int64) CacheOption {
+	return func(o *cacheOptions) error {
+		if maxBytes <= 0 {
+			return fmt.Errorf("invalid cache size: %d", maxBytes)
+		}
+
+		o.maxBytes = maxBytes
+		return nil
+	}
+}
+
+// WithCacheStoreErrorHandler calls handle with the error of every result that
+// couldn't be written to the cache directory, which is otherwise only counted
+// in CacheStats.StoreFailures.
+func WithCacheStoreErrorHandler(handle func(error)) CacheOption {
+	return func(o *cacheOptions) error {
+		if handle == nil {
+			return errors.New("nil store error handler")
+		}
+
+		o.onStoreError = handle
+		return nil
+	}
+}
+
+// NewParseCache returns a cache in dir, creating the directory if needed, that
+// parses with p on a miss.
+func NewParseCache(p *Parser, dir string, opts ...CacheOption) (*ParseCache, error) {
+	options := &cacheOptions{}
+	for _, opt := range opts {
+		if err := opt(options); err != nil {
+			return nil, fmt.Errorf("invalid cache option: %w", err)
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create the cache directory: %w", err)
+	}
+
+	c := &ParseCache{
+		parser:       p,
+		dir:          dir,
+		maxBytes:     options.maxBytes,
+		onStoreError: options.onStoreError,
+		entries:      make(map[string]*cacheEntry),
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the cache directory: %w", err)
+	}
+
+	for _, file := range files {
+		key, ok := strings.CutSuffix(file.Name(), cacheFileExt)
+		if !ok || file.IsDir() {
+			continue
+		}
+
+		info, err := file.Info()
+		if err != nil {
+			// removed by someone else in the meantime
+			continue
+		}
+
+		c.entries[key] = &cacheEntry{size: info.Size(), used: info.ModTime()}
+		c.size += info.Size()
+	}
+
+	return c, nil
+}
+
+// Parse returns the same result as Parser.Parse, from the cache if source was
+// parsed with the same options before.
+func (c *ParseCache) Parse(ctx context.Context, source []byte, opts ...ParseOption) (*ParseResult, error) {
+	options, err := newParseOptionsFrom(opts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid parse option: %w", err)
+	}
+
+	optBytes, err := options.bytes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert options into bytes: %w", err)
+	}
+
+	key := cacheKey(c.parser.version, c.parser.runtime.BinaryHash(), source, optBytes)
+
+	result, err := c.lookup(key, source)
+	if err == nil {
+		c.stats.Hits++
+		return result, nil
+	}
+
+	if !errors.Is(err, fs.ErrNotExist) {
+		c.stats.Corrupted++
+		c.remove(key)
+	}
+
+	c.stats.Misses++
+
+	serialized, err := c.parser.serializeWithOptions(ctx, source, options, c.parser.runtime.SerializeParse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse with options: %w", err)
+	}
+
+	// the result doesn't depend on the cache, the next parse of source only
+	// misses again
+	if err := c.store(key, serialized); err != nil {
+		c.stats.StoreFailures++
+		if c.onStoreError != nil {
+			c.onStoreError(fmt.Errorf("failed to store the cache entry: %w", err))
+		}
+	}
+
+	result, err = deserialize(serialized, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize the result: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *ParseCache) lookup(key string, source []byte) (*ParseResult, error) {
+	serialized, err := c.load(key)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := deserialize(serialized, source)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errCorruptedCacheEntry, err)
+	}
+
+	return result, nil
+}
+
+func (c *ParseCache) Stats() CacheStats {
+	return c.stats
+}
+
+// Size returns the total size of the entries in bytes.
+func (c *ParseCache) Size() int64 {
+	return c.size
+}
+
+func cacheKey(version [3]byte, binaryHash [sha256.Size]byte, source []byte, optBytes []byte) string {
+	h := sha256.New()
+	h.Write(version[:])
+	// builds of the same version may serialize differently
+	h.Write(binaryHash[:])
+	binary.Write(h, binary.LittleEndian, uint64(len(optBytes)))
+	h.Write(optBytes)
+	h.Write(source)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (c *ParseCache) path(key string) string {
+	return filepath.Join(c.dir, key+cacheFileExt)
+}
+
+func (c *ParseCache) load(key string) ([]byte, error) {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < cacheHeaderSize {
+		return nil, fmt.Errorf("%w: truncated header", errCorruptedCacheEntry)
+	}
+
+	header := data[:cacheHeaderSize]
+	payload := data[cacheHeaderSize:]
+
+	if !bytes.HasPrefix(header, []byte(cacheMagic)) {
+		return nil, fmt.Errorf("%w: invalid magic", errCorruptedCacheEntry)
+	}
+	header = header[len(cacheMagic):]
+
+	if !bytes.Equal(header[:4], append([]byte{cacheFormatVersion}, c.parser.version[:]...)) {
+		return nil, fmt.Errorf("%w: written by another version", errCorruptedCacheEntry)
+	}
+	header = header[4:]
+
+	if hex.EncodeToString(header[:sha256.Size]) != key {
+		return nil, fmt.Errorf("%w: key mismatch", errCorruptedCacheEntry)
+	}
+	header = header[sha256.Size:]
+
+	if binary.LittleEndian.Uint32(header) != crc32.Checksum(payload, crc32Table) {
+		return nil, fmt.Errorf("%w: checksum mismatch", errCorruptedCacheEntry)
+	}
+
+	now := time.Now()
+	if entry, ok := c.entries[key]; ok {
+		entry.used = now
+	} else {
+		// stored by another cache sharing the directory
+		c.entries[key] = &cacheEntry{size: int64(len(data)), used: now}
+		c.size += int64(len(data))
+	}
+
+	// the modification time keeps the order for the next cache opening the
+	// directory, failing to update it only makes eviction less accurate
+	_ = os.Chtimes(c.path(key), now, now)
+
+	return payload, nil
+}
+
+func (c *ParseCache) store(key string, serialized []byte) error {
+	rawKey, err := hex.DecodeString(key)
+	if err != nil {
+		return err
+	}
+
+	data := make([]byte, 0, cacheHeaderSize+len(serialized))
+	data = append(data, cacheMagic...)
+	data = append(data, cacheFormatVersion)
+	data = append(data, c.parser.version[:]...)
+	data = append(data, rawKey...)
+	data = binary.LittleEndian.AppendUint32(data, crc32.Checksum(serialized, crc32Table))
+	data = append(data, serialized...)
+
+	// write to a temporary file first so that readers never see a partial
+	// entry
+	file, err := os.CreateTemp(c.dir, "tmp-*")
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+
+	if err := os.Rename(file.Name(), c.path(key)); err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+
+	if entry, ok := c.entries[key]; ok {
+		c.size -= entry.size
+	}
+
+	c.entries[key] = &cacheEntry{size: int64(len(data)), used: time.Now()}
+	c.size += int64(len(data))
+
+	c.evict(key)
+	return nil
+}
+
+func (c *ParseCache) remove(key string) {
+	os.Remove(c.path(key))
+
+	if entry, ok := c.entries[key]; ok {
+		c.size -= entry.size
+		delete(c.entries, key)
+	}
+}
+
+// evict removes the least recently used entries until the cache fits in its
+// size bound, keeping the entry that was just stored.
+func (c *ParseCache) evict(keep string) {
+	if c.maxBytes == 0 || c.size <= c.maxBytes {
+		return
+	}
+
+	keys := make([]string, 0, len(c.entries))
+	for key := range c.entries {
+		if key != keep {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return c.entries[keys[i]].used.Before(c.entries[keys[j]].used)
+	})
+
+	for _, key := range keys {
+		if c.size <= c.maxBytes {
+			break
+		}
+
+		c.remove(key)
+		c.stats.Evictions++
+	}
+}
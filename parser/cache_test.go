@@ -0,0 +1,251 @@
+package parser_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+func newParseCache(t *testing.T, dir string, opts ...parser.CacheOption) *parser.ParseCache {
+	t.Helper()
+
+	ctx := context.Background()
+	p, err := parser.NewParser(ctx)
+	if err != nil {
+		t.Fatalf("failed to create parser: %s", err)
+	}
+	t.Cleanup(func() { p.Close(ctx) })
+
+	c, err := parser.NewParseCache(p, dir, opts...)
+	if err != nil {
+		t.Fatalf("failed to create cache: %s", err)
+	}
+
+	return c
+}
+
+func cacheParse(t *testing.T, c *parser.ParseCache, source string, opts ...parser.ParseOption) *parser.ParseResult {
+	t.Helper()
+
+	result, err := c.Parse(context.Background(), []byte(source), opts...)
+	if err != nil {
+		t.Fatalf("failed to parse %q: %s", source, err)
+	}
+
+	return result
+}
+
+func cacheEntries(t *testing.T, dir string) []string {
+	t.Helper()
+
+	entries, err := filepath.Glob(filepath.Join(dir, "*.prism"))
+	if err != nil {
+		t.Fatalf("failed to list the cache: %s", err)
+	}
+
+	return entries
+}
+
+func TestParseCacheHit(t *testing.T) {
+	dir := t.TempDir()
+	source := "class Foo\n  def bar = baz(:qux, 1)\nend\n"
+
+	c := newParseCache(t, dir)
+	first := cacheParse(t, c, source)
+	second := cacheParse(t, c, source)
+
+	if stats := c.Stats(); stats.Hits != 1 || stats.Misses != 1 {
+		t.Errorf("expected one hit and one miss, got %+v", stats)
+	}
+
+	expected, _ := json.Marshal(first)
+	got, _ := json.Marshal(second)
+	if string(expected) != string(got) {
+		t.Errorf("expected the cached result to equal the parsed one")
+	}
+
+	// options are part of the key
+	cacheParse(t, c, source, parser.WithFrozenStringLiteral(true))
+	if stats := c.Stats(); stats.Misses != 2 {
+		t.Errorf("expected different options to miss, got %+v", stats)
+	}
+
+	// a new cache picks up the entries of the directory
+	reopened := newParseCache(t, dir)
+	cacheParse(t, reopened, source)
+	if stats := reopened.Stats(); stats.Hits != 1 {
+		t.Errorf("expected a hit after reopening, got %+v", stats)
+	}
+
+	if len(cacheEntries(t, dir)) != 2 {
+		t.Errorf("expected 2 entries, got %d", len(cacheEntries(t, dir)))
+	}
+}
+
+func TestParseCacheCorruption(t *testing.T) {
+	dir := t.TempDir()
+	source := "foo(:bar, \"baz\")"
+
+	c := newParseCache(t, dir)
+	expected, _ := json.Marshal(cacheParse(t, c, source))
+
+	entries := cacheEntries(t, dir)
+	if len(entries) != 1 {
+		t.Fatalf("expected a single entry, got %d", len(entries))
+	}
+
+	corruptions := []func(data []byte) []byte{
+		// flip a byte of the payload
+		func(data []byte) []byte { data[len(data)-1] ^= 0xff; return data },
+		// an older prism version
+		func(data []byte) []byte { data[6] = 23; return data },
+		func(data []byte) []byte { return data[:10] },
+	}
+
+	for i, corrupt := range corruptions {
+		data, err := os.ReadFile(entries[0])
+		if err != nil {
+			t.Fatalf("failed to read the entry: %s", err)
+		}
+
+		if err := os.WriteFile(entries[0], corrupt(data), 0o644); err != nil {
+			t.Fatalf("failed to corrupt the entry: %s", err)
+		}
+
+		got, _ := json.Marshal(cacheParse(t, c, source))
+		if string(got) != string(expected) {
+			t.Errorf("%d: expected the source to be parsed again", i)
+		}
+
+		if stats := c.Stats(); stats.Corrupted != i+1 || stats.Hits != 0 {
+			t.Errorf("%d: expected the entry to be detected as corrupted, got %+v", i, stats)
+		}
+	}
+
+	cacheParse(t, c, source)
+	if stats := c.Stats(); stats.Hits != 1 {
+		t.Errorf("expected the rewritten entry to hit, got %+v", stats)
+	}
+}
+
+func TestParseCacheStoreFailure(t *testing.T) {
+	tests := map[string]func(t *testing.T, dir string){
+		"read-only": func(t *testing.T, dir string) {
+			if os.Geteuid() == 0 {
+				t.Skip("root writes to read-only directories")
+			}
+
+			if err := os.Chmod(dir, 0o555); err != nil {
+				t.Fatalf("failed to make the directory read-only: %s", err)
+			}
+			t.Cleanup(func() { os.Chmod(dir, 0o755) })
+		},
+		"removed": func(t *testing.T, dir string) {
+			if err := os.RemoveAll(dir); err != nil {
+				t.Fatalf("failed to remove the directory: %s", err)
+			}
+		},
+	}
+
+	for name, breakDir := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "cache")
+
+			var storeErrors []error
+			c := newParseCache(t, dir, parser.WithCacheStoreErrorHandler(func(err error) {
+				storeErrors = append(storeErrors, err)
+			}))
+			breakDir(t, dir)
+
+			result := cacheParse(t, c, "foo(:bar)")
+			if result.Value == nil {
+				t.Fatalf("expected a result")
+			}
+
+			if len(storeErrors) != 1 {
+				t.Errorf("expected the store error to be reported once, got %v", storeErrors)
+			}
+
+			cacheParse(t, c, "foo(:bar)")
+			if stats := c.Stats(); stats.StoreFailures != 2 || stats.Misses != 2 {
+				t.Errorf("expected every parse to miss and fail to store, got %+v", stats)
+			}
+		})
+	}
+}
+
+func TestParseCacheEviction(t *testing.T) {
+	dir := t.TempDir()
+
+	probe := newParseCache(t, t.TempDir())
+	cacheParse(t, probe, "a")
+	size := probe.Size()
+
+	// room for two entries of the same size
+	c := newParseCache(t, dir, parser.WithCacheMaxBytes(2*size))
+	cacheParse(t, c, "a")
+	cacheParse(t, c, "b")
+	cacheParse(t, c, "a")
+	cacheParse(t, c, "c")
+
+	if stats := c.Stats(); stats.Evictions != 1 {
+		t.Errorf("expected one eviction, got %+v", stats)
+	}
+
+	if c.Size() > 2*size || len(cacheEntries(t, dir)) != 2 {
+		t.Errorf("expected the cache to stay within its bound, got %d bytes in %d entries", c.Size(), len(cacheEntries(t, dir)))
+	}
+
+	// b was the least recently used
+	cacheParse(t, c, "a")
+	cacheParse(t, c, "c")
+	if stats := c.Stats(); stats.Hits != 3 {
+		t.Errorf("expected a and c to be kept, got %+v", stats)
+	}
+
+	if _, err := parser.NewParseCache(nil, dir, parser.WithCacheMaxBytes(0)); err == nil {
+		t.Errorf("expected an invalid size to be rejected")
+	}
+}
+
+func TestParseCacheKeyedByBinary(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	source := "foo(1)"
+
+	binary, err := os.ReadFile(prismWasmPath)
+	if err != nil {
+		t.Fatalf("failed to read prism: %s", err)
+	}
+
+	// another build of the same version, as far as the hash tells: a custom
+	// section named "test" holding a zero
+	binary = append(binary, 0x00, 0x06, 0x04, 't', 'e', 's', 't', 0x00)
+
+	p, err := parser.NewParser(ctx, parser.WithWasmBinary(binary))
+	if err != nil {
+		t.Fatalf("failed to create parser: %s", err)
+	}
+	defer p.Close(ctx)
+
+	other, err := parser.NewParseCache(p, dir)
+	if err != nil {
+		t.Fatalf("failed to create cache: %s", err)
+	}
+
+	c := newParseCache(t, dir)
+	cacheParse(t, c, source)
+	cacheParse(t, other, source)
+
+	if stats := other.Stats(); stats.Hits != 0 || stats.Misses != 1 {
+		t.Errorf("expected the entry of the embedded build to be left alone, got %+v", stats)
+	}
+
+	if entries := cacheEntries(t, dir); len(entries) != 2 {
+		t.Errorf("expected an entry per binary, got %d", len(entries))
+	}
+}
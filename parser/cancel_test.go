@@ -0,0 +1,94 @@
+package parser_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+func newParser(t *testing.T) *parser.Parser {
+	t.Helper()
+
+	ctx := context.Background()
+	p, err := parser.NewParser(ctx)
+	if err != nil {
+		t.Fatalf("failed to create parser: %s", err)
+	}
+	t.Cleanup(func() { p.Close(ctx) })
+
+	return p
+}
+
+func assertParses(t *testing.T, p *parser.Parser) {
+	t.Helper()
+
+	result, err := p.Parse(context.Background(), []byte("foo(1)"))
+	if err != nil {
+		t.Fatalf("expected the parser to recover, got %s", err)
+	}
+
+	if _, ok := firstStatement(t, result).(*parser.CallNode); !ok {
+		t.Errorf("expected a CallNode")
+	}
+}
+
+func TestParseCanceledBeforeStart(t *testing.T) {
+	p := newParser(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := p.Parse(ctx, []byte("foo(1)"))
+	if !errors.Is(err, parser.ErrParseCanceled) || !errors.Is(err, context.Canceled) {
+		t.Errorf("expected ErrParseCanceled wrapping context.Canceled, got %v", err)
+	}
+
+	_, err = p.Lex(ctx, []byte("foo(1)"))
+	if !errors.Is(err, parser.ErrParseCanceled) {
+		t.Errorf("expected ErrParseCanceled from Lex, got %v", err)
+	}
+
+	assertParses(t, p)
+}
+
+func TestParseDeadlineAbortsPrism(t *testing.T) {
+	p := newParser(t)
+
+	// takes prism seconds, far longer than the deadline
+	source := []byte(strings.Repeat("a = [1, 2, {b: 3}]\n", 200_000))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := p.Parse(ctx, source)
+	if !errors.Is(err, parser.ErrParseCanceled) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected ErrParseCanceled wrapping context.DeadlineExceeded, got %v", err)
+	}
+
+	assertParses(t, p)
+}
+
+func TestParserPoolParseCanceled(t *testing.T) {
+	ctx := context.Background()
+	pool, err := parser.NewParserPool(ctx, 1)
+	if err != nil {
+		t.Fatalf("failed to create pool: %s", err)
+	}
+	defer pool.Close(ctx)
+
+	canceled, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	_, err = pool.Parse(canceled, []byte(strings.Repeat("a = [1, 2, {b: 3}]\n", 200_000)))
+	if !errors.Is(err, parser.ErrParseCanceled) {
+		t.Fatalf("expected ErrParseCanceled, got %v", err)
+	}
+
+	if _, err := pool.Parse(ctx, []byte("foo(1)")); err != nil {
+		t.Errorf("expected the pool to recover, got %s", err)
+	}
+}
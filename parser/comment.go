@@ -21,6 +21,24 @@ func (c *Comment) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (c *Comment) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		Type uint32    `json:"type"`
+		Loc  *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	*c = Comment{
+		Typpe: fields.Type,
+		Loc:   fields.Loc,
+	}
+
+	return nil
+}
+
 type MagicComment struct {
 	KeyLocation   *Location
 	ValueLocation *Location
@@ -39,3 +57,21 @@ func (c *MagicComment) MarshalJSON() ([]byte, error) {
 		"valueLocation": c.ValueLocation,
 	})
 }
+
+func (c *MagicComment) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		KeyLocation   *Location `json:"keyLocation"`
+		ValueLocation *Location `json:"valueLocation"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	*c = MagicComment{
+		KeyLocation:   fields.KeyLocation,
+		ValueLocation: fields.ValueLocation,
+	}
+
+	return nil
+}
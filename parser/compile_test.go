@@ -0,0 +1,58 @@
+package parser_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+func TestParsersShareCompiledModule(t *testing.T) {
+	ctx := context.Background()
+
+	first, err := parser.NewParser(ctx)
+	if err != nil {
+		t.Fatalf("failed to create parser: %s", err)
+	}
+
+	second := newParser(t)
+
+	// closing a parser only closes its own instance
+	if err := first.Close(ctx); err != nil {
+		t.Fatalf("failed to close parser: %s", err)
+	}
+
+	assertParses(t, second)
+	assertParses(t, newParser(t))
+}
+
+func TestCompilationCacheDir(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	p, err := parser.NewParser(ctx, parser.WithCompilationCacheDir(dir))
+	if err != nil {
+		t.Fatalf("failed to create parser: %s", err)
+	}
+	defer p.Close(ctx)
+
+	assertParses(t, p)
+
+	files := 0
+	_ = filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err == nil && entry.Type().IsRegular() {
+			files++
+		}
+		return err
+	})
+
+	if files == 0 {
+		t.Errorf("expected the compiled module to be stored in %s", dir)
+	}
+
+	if _, err := parser.NewParser(ctx, parser.WithCompilationCacheDir("")); err == nil {
+		t.Errorf("expected an empty directory to be rejected")
+	}
+}
@@ -3,6 +3,7 @@ package parser
 import (
 	"encoding/binary"
 	"fmt"
+	"io"
 )
 
 type constantPool struct {
@@ -12,16 +13,24 @@ type constantPool struct {
 	cache        []*string
 }
 
-func newConstantPool(source []byte, serialized []byte, bufferOffset, length uint32) *constantPool {
+func newConstantPool(source []byte, serialized []byte, bufferOffset, length uint32) (*constantPool, error) {
+	if uint64(bufferOffset)+uint64(length)*8 > uint64(len(serialized)) {
+		return nil, fmt.Errorf("constant pool of %d constants at offset %d is outside of the %d serialized bytes: %w", length, bufferOffset, len(serialized), io.ErrUnexpectedEOF)
+	}
+
 	return &constantPool{
 		source:       source,
 		serialized:   serialized,
 		bufferOffset: bufferOffset,
 		cache:        make([]*string, length),
-	}
+	}, nil
 }
 
 func (cp *constantPool) Get(buff *buffer, oneBasedIndex uint32) (string, error) {
+	if oneBasedIndex == 0 || int64(oneBasedIndex) > int64(len(cp.cache)) {
+		return "", fmt.Errorf("invalid constant index %d in a pool of %d constants", oneBasedIndex, len(cp.cache))
+	}
+
 	index := oneBasedIndex - 1
 	constant := cp.cache[index]
 
@@ -29,18 +38,35 @@ func (cp *constantPool) Get(buff *buffer, oneBasedIndex uint32) (string, error)
 		offset := cp.bufferOffset + index*8
 		start := binary.LittleEndian.Uint32(cp.serialized[offset : offset+4])
 		length := binary.LittleEndian.Uint32(cp.serialized[offset+4 : offset+8])
-		bytes := make([]byte, length)
 
+		var bytes []byte
 		if start <= 0x7FFFFFFF {
+			if uint64(start)+uint64(length) > uint64(len(cp.source)) {
+				return "", fmt.Errorf("constant %d...%d is outside of the %d byte source: %w", start, uint64(start)+uint64(length), len(cp.source), io.ErrUnexpectedEOF)
+			}
+
+			bytes = make([]byte, length)
 			copy(bytes, cp.source[start:start+length])
 		} else {
+			// owned constants are embedded in the serialized output
 			position := buff.position()
-			buff.setPosition(int(start & 0x7FFFFFFF))
+			if err := buff.setPosition(int(start & 0x7FFFFFFF)); err != nil {
+				return "", fmt.Errorf("error seeking to constant: %w", err)
+			}
+
+			if int64(length) > int64(buff.remaining()) {
+				return "", fmt.Errorf("constant of %d bytes at offset %d is outside of the %d serialized bytes: %w", length, buff.position(), len(cp.serialized), io.ErrUnexpectedEOF)
+			}
+
+			bytes = make([]byte, length)
 			_, err := buff.read(bytes)
 			if err != nil {
 				return "", fmt.Errorf("error reading bytes: %w", err)
 			}
-			buff.setPosition(position)
+
+			if err := buff.setPosition(position); err != nil {
+				return "", fmt.Errorf("error seeking back from constant: %w", err)
+			}
 		}
 
 		var str = string(bytes)
@@ -4,51 +4,244 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"strings"
 )
 
 const prismHeader = "PRISM"
+
+// the version of the prism build embedded in the wasm package
 const majorVersion = 0
 const minorVersion = 24
 const patchVersion = 0
 
+// supportedVersions are the prism versions whose serialization format this
+// package reads. Supporting another one means generating its nodes.
+var supportedVersions = [][3]byte{
+	{majorVersion, minorVersion, patchVersion},
+}
+
+// SupportedPrismVersions returns the prism versions this package can load,
+// whether from a serialized blob or a wasm binary.
+func SupportedPrismVersions() []string {
+	versions := make([]string, 0, len(supportedVersions))
+	for _, version := range supportedVersions {
+		versions = append(versions, formatVersion(version))
+	}
+
+	return versions
+}
+
+// VersionMismatchError is returned when a blob was serialized, or a wasm
+// binary was built, by a prism version whose format this package can't read.
+type VersionMismatchError struct {
+	Found     string
+	Supported []string
+}
+
+func (e *VersionMismatchError) Error() string {
+	return fmt.Sprintf("prism %s is not supported, supported versions: %s", e.Found, strings.Join(e.Supported, ", "))
+}
+
+func formatVersion(version [3]byte) string {
+	return fmt.Sprintf("%d.%d.%d", version[0], version[1], version[2])
+}
+
+// checkVersion returns version as bytes if it is supported, or a
+// *VersionMismatchError.
+func checkVersion(version string) ([3]byte, error) {
+	for _, supported := range supportedVersions {
+		if formatVersion(supported) == version {
+			return supported, nil
+		}
+	}
+
+	return [3]byte{}, &VersionMismatchError{Found: version, Supported: SupportedPrismVersions()}
+}
+
+// SerializationHeader describes a blob produced by prism's serializer.
+type SerializationHeader struct {
+	Version string
+	// OnlySemanticFields is set for blobs serialized without the location
+	// fields of nodes, in which those fields are nil.
+	OnlySemanticFields bool
+	// Encoding is the name of the encoding the source was parsed in.
+	Encoding string
+}
+
+type DeserializeOption func(*deserializeOptions) error
+
+type deserializeOptions struct {
+	onlySemanticFields bool
+}
+
+// WithOnlySemanticFields accepts blobs serialized by a prism built with
+// PRISM_SERIALIZE_ONLY_SEMANTICS_FIELDS. Location fields of their nodes are
+// nil, only the locations of the nodes themselves are kept.
+func WithOnlySemanticFields(allow bool) DeserializeOption {
+	return func(o *deserializeOptions) error {
+		o.onlySemanticFields = allow
+		return nil
+	}
+}
+
+// Deserialize loads the output of prism's serializer, for example of
+// Prism.dump in ruby, for source. The blob must come from the prism version
+// this package is built for.
+func Deserialize(serialized []byte, source []byte, opts ...DeserializeOption) (*ParseResult, *SerializationHeader, error) {
+	options := &deserializeOptions{}
+	for _, opt := range opts {
+		if err := opt(options); err != nil {
+			return nil, nil, fmt.Errorf("invalid deserialize option: %w", err)
+		}
+	}
+
+	return deserializeWithOptions(serialized, source, options)
+}
+
 func deserialize(serialized []byte, source []byte) (*ParseResult, error) {
+	result, _, err := deserializeWithOptions(serialized, source, &deserializeOptions{})
+	return result, err
+}
+
+func deserializeWithOptions(serialized []byte, source []byte, opts *deserializeOptions) (*ParseResult, *SerializationHeader, error) {
 	buff := newBuffer(serialized)
 
+	header, err := loadHeader(buff, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata, err := loadMetadata(buff, source)
+	if err != nil {
+		return nil, nil, err
+	}
+	header.Encoding = metadata.encoding
+
+	// build constant pool
+	constantPoolBufferOffset, err := buff.readUInt32()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading constant pool offset: %w", err)
+	}
+
+	constantPoolLength, err := loadVarUInt(buff)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading constant pool length: %w", err)
+	}
+
+	constantPool, err := newConstantPool(
+		source,
+		serialized,
+		constantPoolBufferOffset,
+		constantPoolLength,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading constant pool: %w", err)
+	}
+
+	// load first node
+	node, err := loadNode(buff, source, constantPool)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading first node: %w", err)
+	}
+
+	// build parse result
+	return NewParseResult(
+		node,
+		metadata.comments,
+		metadata.magicComments,
+		metadata.dataLocation,
+		metadata.synErrors,
+		metadata.synWarnings,
+		metadata.source,
+	), header, nil
+}
+
+func loadHeader(buff *buffer, opts *deserializeOptions) (*SerializationHeader, error) {
 	// check header
-	header := make([]byte, 5)
-	_, err := buff.read(header)
+	magic := make([]byte, 5)
+	_, err := buff.read(magic)
 	if err != nil {
 		return nil, fmt.Errorf("error reading header: %w", err)
 	}
 
-	if !bytes.Equal(header, []byte(prismHeader)) {
+	if !bytes.Equal(magic, []byte(prismHeader)) {
 		return nil, errors.New("invalid prism header")
 	}
 
 	// check version
-	version := make([]byte, 3)
-	_, err = buff.read(version)
+	var version [3]byte
+	_, err = buff.read(version[:])
 	if err != nil {
 		return nil, fmt.Errorf("error reading version: %w", err)
 	}
 
-	if !bytes.Equal(version, []byte{majorVersion, minorVersion, patchVersion}) {
-		return nil, errors.New("invalid version number")
+	header := &SerializationHeader{
+		Version: formatVersion(version),
 	}
 
-	// check location
-	var location = make([]byte, 1)
-	_, err = buff.read(location)
+	if _, err := checkVersion(header.Version); err != nil {
+		return nil, err
+	}
+
+	// 0 if the location fields of nodes are serialized, 1 if only their
+	// semantic fields are
+	onlySemanticFields, err := buff.readByte()
+	if err != nil {
+		return nil, fmt.Errorf("error reading semantic fields flag: %w", err)
+	}
+
+	switch {
+	case onlySemanticFields == 0:
+	case onlySemanticFields == 1 && opts.onlySemanticFields:
+		header.OnlySemanticFields = true
+		buff.onlySemanticFields = true
+	case onlySemanticFields == 1:
+		return nil, errors.New("serialized without location fields, use WithOnlySemanticFields to load it")
+	default:
+		return nil, fmt.Errorf("invalid semantic fields flag: %d", onlySemanticFields)
+	}
+
+	return header, nil
+}
+
+func deserializeTokens(serialized []byte, source []byte) (*LexResult, error) {
+	buff := newBuffer(serialized)
+
+	tokens, err := loadTokens(buff)
 	if err != nil {
-		return nil, fmt.Errorf("error reading location: %w", err)
+		return nil, fmt.Errorf("error reading tokens: %w", err)
 	}
 
-	if !bytes.Equal(location, []byte{0}) {
-		return nil, errors.New("requires no location fields in the serialized output")
+	metadata, err := loadMetadata(buff, source)
+	if err != nil {
+		return nil, err
 	}
 
-	// reading encoding and discard it is always UTF-8
-	encodingLen, err := loadVarUInt(buff)
+	return NewLexResult(
+		tokens,
+		metadata.comments,
+		metadata.magicComments,
+		metadata.dataLocation,
+		metadata.synErrors,
+		metadata.synWarnings,
+		metadata.source,
+	), nil
+}
+
+type metadata struct {
+	encoding      string
+	comments      []*Comment
+	magicComments []*MagicComment
+	dataLocation  *Location
+	synErrors     []*SyntaxError
+	synWarnings   []*SyntaxWarning
+	source        *Source
+}
+
+// loadMetadata reads the part of the serialized output that parse and lex
+// results share, from the encoding up to the syntax warnings.
+func loadMetadata(buff *buffer, source []byte) (*metadata, error) {
+	encodingLen, err := loadCount(buff)
 	if err != nil {
 		return nil, fmt.Errorf("error reading encoding length: %w", err)
 	}
@@ -59,13 +252,13 @@ func deserialize(serialized []byte, source []byte) (*ParseResult, error) {
 		return nil, fmt.Errorf("error reading encoding: %w", err)
 	}
 
-	// skip start line and line offsets
-	_, err = loadVarSInt(buff)
+	// load start line and line offsets
+	startLine, err := loadVarSInt(buff)
 	if err != nil {
 		return nil, fmt.Errorf("error reading start line: %w", err)
 	}
 
-	_, err = loadLineOffsets(buff)
+	lineOffsets, err := loadLineOffsets(buff)
 	if err != nil {
 		return nil, fmt.Errorf("error reading line offsets: %w", err)
 	}
@@ -100,34 +293,13 @@ func deserialize(serialized []byte, source []byte) (*ParseResult, error) {
 		return nil, fmt.Errorf("error reading syntax warnings: %w", err)
 	}
 
-	// build constant pool
-	constantPoolBufferOffset := buff.readUInt32()
-
-	constantPoolLength, err := loadVarUInt(buff)
-	if err != nil {
-		return nil, fmt.Errorf("error reading constant pool length: %w", err)
-	}
-
-	constantPool := newConstantPool(
-		source,
-		serialized,
-		constantPoolBufferOffset,
-		constantPoolLength,
-	)
-
-	// load first node
-	node, err := loadNode(buff, source, constantPool)
-	if err != nil {
-		return nil, fmt.Errorf("error reading first node: %w", err)
-	}
-
-	// build parse result
-	return NewParseResult(
-		node,
-		comments,
-		magicComments,
-		dataLocation,
-		synErrors,
-		synWarnings,
-	), nil
+	return &metadata{
+		encoding:      string(encoding),
+		comments:      comments,
+		magicComments: magicComments,
+		dataLocation:  dataLocation,
+		synErrors:     synErrors,
+		synWarnings:   synWarnings,
+		source:        NewSource(source, startLine, lineOffsets),
+	}, nil
 }
@@ -0,0 +1,101 @@
+package parser
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+)
+
+func serialized(t testing.TB, source string) []byte {
+	t.Helper()
+
+	ctx := context.Background()
+	p, err := NewParser(ctx)
+	if err != nil {
+		t.Fatalf("failed to create parser: %s", err)
+	}
+	defer p.Close(ctx)
+
+	data, err := p.serializeWithOptions(ctx, []byte(source), newParseOptions(), p.runtime.SerializeParse)
+	if err != nil {
+		t.Fatalf("failed to serialize %q: %s", source, err)
+	}
+
+	return data
+}
+
+func TestDeserializeTruncated(t *testing.T) {
+	source := "class Foo < Bar; def baz(a, *b) = [a, b, :c, \"d#{e}\", 1.5, 2**100]; end"
+	data := serialized(t, source)
+
+	if _, err := deserialize(data, []byte(source)); err != nil {
+		t.Fatalf("failed to deserialize the full output: %s", err)
+	}
+
+	for size := 0; size < len(data); size++ {
+		_, err := deserialize(data[:size], []byte(source))
+		if err == nil {
+			// the constant pool sits at the end, the tree may not need it
+			continue
+		}
+
+		if size > len(prismHeader) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("expected io.ErrUnexpectedEOF when truncated to %d bytes, got %s", size, err)
+		}
+	}
+}
+
+func TestDeserializeRejectsSourceOutOfRange(t *testing.T) {
+	source := "foo(:bar)"
+	data := serialized(t, source)
+
+	if _, err := deserialize(data, []byte("f")); err == nil {
+		t.Errorf("expected constants pointing past the source to be rejected")
+	}
+}
+
+func TestDeserializeRejectsInvalidLevels(t *testing.T) {
+	// one diagnostic of a valid type with an empty message at 0..0, then the
+	// level
+	diagnostic := func(diagnosticType, level byte) []byte {
+		return []byte{1, diagnosticType, 0, 0, 0, level}
+	}
+
+	if _, err := loadSynErrors(newBuffer(diagnostic(0, byte(len(SyntaxErrorLevels))))); err == nil {
+		t.Errorf("expected an invalid error level to be rejected")
+	}
+
+	if _, err := loadSynWarnings(newBuffer(diagnostic(224, byte(len(SyntaxWarningLevels))))); err == nil {
+		t.Errorf("expected an invalid warning level to be rejected")
+	}
+
+	// valid levels are accepted
+	if _, err := loadSynErrors(newBuffer(diagnostic(0, 0))); err != nil {
+		t.Errorf("failed to load a valid error: %s", err)
+	}
+
+	if _, err := loadSynWarnings(newBuffer(diagnostic(224, 0))); err != nil {
+		t.Errorf("failed to load a valid warning: %s", err)
+	}
+}
+
+func FuzzDeserialize(f *testing.F) {
+	sources := []string{
+		"",
+		"1 + 2",
+		"class Foo < Bar; def baz(a, *b) = [a, b, :c, \"d#{e}\", 1.5, 2**100]; end",
+		"case x\nin {a: [1, *]} then :ok\nend",
+		"<<~EOS\n  heredoc #{x}\nEOS\n__END__\ndata",
+		"foo(",
+	}
+
+	for _, source := range sources {
+		f.Add(serialized(f, source), []byte(source))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte, source []byte) {
+		// errors are expected, panics are not
+		_, _ = deserialize(data, source)
+	})
+}
@@ -0,0 +1,125 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDeserialize(t *testing.T) {
+	source := "foo(:bar)"
+	data := serialized(t, source)
+
+	result, header, err := Deserialize(data, []byte(source))
+	if err != nil {
+		t.Fatalf("failed to deserialize: %s", err)
+	}
+
+	if header.Version != "0.24.0" || header.Encoding != "UTF-8" || header.OnlySemanticFields {
+		t.Errorf("unexpected header %+v", header)
+	}
+
+	if _, ok := result.Value.(*ProgramNode); !ok {
+		t.Errorf("expected a program node, got %T", result.Value)
+	}
+}
+
+func TestDeserializeVersionMismatch(t *testing.T) {
+	source := "foo"
+	data := serialized(t, source)
+	data[len(prismHeader)+1] = 99
+
+	_, _, err := Deserialize(data, []byte(source))
+
+	var mismatch *VersionMismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("expected a version mismatch, got %v", err)
+	}
+
+	if mismatch.Found != "0.99.0" || !slices.Equal(mismatch.Supported, []string{"0.24.0"}) {
+		t.Errorf("unexpected versions %+v", mismatch)
+	}
+}
+
+// withoutLocationFields turns data, serialized with location fields, into the
+// blob a prism built with PRISM_SERIALIZE_ONLY_SEMANTICS_FIELDS would produce,
+// given the bytes of the location fields it holds, in order and with what
+// separates them.
+func withoutLocationFields(t *testing.T, data []byte, source string, fields, semantic []byte) []byte {
+	t.Helper()
+
+	buff := newBuffer(data)
+	if _, err := loadHeader(buff, &deserializeOptions{}); err != nil {
+		t.Fatalf("failed to read the header: %s", err)
+	}
+	if _, err := loadMetadata(buff, []byte(source)); err != nil {
+		t.Fatalf("failed to read the metadata: %s", err)
+	}
+
+	// the constant pool follows the nodes, its offset moves with them
+	poolOffsetAt := buff.index
+	poolOffset := binary.LittleEndian.Uint32(data[poolOffsetAt:])
+
+	nodes := data[poolOffsetAt+4 : poolOffset]
+	if bytes.Count(nodes, fields) != 1 {
+		t.Fatalf("expected to find the location fields %v once in %v", fields, nodes)
+	}
+
+	stripped := bytes.Clone(data[:poolOffsetAt+4])
+	stripped = append(stripped, bytes.Replace(nodes, fields, semantic, 1)...)
+	stripped = append(stripped, data[poolOffset:]...)
+
+	stripped[len(prismHeader)+3] = 1
+	binary.LittleEndian.PutUint32(stripped[poolOffsetAt:], poolOffset-uint32(len(fields)-len(semantic)))
+
+	return stripped
+}
+
+func TestDeserializeOnlySemanticFields(t *testing.T) {
+	source := "foo()"
+	// the call operator, name, message, opening, arguments and closing
+	// fields, of which the name and the nil arguments stay
+	data := withoutLocationFields(t, serialized(t, source), source,
+		[]byte{0, 1, 1, 0, 3, 1, 3, 1, 0, 1, 4, 1},
+		[]byte{1, 0},
+	)
+
+	if _, _, err := Deserialize(data, []byte(source)); err == nil {
+		t.Errorf("expected a blob without location fields to be rejected by default")
+	}
+
+	result, header, err := Deserialize(data, []byte(source), WithOnlySemanticFields(true))
+	if err != nil {
+		t.Fatalf("failed to deserialize: %s", err)
+	}
+
+	if !header.OnlySemanticFields {
+		t.Errorf("expected the header to report semantic fields only")
+	}
+
+	call, ok := result.Value.(*ProgramNode).Statements.Body[0].(*CallNode)
+	if !ok {
+		t.Fatalf("expected a call node, got %T", result.Value.(*ProgramNode).Statements.Body[0])
+	}
+
+	if call.Name != "foo" || call.Arguments != nil || call.Block != nil {
+		t.Errorf("expected the semantic fields of foo(), got %+v", call)
+	}
+
+	if call.Messageloc != nil || call.Openingloc != nil || call.Closingloc != nil {
+		t.Errorf("expected the location fields to be nil, got %v, %v and %v", call.Messageloc, call.Openingloc, call.Closingloc)
+	}
+
+	// node locations are still serialized
+	if loc := call.Location(); loc.StartOffset != 0 || loc.Length != 5 {
+		t.Errorf("expected the call to span the source, got %+v", loc)
+	}
+
+	inspected := result.Inspect()
+	if !strings.Contains(inspected, "message_loc: ∅") {
+		t.Errorf("expected the missing locations to be inspected as empty, got:\n%s", inspected)
+	}
+}
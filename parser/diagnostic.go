@@ -0,0 +1,221 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+func (e *SyntaxError) Error() string {
+	return e.Message
+}
+
+// ParseError holds the syntax errors of a parse. Its message lists them with
+// their line and column, or alone without a Source, and errors.As finds the
+// individual *SyntaxError.
+type ParseError struct {
+	Errors []*SyntaxError
+	Source *Source
+}
+
+func (e *ParseError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, synError := range e.Errors {
+		if e.Source == nil {
+			messages = append(messages, synError.Message)
+			continue
+		}
+
+		messages = append(messages, fmt.Sprintf("%d:%d: %s", e.Source.StartLineOf(synError.Location), e.Source.StartCharacterColumnOf(synError.Location)+1, synError.Message))
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+func (e *ParseError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, synError := range e.Errors {
+		errs = append(errs, synError)
+	}
+
+	return errs
+}
+
+// Err returns a *ParseError holding the fatal syntax errors of the result, or
+// nil if there are none. Errors of other levels are left in SynError.
+func (p *ParseResult) Err() error {
+	var fatal []*SyntaxError
+	for _, synError := range p.SynError {
+		if synError.Level == SyntaxErrorFatal {
+			fatal = append(fatal, synError)
+		}
+	}
+
+	if len(fatal) == 0 {
+		return nil
+	}
+
+	return &ParseError{
+		Errors: fatal,
+		Source: p.Source,
+	}
+}
+
+const (
+	ansiReset   = "\x1b[0m"
+	ansiBold    = "\x1b[1m"
+	ansiRed     = "\x1b[31m"
+	ansiGreen   = "\x1b[32m"
+	ansiMagenta = "\x1b[35m"
+)
+
+// DiagnosticRenderer prints syntax errors and warnings the way compilers do:
+//
+//	app.rb:2:5: error: unexpected end-of-input
+//	  foo(
+//	      ^
+//
+// Lines and columns start at 1 and columns count characters. Filename is left
+// out of the header when it is empty. Without a source, like in a result
+// decoded from JSON without it, only the header is printed and without the
+// position.
+type DiagnosticRenderer struct {
+	Filename string
+	// Color highlights the output with ANSI escape sequences.
+	Color bool
+}
+
+// Render writes all errors of result followed by all warnings, each in source
+// order.
+func (r *DiagnosticRenderer) Render(w io.Writer, result *ParseResult) error {
+	synErrors := append([]*SyntaxError{}, result.SynError...)
+	sort.SliceStable(synErrors, func(i, j int) bool {
+		return synErrors[i].Location.StartOffset < synErrors[j].Location.StartOffset
+	})
+
+	for _, synError := range synErrors {
+		if err := r.RenderError(w, result.Source, synError); err != nil {
+			return err
+		}
+	}
+
+	synWarnings := append([]*SyntaxWarning{}, result.SynWarnings...)
+	sort.SliceStable(synWarnings, func(i, j int) bool {
+		return synWarnings[i].Location.StartOffset < synWarnings[j].Location.StartOffset
+	})
+
+	for _, synWarning := range synWarnings {
+		if err := r.RenderWarning(w, result.Source, synWarning); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *DiagnosticRenderer) RenderError(w io.Writer, source *Source, synError *SyntaxError) error {
+	return r.render(w, source, synError.Location, "error", ansiRed, synError.Message)
+}
+
+func (r *DiagnosticRenderer) RenderWarning(w io.Writer, source *Source, synWarning *SyntaxWarning) error {
+	return r.render(w, source, synWarning.Location, "warning", ansiMagenta, synWarning.Message)
+}
+
+func (r *DiagnosticRenderer) render(w io.Writer, source *Source, loc *Location, severity string, color string, message string) error {
+	var b strings.Builder
+
+	position := ""
+	if source != nil {
+		position = fmt.Sprintf("%d:%d:", source.StartLineOf(loc), source.StartCharacterColumnOf(loc)+1)
+	}
+	if r.Filename != "" {
+		position = r.Filename + ":" + position
+	}
+
+	if position != "" {
+		b.WriteString(r.paint(ansiBold, position))
+		b.WriteString(" ")
+	}
+	b.WriteString(r.paint(ansiBold+color, severity+":"))
+	b.WriteString(" ")
+	b.WriteString(r.paint(ansiBold, message))
+	b.WriteString("\n")
+
+	if source == nil {
+		return r.write(w, b.String())
+	}
+
+	lineStart := source.LineStart(loc.StartOffset)
+	line := sourceLine(source, loc.StartOffset)
+
+	// the marker lines up with the source line, tabs included
+	prefix := source.slice(lineStart, loc.StartOffset)
+	var marker strings.Builder
+	for len(prefix) > 0 {
+		c, size := utf8.DecodeRune(prefix)
+		if c == '\t' {
+			marker.WriteByte('\t')
+		} else {
+			marker.WriteByte(' ')
+		}
+		prefix = prefix[size:]
+	}
+
+	// locations spanning several lines are underlined up to the end of
+	// their first line
+	end := min(loc.EndOffset(), lineStart+uint32(len(line)))
+	width := utf8.RuneCount(source.slice(loc.StartOffset, end))
+
+	underline := "^" + strings.Repeat("~", max(width-1, 0))
+
+	b.WriteString("  ")
+	b.Write(line)
+	b.WriteString("\n  ")
+	b.WriteString(marker.String())
+	b.WriteString(r.paint(ansiBold+ansiGreen, underline))
+	b.WriteString("\n")
+
+	return r.write(w, b.String())
+}
+
+func (r *DiagnosticRenderer) write(w io.Writer, diagnostic string) error {
+	if _, err := io.WriteString(w, diagnostic); err != nil {
+		return fmt.Errorf("failed to write diagnostic: %w", err)
+	}
+
+	return nil
+}
+
+func (r *DiagnosticRenderer) paint(color string, text string) string {
+	if !r.Color {
+		return text
+	}
+
+	return color + text + ansiReset
+}
+
+// sourceLine returns the line offset is on without its line terminator.
+func sourceLine(source *Source, offset uint32) []byte {
+	index := source.lineIndex(offset)
+
+	end := uint32(len(source.Source))
+	if index+1 < len(source.LineOffsets) {
+		end = source.LineOffsets[index+1]
+	}
+
+	line := source.slice(source.LineOffsets[index], end)
+	line = trimSuffix(line, '\n')
+	line = trimSuffix(line, '\r')
+
+	return line
+}
+
+func trimSuffix(b []byte, c byte) []byte {
+	if len(b) > 0 && b[len(b)-1] == c {
+		return b[:len(b)-1]
+	}
+
+	return b
+}
@@ -0,0 +1,101 @@
+package parser_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+func TestParseResultErr(t *testing.T) {
+	if err := parse(t, "foo(1)").Err(); err != nil {
+		t.Errorf("expected no error, got %s", err)
+	}
+
+	err := parse(t, "foo(1\n\tbar(é, 1 +)").Err()
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	expected := "2:1: expected a `)` to close the arguments\n" +
+		"2:2: unexpected local variable or method, expecting end-of-input\n" +
+		"2:11: expected an expression after the operator"
+	if err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+
+	var synError *parser.SyntaxError
+	if !errors.As(err, &synError) || synError.Message != "expected a `)` to close the arguments" {
+		t.Errorf("expected to find the first syntax error, got %v", synError)
+	}
+}
+
+func TestParseResultErrOnlyFatal(t *testing.T) {
+	result := parse(t, "foo(1 +)")
+	if len(result.SynError) != 1 {
+		t.Fatalf("expected 1 syntax error, got %d", len(result.SynError))
+	}
+
+	fatal := result.SynError[0]
+	argument := parser.NewSyntaxError("unexpected argument", fatal.Location, parser.SyntaxErrorArgument, fatal.Type)
+	result.SynError = append(result.SynError, argument)
+
+	var parseError *parser.ParseError
+	if !errors.As(result.Err(), &parseError) {
+		t.Fatalf("expected a *ParseError, got %v", result.Err())
+	}
+
+	if len(parseError.Errors) != 1 || parseError.Errors[0] != fatal {
+		t.Errorf("expected only the fatal error, got %v", parseError.Errors)
+	}
+
+	result.SynError = []*parser.SyntaxError{argument}
+	if err := result.Err(); err != nil {
+		t.Errorf("expected no error without fatal errors, got %s", err)
+	}
+}
+
+func TestDiagnosticRenderer(t *testing.T) {
+	result := parse(t, "foo(1\n\tbar(é, 1 +)\np -a\n", parser.WithScopes([]string{"a"}))
+
+	var b strings.Builder
+	renderer := &parser.DiagnosticRenderer{Filename: "app.rb"}
+	if err := renderer.Render(&b, result); err != nil {
+		t.Fatalf("failed to render: %s", err)
+	}
+
+	expected := "app.rb:2:1: error: expected a `)` to close the arguments\n" +
+		"  \tbar(é, 1 +)\n" +
+		"  ^\n" +
+		"app.rb:2:2: error: unexpected local variable or method, expecting end-of-input\n" +
+		"  \tbar(é, 1 +)\n" +
+		"  \t^~~\n" +
+		"app.rb:2:11: error: expected an expression after the operator\n" +
+		"  \tbar(é, 1 +)\n" +
+		"  \t         ^\n" +
+		"app.rb:3:3: warning: ambiguous first argument; put parentheses or a space even after `-` operator\n" +
+		"  p -a\n" +
+		"    ^\n"
+	if b.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, b.String())
+	}
+}
+
+func TestDiagnosticRendererColor(t *testing.T) {
+	result := parse(t, "foo(")
+
+	var b strings.Builder
+	renderer := &parser.DiagnosticRenderer{Color: true}
+	if err := renderer.Render(&b, result); err != nil {
+		t.Fatalf("failed to render: %s", err)
+	}
+
+	if !strings.Contains(b.String(), "\x1b[1m\x1b[31merror:\x1b[0m") {
+		t.Errorf("expected a red error label, got %q", b.String())
+	}
+
+	if strings.HasPrefix(b.String(), ":") {
+		t.Errorf("expected no filename, got %q", b.String())
+	}
+}
@@ -0,0 +1,261 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// FileResult is the result of parsing the file at Path. Path ends up as is in
+// the exported diagnostics, so it should be relative to the repository root
+// for code scanning tools.
+type FileResult struct {
+	Path   string
+	Result *ParseResult
+}
+
+type diagnostic struct {
+	path     string
+	source   *Source
+	rule     string
+	severity string
+	level    string
+	message  string
+	loc      *Location
+}
+
+func collectDiagnostics(files []FileResult) []diagnostic {
+	diagnostics := make([]diagnostic, 0)
+
+	for _, file := range files {
+		start := len(diagnostics)
+
+		for _, synError := range file.Result.SynError {
+			diagnostics = append(diagnostics, diagnostic{
+				path:     file.Path,
+				source:   file.Result.Source,
+				rule:     synError.Type.String(),
+				severity: "error",
+				level:    synError.Level.String(),
+				message:  synError.Message,
+				loc:      synError.Location,
+			})
+		}
+
+		for _, synWarning := range file.Result.SynWarnings {
+			diagnostics = append(diagnostics, diagnostic{
+				path:     file.Path,
+				source:   file.Result.Source,
+				rule:     synWarning.Type.String(),
+				severity: "warning",
+				level:    synWarning.Level.String(),
+				message:  synWarning.Message,
+				loc:      synWarning.Location,
+			})
+		}
+
+		fileDiagnostics := diagnostics[start:]
+		sort.SliceStable(fileDiagnostics, func(i, j int) bool {
+			return fileDiagnostics[i].loc.StartOffset < fileDiagnostics[j].loc.StartOffset
+		})
+	}
+
+	return diagnostics
+}
+
+type jsonLine struct {
+	Path        string `json:"path"`
+	Rule        string `json:"rule"`
+	Severity    string `json:"severity"`
+	Level       string `json:"level"`
+	Message     string `json:"message"`
+	Line        int    `json:"line"`
+	Column      int    `json:"column"`
+	EndLine     int    `json:"endLine"`
+	EndColumn   int    `json:"endColumn"`
+	StartOffset uint32 `json:"startOffset"`
+	Length      uint32 `json:"length"`
+}
+
+// ExportJSONLines writes one JSON object per line for every error and warning
+// of files. Lines and columns start at 1 and columns count characters.
+func ExportJSONLines(w io.Writer, files ...FileResult) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+
+	for _, d := range collectDiagnostics(files) {
+		line := jsonLine{
+			Path:        d.path,
+			Rule:        d.rule,
+			Severity:    d.severity,
+			Level:       d.level,
+			Message:     d.message,
+			Line:        d.source.StartLineOf(d.loc),
+			Column:      d.source.StartCharacterColumnOf(d.loc) + 1,
+			EndLine:     d.source.EndLineOf(d.loc),
+			EndColumn:   d.source.EndCharacterColumnOf(d.loc) + 1,
+			StartOffset: d.loc.StartOffset,
+			Length:      d.loc.Length,
+		}
+
+		if err := encoder.Encode(line); err != nil {
+			return fmt.Errorf("failed to write diagnostic: %w", err)
+		}
+	}
+
+	return nil
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool       sarifTool     `json:"tool"`
+	ColumnKind string        `json:"columnKind"`
+	Results    []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int    `json:"startLine"`
+	StartColumn int    `json:"startColumn"`
+	EndLine     int    `json:"endLine"`
+	EndColumn   int    `json:"endColumn"`
+	ByteOffset  uint32 `json:"byteOffset"`
+	ByteLength  uint32 `json:"byteLength"`
+}
+
+// sarifLevel maps prism levels to SARIF levels. Verbose warnings are only
+// shown by ruby -W2, so they become notes.
+func sarifLevel(d diagnostic) string {
+	switch {
+	case d.severity == "error":
+		return "error"
+	case d.level == "verbose":
+		return "note"
+	}
+
+	return "warning"
+}
+
+// ExportSARIF writes a SARIF 2.1.0 log with a single run holding every error
+// and warning of files. Rules are the error and warning types that occur,
+// identified by their config.yml names, which unlike the numeric values don't
+// change between prism releases. Columns count UTF-16 code units as
+// SARIF expects by default.
+func ExportSARIF(w io.Writer, files ...FileResult) error {
+	diagnostics := collectDiagnostics(files)
+
+	levels := make(map[string]string)
+	for _, d := range diagnostics {
+		levels[d.rule] = sarifLevel(d)
+	}
+
+	ids := make([]string, 0, len(levels))
+	for id := range levels {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	rules := make([]sarifRule, 0, len(ids))
+	ruleIndex := make(map[string]int, len(ids))
+	for i, id := range ids {
+		rules = append(rules, sarifRule{ID: id, DefaultConfiguration: sarifConfiguration{Level: levels[id]}})
+		ruleIndex[id] = i
+	}
+
+	results := make([]sarifResult, 0, len(diagnostics))
+	for _, d := range diagnostics {
+		results = append(results, sarifResult{
+			RuleID:    d.rule,
+			RuleIndex: ruleIndex[d.rule],
+			Level:     sarifLevel(d),
+			Message:   sarifMessage{Text: d.message},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: d.path},
+					Region: sarifRegion{
+						StartLine:   d.source.StartLineOf(d.loc),
+						StartColumn: d.source.StartCodeUnitsColumnOf(d.loc) + 1,
+						EndLine:     d.source.EndLineOf(d.loc),
+						EndColumn:   d.source.EndCodeUnitsColumnOf(d.loc) + 1,
+						ByteOffset:  d.loc.StartOffset,
+						ByteLength:  d.loc.Length,
+					},
+				},
+			}},
+		})
+	}
+
+	log := sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "prism",
+				Version:        fmt.Sprintf("%d.%d.%d", majorVersion, minorVersion, patchVersion),
+				InformationURI: "https://github.com/ruby/prism",
+				Rules:          rules,
+			}},
+			ColumnKind: "utf16CodeUnits",
+			Results:    results,
+		}},
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(log); err != nil {
+		return fmt.Errorf("failed to write sarif log: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package parser_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func golden(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("failed to update %s: %s", path, err)
+		}
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read %s: %s", path, err)
+	}
+
+	if !bytes.Equal(expected, got) {
+		t.Errorf("%s does not match, got:\n%s", path, got)
+	}
+}
+
+func exportFiles(t *testing.T) []parser.FileResult {
+	return []parser.FileResult{
+		{Path: "app/models/user.rb", Result: parse(t, "class User\n  def name = \"😀\" + (\nend\n")},
+		{Path: "lib/clean.rb", Result: parse(t, "puts 1\n")},
+		{Path: "lib/warnings.rb", Result: parse(t, "a = 1\np -a\nif a = 1; end\n")},
+	}
+}
+
+func TestExportJSONLines(t *testing.T) {
+	var b bytes.Buffer
+	if err := parser.ExportJSONLines(&b, exportFiles(t)...); err != nil {
+		t.Fatalf("failed to export: %s", err)
+	}
+
+	golden(t, "diagnostics.jsonl", b.Bytes())
+}
+
+func TestExportSARIF(t *testing.T) {
+	var b bytes.Buffer
+	if err := parser.ExportSARIF(&b, exportFiles(t)...); err != nil {
+		t.Fatalf("failed to export: %s", err)
+	}
+
+	if !json.Valid(b.Bytes()) {
+		t.Fatalf("expected valid JSON")
+	}
+
+	golden(t, "diagnostics.sarif", b.Bytes())
+}
+
+func TestExportSARIFWithoutDiagnostics(t *testing.T) {
+	var b bytes.Buffer
+	if err := parser.ExportSARIF(&b, parser.FileResult{Path: "clean.rb", Result: parse(t, "1")}); err != nil {
+		t.Fatalf("failed to export: %s", err)
+	}
+
+	var log struct {
+		Runs []struct {
+			Results []interface{} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(b.Bytes(), &log); err != nil {
+		t.Fatalf("failed to decode: %s", err)
+	}
+
+	if len(log.Runs) != 1 || log.Runs[0].Results == nil || len(log.Runs[0].Results) != 0 {
+		t.Errorf("expected one run with an empty result list, got %s", b.String())
+	}
+}
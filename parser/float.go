@@ -0,0 +1,47 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// jsonFloat is a float64 that survives JSON when it isn't finite, like the
+// value of 1e400. Those are encoded as strings spelled the way inspect prints
+// them.
+type jsonFloat float64
+
+func (f jsonFloat) MarshalJSON() ([]byte, error) {
+	value := float64(f)
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		return json.Marshal(inspectFloat(value))
+	}
+
+	return json.Marshal(value)
+}
+
+func (f *jsonFloat) UnmarshalJSON(data []byte) error {
+	var spelled string
+	if err := json.Unmarshal(data, &spelled); err != nil {
+		var value float64
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+
+		*f = jsonFloat(value)
+		return nil
+	}
+
+	switch spelled {
+	case "Infinity":
+		*f = jsonFloat(math.Inf(1))
+	case "-Infinity":
+		*f = jsonFloat(math.Inf(-1))
+	case "NaN":
+		*f = jsonFloat(math.NaN())
+	default:
+		return fmt.Errorf("invalid float %q", spelled)
+	}
+
+	return nil
+}
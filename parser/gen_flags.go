@@ -8,12 +8,20 @@ const (
 	ARGUMENTS_NODE_CONTAINS_KEYWORD_SPLAT ArgumentsNodeFlags = 1 << 0
 )
 
+func (f ArgumentsNodeFlags) names() []string {
+	return flagNames(int16(f), []string{"contains_keyword_splat"})
+}
+
 type ArrayNodeFlags int16
 
 const (
 	ARRAY_NODE_CONTAINS_SPLAT ArrayNodeFlags = 1 << 0
 )
 
+func (f ArrayNodeFlags) names() []string {
+	return flagNames(int16(f), []string{"contains_splat"})
+}
+
 type CallNodeFlags int16
 
 const (
@@ -23,6 +31,10 @@ const (
 	CALL_NODE_IGNORE_VISIBILITY CallNodeFlags = 1 << 3
 )
 
+func (f CallNodeFlags) names() []string {
+	return flagNames(int16(f), []string{"safe_navigation", "variable_call", "attribute_write", "ignore_visibility"})
+}
+
 type EncodingFlags int16
 
 const (
@@ -30,6 +42,10 @@ const (
 	ENCODING_FORCED_BINARY_ENCODING EncodingFlags = 1 << 1
 )
 
+func (f EncodingFlags) names() []string {
+	return flagNames(int16(f), []string{"forced_utf8_encoding", "forced_binary_encoding"})
+}
+
 type IntegerBaseFlags int16
 
 const (
@@ -39,30 +55,50 @@ const (
 	INTEGER_BASE_HEXADECIMAL IntegerBaseFlags = 1 << 3
 )
 
+func (f IntegerBaseFlags) names() []string {
+	return flagNames(int16(f), []string{"binary", "decimal", "octal", "hexadecimal"})
+}
+
 type KeywordHashNodeFlags int16
 
 const (
 	KEYWORD_HASH_NODE_SYMBOL_KEYS KeywordHashNodeFlags = 1 << 0
 )
 
+func (f KeywordHashNodeFlags) names() []string {
+	return flagNames(int16(f), []string{"symbol_keys"})
+}
+
 type LoopFlags int16
 
 const (
 	LOOP_BEGIN_MODIFIER LoopFlags = 1 << 0
 )
 
+func (f LoopFlags) names() []string {
+	return flagNames(int16(f), []string{"begin_modifier"})
+}
+
 type ParameterFlags int16
 
 const (
 	PARAMETER_REPEATED_PARAMETER ParameterFlags = 1 << 0
 )
 
+func (f ParameterFlags) names() []string {
+	return flagNames(int16(f), []string{"repeated_parameter"})
+}
+
 type RangeFlags int16
 
 const (
 	RANGE_EXCLUDE_END RangeFlags = 1 << 0
 )
 
+func (f RangeFlags) names() []string {
+	return flagNames(int16(f), []string{"exclude_end"})
+}
+
 type RegularExpressionFlags int16
 
 const (
@@ -79,6 +115,10 @@ const (
 	REGULAR_EXPRESSION_FORCED_US_ASCII_ENCODING RegularExpressionFlags = 1 << 10
 )
 
+func (f RegularExpressionFlags) names() []string {
+	return flagNames(int16(f), []string{"ignore_case", "extended", "multi_line", "once", "euc_jp", "ascii_8bit", "windows_31j", "utf_8", "forced_utf8_encoding", "forced_binary_encoding", "forced_us_ascii_encoding"})
+}
+
 type StringFlags int16
 
 const (
@@ -87,6 +127,10 @@ const (
 	STRING_FROZEN                 StringFlags = 1 << 2
 )
 
+func (f StringFlags) names() []string {
+	return flagNames(int16(f), []string{"forced_utf8_encoding", "forced_binary_encoding", "frozen"})
+}
+
 type SymbolFlags int16
 
 const (
@@ -94,3 +138,7 @@ const (
 	SYMBOL_FORCED_BINARY_ENCODING   SymbolFlags = 1 << 1
 	SYMBOL_FORCED_US_ASCII_ENCODING SymbolFlags = 1 << 2
 )
+
+func (f SymbolFlags) names() []string {
+	return flagNames(int16(f), []string{"forced_utf8_encoding", "forced_binary_encoding", "forced_us_ascii_encoding"})
+}
@@ -33,7 +33,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		oldName := oldName_
 
-		keywordLoc, err := loadLocation(buff)
+		keywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordLoc: %w", err)
 		}
@@ -54,7 +54,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		oldName := oldName_
 
-		keywordLoc, err := loadLocation(buff)
+		keywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordLoc: %w", err)
 		}
@@ -75,7 +75,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		right := right_
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -96,7 +96,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		right := right_
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -109,7 +109,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 		}
 		flags := ArgumentsNodeFlags(flags_)
 
-		argumentsCount, err := loadVarUInt(buff)
+		argumentsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param argumentsCount: %w", err)
 		}
@@ -130,7 +130,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 		}
 		flags := ArrayNodeFlags(flags_)
 
-		elementsCount, err := loadVarUInt(buff)
+		elementsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param elementsCount: %w", err)
 		}
@@ -143,12 +143,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			}
 		}
 
-		openingLoc, err := loadOptionalLocation(buff)
+		openingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		closingLoc, err := loadOptionalLocation(buff)
+		closingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -162,7 +162,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		constant := constant_
 
-		requiredsCount, err := loadVarUInt(buff)
+		requiredsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param requiredsCount: %w", err)
 		}
@@ -182,7 +182,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		rest := rest_
 
-		postsCount, err := loadVarUInt(buff)
+		postsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param postsCount: %w", err)
 		}
@@ -195,12 +195,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			}
 		}
 
-		openingLoc, err := loadOptionalLocation(buff)
+		openingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		closingLoc, err := loadOptionalLocation(buff)
+		closingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -221,7 +221,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		value := value_
 
-		operatorLoc, err := loadOptionalLocation(buff)
+		operatorLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -235,7 +235,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		value := value_
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -249,7 +249,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		return NewBackReferenceReadNode(name, nodeLoc), nil
 	case 11:
-		beginKeywordLoc, err := loadOptionalLocation(buff)
+		beginKeywordLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param beginKeywordLoc: %w", err)
 		}
@@ -294,7 +294,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param ensureClause: expected EnsureNode, got %T: %w", ensureClause_, err)
 		}
 
-		endKeywordLoc, err := loadOptionalLocation(buff)
+		endKeywordLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param endKeywordLoc: %w", err)
 		}
@@ -308,7 +308,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		expression := expression_
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -347,12 +347,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		body := body_
 
-		openingLoc, err := loadLocation(buff)
+		openingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		closingLoc, err := loadLocation(buff)
+		closingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -370,12 +370,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadOptionalLocation(buff)
+		nameLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -392,7 +392,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param parameters: expected ParametersNode, got %T: %w", parameters_, err)
 		}
 
-		localsCount, err := loadVarUInt(buff)
+		localsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param localsCount: %w", err)
 		}
@@ -405,12 +405,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			}
 		}
 
-		openingLoc, err := loadOptionalLocation(buff)
+		openingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		closingLoc, err := loadOptionalLocation(buff)
+		closingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -427,7 +427,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param arguments: expected ArgumentsNode, got %T: %w", arguments_, err)
 		}
 
-		keywordLoc, err := loadLocation(buff)
+		keywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordLoc: %w", err)
 		}
@@ -447,12 +447,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		receiver := receiver_
 
-		callOperatorLoc, err := loadOptionalLocation(buff)
+		callOperatorLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param callOperatorLoc: %w", err)
 		}
 
-		messageLoc, err := loadOptionalLocation(buff)
+		messageLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param messageLoc: %w", err)
 		}
@@ -467,7 +467,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param writeName: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -494,7 +494,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		receiver := receiver_
 
-		callOperatorLoc, err := loadOptionalLocation(buff)
+		callOperatorLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param callOperatorLoc: %w", err)
 		}
@@ -504,12 +504,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		messageLoc, err := loadOptionalLocation(buff)
+		messageLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param messageLoc: %w", err)
 		}
 
-		openingLoc, err := loadOptionalLocation(buff)
+		openingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
@@ -524,7 +524,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param arguments: expected ArgumentsNode, got %T: %w", arguments_, err)
 		}
 
-		closingLoc, err := loadOptionalLocation(buff)
+		closingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -551,12 +551,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		receiver := receiver_
 
-		callOperatorLoc, err := loadOptionalLocation(buff)
+		callOperatorLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param callOperatorLoc: %w", err)
 		}
 
-		messageLoc, err := loadOptionalLocation(buff)
+		messageLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param messageLoc: %w", err)
 		}
@@ -576,7 +576,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param operator: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -603,12 +603,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		receiver := receiver_
 
-		callOperatorLoc, err := loadOptionalLocation(buff)
+		callOperatorLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param callOperatorLoc: %w", err)
 		}
 
-		messageLoc, err := loadOptionalLocation(buff)
+		messageLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param messageLoc: %w", err)
 		}
@@ -623,7 +623,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param writeName: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -650,7 +650,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		receiver := receiver_
 
-		callOperatorLoc, err := loadLocation(buff)
+		callOperatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param callOperatorLoc: %w", err)
 		}
@@ -660,7 +660,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		messageLoc, err := loadLocation(buff)
+		messageLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param messageLoc: %w", err)
 		}
@@ -681,7 +681,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		target := target_
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -695,7 +695,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		predicate := predicate_
 
-		conditionsCount, err := loadVarUInt(buff)
+		conditionsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param conditionsCount: %w", err)
 		}
@@ -718,12 +718,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param consequent: expected ElseNode, got %T: %w", consequent_, err)
 		}
 
-		caseKeywordLoc, err := loadLocation(buff)
+		caseKeywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param caseKeywordLoc: %w", err)
 		}
 
-		endKeywordLoc, err := loadLocation(buff)
+		endKeywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param endKeywordLoc: %w", err)
 		}
@@ -737,7 +737,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		predicate := predicate_
 
-		conditionsCount, err := loadVarUInt(buff)
+		conditionsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param conditionsCount: %w", err)
 		}
@@ -760,12 +760,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param consequent: expected ElseNode, got %T: %w", consequent_, err)
 		}
 
-		caseKeywordLoc, err := loadLocation(buff)
+		caseKeywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param caseKeywordLoc: %w", err)
 		}
 
-		endKeywordLoc, err := loadLocation(buff)
+		endKeywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param endKeywordLoc: %w", err)
 		}
@@ -777,7 +777,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param locals: %w", err)
 		}
 
-		classKeywordLoc, err := loadLocation(buff)
+		classKeywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param classKeywordLoc: %w", err)
 		}
@@ -789,7 +789,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		constantPath := constantPath_
 
-		inheritanceOperatorLoc, err := loadOptionalLocation(buff)
+		inheritanceOperatorLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param inheritanceOperatorLoc: %w", err)
 		}
@@ -808,7 +808,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		body := body_
 
-		endKeywordLoc, err := loadLocation(buff)
+		endKeywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param endKeywordLoc: %w", err)
 		}
@@ -825,12 +825,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -849,12 +849,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -878,12 +878,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -916,7 +916,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
@@ -928,7 +928,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		value := value_
 
-		operatorLoc, err := loadOptionalLocation(buff)
+		operatorLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -940,12 +940,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -964,12 +964,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -993,12 +993,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -1022,7 +1022,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param target: expected ConstantPathNode, got %T: %w", target_, err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -1050,7 +1050,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		child := child_
 
-		delimiterLoc, err := loadLocation(buff)
+		delimiterLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param delimiterLoc: %w", err)
 		}
@@ -1067,7 +1067,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param target: expected ConstantPathNode, got %T: %w", target_, err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -1096,7 +1096,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param target: expected ConstantPathNode, got %T: %w", target_, err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -1124,7 +1124,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		child := child_
 
-		delimiterLoc, err := loadLocation(buff)
+		delimiterLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param delimiterLoc: %w", err)
 		}
@@ -1141,7 +1141,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param target: expected ConstantPathNode, got %T: %w", target_, err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -1174,7 +1174,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
@@ -1186,21 +1186,23 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		value := value_
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
 
 		return NewConstantWriteNode(name, nameLoc, value, operatorLoc, nodeLoc), nil
 	case 45:
-		buff.readUInt32()
+		if _, err := buff.readUInt32(); err != nil {
+			return nil, fmt.Errorf("error reading serialized length: %w", err)
+		}
 
 		name, err := loadConstant(buff, pool)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
@@ -1234,39 +1236,39 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param locals: %w", err)
 		}
 
-		defKeywordLoc, err := loadLocation(buff)
+		defKeywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param defKeywordLoc: %w", err)
 		}
 
-		operatorLoc, err := loadOptionalLocation(buff)
+		operatorLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
 
-		lparenLoc, err := loadOptionalLocation(buff)
+		lparenLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param lparenLoc: %w", err)
 		}
 
-		rparenLoc, err := loadOptionalLocation(buff)
+		rparenLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param rparenLoc: %w", err)
 		}
 
-		equalLoc, err := loadOptionalLocation(buff)
+		equalLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param equalLoc: %w", err)
 		}
 
-		endKeywordLoc, err := loadOptionalLocation(buff)
+		endKeywordLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param endKeywordLoc: %w", err)
 		}
 
 		return NewDefNode(name, nameLoc, receiver, parameters, body, locals, defKeywordLoc, operatorLoc, lparenLoc, rparenLoc, equalLoc, endKeywordLoc, nodeLoc), nil
 	case 46:
-		lparenLoc, err := loadOptionalLocation(buff)
+		lparenLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param lparenLoc: %w", err)
 		}
@@ -1278,19 +1280,19 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		value := value_
 
-		rparenLoc, err := loadOptionalLocation(buff)
+		rparenLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param rparenLoc: %w", err)
 		}
 
-		keywordLoc, err := loadLocation(buff)
+		keywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordLoc: %w", err)
 		}
 
 		return NewDefinedNode(lparenLoc, value, rparenLoc, keywordLoc, nodeLoc), nil
 	case 47:
-		elseKeywordLoc, err := loadLocation(buff)
+		elseKeywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param elseKeywordLoc: %w", err)
 		}
@@ -1305,14 +1307,14 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param statements: expected StatementsNode, got %T: %w", statements_, err)
 		}
 
-		endKeywordLoc, err := loadOptionalLocation(buff)
+		endKeywordLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param endKeywordLoc: %w", err)
 		}
 
 		return NewElseNode(elseKeywordLoc, statements, endKeywordLoc, nodeLoc), nil
 	case 48:
-		openingLoc, err := loadLocation(buff)
+		openingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
@@ -1327,14 +1329,14 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param statements: expected StatementsNode, got %T: %w", statements_, err)
 		}
 
-		closingLoc, err := loadLocation(buff)
+		closingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
 
 		return NewEmbeddedStatementsNode(openingLoc, statements, closingLoc, nodeLoc), nil
 	case 49:
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -1348,7 +1350,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		return NewEmbeddedVariableNode(operatorLoc, variable, nodeLoc), nil
 	case 50:
-		ensureKeywordLoc, err := loadLocation(buff)
+		ensureKeywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param ensureKeywordLoc: %w", err)
 		}
@@ -1363,7 +1365,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param statements: expected StatementsNode, got %T: %w", statements_, err)
 		}
 
-		endKeywordLoc, err := loadLocation(buff)
+		endKeywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param endKeywordLoc: %w", err)
 		}
@@ -1386,7 +1388,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		left := left_
 
-		requiredsCount, err := loadVarUInt(buff)
+		requiredsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param requiredsCount: %w", err)
 		}
@@ -1406,12 +1408,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		right := right_
 
-		openingLoc, err := loadOptionalLocation(buff)
+		openingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		closingLoc, err := loadOptionalLocation(buff)
+		closingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -1438,14 +1440,17 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		right := right_
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
 
 		return NewFlipFlopNode(flags, left, right, operatorLoc, nodeLoc), nil
 	case 54:
-		value := buff.readFloat64()
+		value, err := buff.readFloat64()
+		if err != nil {
+			return nil, fmt.Errorf("error reading param value: %w", err)
+		}
 
 		return NewFloatNode(value, nodeLoc), nil
 	case 55:
@@ -1473,22 +1478,22 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param statements: expected StatementsNode, got %T: %w", statements_, err)
 		}
 
-		forKeywordLoc, err := loadLocation(buff)
+		forKeywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param forKeywordLoc: %w", err)
 		}
 
-		inKeywordLoc, err := loadLocation(buff)
+		inKeywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param inKeywordLoc: %w", err)
 		}
 
-		doKeywordLoc, err := loadOptionalLocation(buff)
+		doKeywordLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param doKeywordLoc: %w", err)
 		}
 
-		endKeywordLoc, err := loadLocation(buff)
+		endKeywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param endKeywordLoc: %w", err)
 		}
@@ -1516,12 +1521,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -1540,12 +1545,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -1569,12 +1574,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -1607,7 +1612,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
@@ -1619,19 +1624,19 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		value := value_
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
 
 		return NewGlobalVariableWriteNode(name, nameLoc, value, operatorLoc, nodeLoc), nil
 	case 65:
-		openingLoc, err := loadLocation(buff)
+		openingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		elementsCount, err := loadVarUInt(buff)
+		elementsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param elementsCount: %w", err)
 		}
@@ -1644,7 +1649,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			}
 		}
 
-		closingLoc, err := loadLocation(buff)
+		closingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -1658,7 +1663,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		constant := constant_
 
-		elementsCount, err := loadVarUInt(buff)
+		elementsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param elementsCount: %w", err)
 		}
@@ -1678,19 +1683,19 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		rest := rest_
 
-		openingLoc, err := loadOptionalLocation(buff)
+		openingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		closingLoc, err := loadOptionalLocation(buff)
+		closingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
 
 		return NewHashPatternNode(constant, elements, rest, openingLoc, closingLoc, nodeLoc), nil
 	case 67:
-		ifKeywordLoc, err := loadOptionalLocation(buff)
+		ifKeywordLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param ifKeywordLoc: %w", err)
 		}
@@ -1702,7 +1707,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		predicate := predicate_
 
-		thenKeywordLoc, err := loadOptionalLocation(buff)
+		thenKeywordLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param thenKeywordLoc: %w", err)
 		}
@@ -1724,7 +1729,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		consequent := consequent_
 
-		endKeywordLoc, err := loadOptionalLocation(buff)
+		endKeywordLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param endKeywordLoc: %w", err)
 		}
@@ -1768,12 +1773,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param statements: expected StatementsNode, got %T: %w", statements_, err)
 		}
 
-		inLoc, err := loadLocation(buff)
+		inLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param inLoc: %w", err)
 		}
 
-		thenLoc, err := loadOptionalLocation(buff)
+		thenLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param thenLoc: %w", err)
 		}
@@ -1793,12 +1798,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		receiver := receiver_
 
-		callOperatorLoc, err := loadOptionalLocation(buff)
+		callOperatorLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param callOperatorLoc: %w", err)
 		}
 
-		openingLoc, err := loadLocation(buff)
+		openingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
@@ -1813,7 +1818,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param arguments: expected ArgumentsNode, got %T: %w", arguments_, err)
 		}
 
-		closingLoc, err := loadLocation(buff)
+		closingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -1825,7 +1830,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		block := block_
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -1852,12 +1857,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		receiver := receiver_
 
-		callOperatorLoc, err := loadOptionalLocation(buff)
+		callOperatorLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param callOperatorLoc: %w", err)
 		}
 
-		openingLoc, err := loadLocation(buff)
+		openingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
@@ -1872,7 +1877,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param arguments: expected ArgumentsNode, got %T: %w", arguments_, err)
 		}
 
-		closingLoc, err := loadLocation(buff)
+		closingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -1889,7 +1894,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param operator: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -1916,12 +1921,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		receiver := receiver_
 
-		callOperatorLoc, err := loadOptionalLocation(buff)
+		callOperatorLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param callOperatorLoc: %w", err)
 		}
 
-		openingLoc, err := loadLocation(buff)
+		openingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
@@ -1936,7 +1941,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param arguments: expected ArgumentsNode, got %T: %w", arguments_, err)
 		}
 
-		closingLoc, err := loadLocation(buff)
+		closingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -1948,7 +1953,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		block := block_
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -1975,7 +1980,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		receiver := receiver_
 
-		openingLoc, err := loadLocation(buff)
+		openingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
@@ -1990,7 +1995,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param arguments: expected ArgumentsNode, got %T: %w", arguments_, err)
 		}
 
-		closingLoc, err := loadLocation(buff)
+		closingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -2009,12 +2014,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -2033,12 +2038,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -2062,12 +2067,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -2100,7 +2105,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
@@ -2112,7 +2117,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		value := value_
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -2138,12 +2143,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 		}
 		flags := RegularExpressionFlags(flags_)
 
-		openingLoc, err := loadLocation(buff)
+		openingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		partsCount, err := loadVarUInt(buff)
+		partsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param partsCount: %w", err)
 		}
@@ -2156,7 +2161,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			}
 		}
 
-		closingLoc, err := loadLocation(buff)
+		closingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -2169,12 +2174,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 		}
 		flags := RegularExpressionFlags(flags_)
 
-		openingLoc, err := loadLocation(buff)
+		openingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		partsCount, err := loadVarUInt(buff)
+		partsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param partsCount: %w", err)
 		}
@@ -2187,19 +2192,19 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			}
 		}
 
-		closingLoc, err := loadLocation(buff)
+		closingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
 
 		return NewInterpolatedRegularExpressionNode(flags, openingLoc, parts, closingLoc, nodeLoc), nil
 	case 85:
-		openingLoc, err := loadOptionalLocation(buff)
+		openingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		partsCount, err := loadVarUInt(buff)
+		partsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param partsCount: %w", err)
 		}
@@ -2212,19 +2217,19 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			}
 		}
 
-		closingLoc, err := loadOptionalLocation(buff)
+		closingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
 
 		return NewInterpolatedStringNode(openingLoc, parts, closingLoc, nodeLoc), nil
 	case 86:
-		openingLoc, err := loadOptionalLocation(buff)
+		openingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		partsCount, err := loadVarUInt(buff)
+		partsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param partsCount: %w", err)
 		}
@@ -2237,19 +2242,19 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			}
 		}
 
-		closingLoc, err := loadOptionalLocation(buff)
+		closingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
 
 		return NewInterpolatedSymbolNode(openingLoc, parts, closingLoc, nodeLoc), nil
 	case 87:
-		openingLoc, err := loadLocation(buff)
+		openingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		partsCount, err := loadVarUInt(buff)
+		partsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param partsCount: %w", err)
 		}
@@ -2262,7 +2267,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			}
 		}
 
-		closingLoc, err := loadLocation(buff)
+		closingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -2277,7 +2282,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 		}
 		flags := KeywordHashNodeFlags(flags_)
 
-		elementsCount, err := loadVarUInt(buff)
+		elementsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param elementsCount: %w", err)
 		}
@@ -2303,12 +2308,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadOptionalLocation(buff)
+		nameLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -2320,17 +2325,17 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param locals: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
 
-		openingLoc, err := loadLocation(buff)
+		openingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		closingLoc, err := loadLocation(buff)
+		closingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -2351,12 +2356,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		return NewLambdaNode(locals, operatorLoc, openingLoc, closingLoc, parameters, body, nodeLoc), nil
 	case 92:
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -2380,12 +2385,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		return NewLocalVariableAndWriteNode(nameLoc, operatorLoc, value, name, depth, nodeLoc), nil
 	case 93:
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -2414,12 +2419,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		return NewLocalVariableOperatorWriteNode(nameLoc, operatorLoc, value, name, operator, depth, nodeLoc), nil
 	case 94:
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -2477,7 +2482,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param depth: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
@@ -2489,7 +2494,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		value := value_
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -2502,17 +2507,17 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 		}
 		flags := RegularExpressionFlags(flags_)
 
-		openingLoc, err := loadLocation(buff)
+		openingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		contentLoc, err := loadLocation(buff)
+		contentLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param contentLoc: %w", err)
 		}
 
-		closingLoc, err := loadLocation(buff)
+		closingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -2539,7 +2544,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		pattern := pattern_
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -2560,7 +2565,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		pattern := pattern_
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -2577,7 +2582,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param call: expected CallNode, got %T: %w", call_, err)
 		}
 
-		targetsCount, err := loadVarUInt(buff)
+		targetsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param targetsCount: %w", err)
 		}
@@ -2599,7 +2604,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param locals: %w", err)
 		}
 
-		moduleKeywordLoc, err := loadLocation(buff)
+		moduleKeywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param moduleKeywordLoc: %w", err)
 		}
@@ -2618,7 +2623,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		body := body_
 
-		endKeywordLoc, err := loadLocation(buff)
+		endKeywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param endKeywordLoc: %w", err)
 		}
@@ -2630,7 +2635,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		return NewModuleNode(locals, moduleKeywordLoc, constantPath, body, endKeywordLoc, name, nodeLoc), nil
 	case 104:
-		leftsCount, err := loadVarUInt(buff)
+		leftsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param leftsCount: %w", err)
 		}
@@ -2650,7 +2655,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		rest := rest_
 
-		rightsCount, err := loadVarUInt(buff)
+		rightsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param rightsCount: %w", err)
 		}
@@ -2663,19 +2668,19 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			}
 		}
 
-		lparenLoc, err := loadOptionalLocation(buff)
+		lparenLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param lparenLoc: %w", err)
 		}
 
-		rparenLoc, err := loadOptionalLocation(buff)
+		rparenLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param rparenLoc: %w", err)
 		}
 
 		return NewMultiTargetNode(lefts, rest, rights, lparenLoc, rparenLoc, nodeLoc), nil
 	case 105:
-		leftsCount, err := loadVarUInt(buff)
+		leftsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param leftsCount: %w", err)
 		}
@@ -2695,7 +2700,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		rest := rest_
 
-		rightsCount, err := loadVarUInt(buff)
+		rightsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param rightsCount: %w", err)
 		}
@@ -2708,17 +2713,17 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			}
 		}
 
-		lparenLoc, err := loadOptionalLocation(buff)
+		lparenLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param lparenLoc: %w", err)
 		}
 
-		rparenLoc, err := loadOptionalLocation(buff)
+		rparenLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param rparenLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -2742,7 +2747,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param arguments: expected ArgumentsNode, got %T: %w", arguments_, err)
 		}
 
-		keywordLoc, err := loadLocation(buff)
+		keywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordLoc: %w", err)
 		}
@@ -2751,12 +2756,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 	case 107:
 		return NewNilNode(nodeLoc), nil
 	case 108:
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
 
-		keywordLoc, err := loadLocation(buff)
+		keywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordLoc: %w", err)
 		}
@@ -2788,7 +2793,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
@@ -2813,12 +2818,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -2846,14 +2851,14 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		right := right_
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
 
 		return NewOrNode(left, right, operatorLoc, nodeLoc), nil
 	case 114:
-		requiredsCount, err := loadVarUInt(buff)
+		requiredsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param requiredsCount: %w", err)
 		}
@@ -2866,7 +2871,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			}
 		}
 
-		optionalsCount, err := loadVarUInt(buff)
+		optionalsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param optionalsCount: %w", err)
 		}
@@ -2886,7 +2891,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		rest := rest_
 
-		postsCount, err := loadVarUInt(buff)
+		postsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param postsCount: %w", err)
 		}
@@ -2899,7 +2904,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			}
 		}
 
-		keywordsCount, err := loadVarUInt(buff)
+		keywordsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordsCount: %w", err)
 		}
@@ -2938,12 +2943,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		body := body_
 
-		openingLoc, err := loadLocation(buff)
+		openingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		closingLoc, err := loadLocation(buff)
+		closingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -2957,17 +2962,17 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		expression := expression_
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
 
-		lparenLoc, err := loadLocation(buff)
+		lparenLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param lparenLoc: %w", err)
 		}
 
-		rparenLoc, err := loadLocation(buff)
+		rparenLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param rparenLoc: %w", err)
 		}
@@ -2981,7 +2986,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		variable := variable_
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -2998,17 +3003,17 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param statements: expected StatementsNode, got %T: %w", statements_, err)
 		}
 
-		keywordLoc, err := loadLocation(buff)
+		keywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordLoc: %w", err)
 		}
 
-		openingLoc, err := loadLocation(buff)
+		openingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		closingLoc, err := loadLocation(buff)
+		closingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -3025,17 +3030,17 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param statements: expected StatementsNode, got %T: %w", statements_, err)
 		}
 
-		keywordLoc, err := loadLocation(buff)
+		keywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordLoc: %w", err)
 		}
 
-		openingLoc, err := loadLocation(buff)
+		openingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		closingLoc, err := loadLocation(buff)
+		closingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -3079,7 +3084,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		right := right_
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -3103,17 +3108,17 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 		}
 		flags := RegularExpressionFlags(flags_)
 
-		openingLoc, err := loadLocation(buff)
+		openingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		contentLoc, err := loadLocation(buff)
+		contentLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param contentLoc: %w", err)
 		}
 
-		closingLoc, err := loadLocation(buff)
+		closingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -3137,7 +3142,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadLocation(buff)
+		nameLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
@@ -3164,7 +3169,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		expression := expression_
 
-		keywordLoc, err := loadLocation(buff)
+		keywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordLoc: %w", err)
 		}
@@ -3178,12 +3183,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		return NewRescueModifierNode(expression, keywordLoc, rescueExpression, nodeLoc), nil
 	case 128:
-		keywordLoc, err := loadLocation(buff)
+		keywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordLoc: %w", err)
 		}
 
-		exceptionsCount, err := loadVarUInt(buff)
+		exceptionsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param exceptionsCount: %w", err)
 		}
@@ -3196,7 +3201,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			}
 		}
 
-		operatorLoc, err := loadOptionalLocation(buff)
+		operatorLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -3241,12 +3246,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param name: %w", err)
 		}
 
-		nameLoc, err := loadOptionalLocation(buff)
+		nameLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param nameLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -3255,7 +3260,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 	case 130:
 		return NewRetryNode(nodeLoc), nil
 	case 131:
-		keywordLoc, err := loadLocation(buff)
+		keywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordLoc: %w", err)
 		}
@@ -3279,12 +3284,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param locals: %w", err)
 		}
 
-		classKeywordLoc, err := loadLocation(buff)
+		classKeywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param classKeywordLoc: %w", err)
 		}
 
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -3303,7 +3308,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		body := body_
 
-		endKeywordLoc, err := loadLocation(buff)
+		endKeywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param endKeywordLoc: %w", err)
 		}
@@ -3322,7 +3327,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 	case 136:
 		return NewSourceLineNode(nodeLoc), nil
 	case 137:
-		operatorLoc, err := loadLocation(buff)
+		operatorLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param operatorLoc: %w", err)
 		}
@@ -3336,7 +3341,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		return NewSplatNode(operatorLoc, expression, nodeLoc), nil
 	case 138:
-		bodyCount, err := loadVarUInt(buff)
+		bodyCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param bodyCount: %w", err)
 		}
@@ -3357,17 +3362,17 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 		}
 		flags := StringFlags(flags_)
 
-		openingLoc, err := loadOptionalLocation(buff)
+		openingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		contentLoc, err := loadLocation(buff)
+		contentLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param contentLoc: %w", err)
 		}
 
-		closingLoc, err := loadOptionalLocation(buff)
+		closingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -3380,12 +3385,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		return NewStringNode(flags, openingLoc, contentLoc, closingLoc, unescaped, nodeLoc), nil
 	case 140:
-		keywordLoc, err := loadLocation(buff)
+		keywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordLoc: %w", err)
 		}
 
-		lparenLoc, err := loadOptionalLocation(buff)
+		lparenLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param lparenLoc: %w", err)
 		}
@@ -3400,7 +3405,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param arguments: expected ArgumentsNode, got %T: %w", arguments_, err)
 		}
 
-		rparenLoc, err := loadOptionalLocation(buff)
+		rparenLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param rparenLoc: %w", err)
 		}
@@ -3420,17 +3425,17 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 		}
 		flags := SymbolFlags(flags_)
 
-		openingLoc, err := loadOptionalLocation(buff)
+		openingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		valueLoc, err := loadOptionalLocation(buff)
+		valueLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param valueLoc: %w", err)
 		}
 
-		closingLoc, err := loadOptionalLocation(buff)
+		closingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -3445,7 +3450,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 	case 142:
 		return NewTrueNode(nodeLoc), nil
 	case 143:
-		namesCount, err := loadVarUInt(buff)
+		namesCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param namesCount: %w", err)
 		}
@@ -3458,14 +3463,14 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			}
 		}
 
-		keywordLoc, err := loadLocation(buff)
+		keywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordLoc: %w", err)
 		}
 
 		return NewUndefNode(names, keywordLoc, nodeLoc), nil
 	case 144:
-		keywordLoc, err := loadLocation(buff)
+		keywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordLoc: %w", err)
 		}
@@ -3477,7 +3482,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		predicate := predicate_
 
-		thenKeywordLoc, err := loadOptionalLocation(buff)
+		thenKeywordLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param thenKeywordLoc: %w", err)
 		}
@@ -3502,7 +3507,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param consequent: expected ElseNode, got %T: %w", consequent_, err)
 		}
 
-		endKeywordLoc, err := loadOptionalLocation(buff)
+		endKeywordLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param endKeywordLoc: %w", err)
 		}
@@ -3515,12 +3520,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 		}
 		flags := LoopFlags(flags_)
 
-		keywordLoc, err := loadLocation(buff)
+		keywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordLoc: %w", err)
 		}
 
-		closingLoc, err := loadOptionalLocation(buff)
+		closingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -3544,12 +3549,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		return NewUntilNode(flags, keywordLoc, closingLoc, predicate, statements, nodeLoc), nil
 	case 146:
-		keywordLoc, err := loadLocation(buff)
+		keywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordLoc: %w", err)
 		}
 
-		conditionsCount, err := loadVarUInt(buff)
+		conditionsCount, err := loadCount(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param conditionsCount: %w", err)
 		}
@@ -3562,7 +3567,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			}
 		}
 
-		thenKeywordLoc, err := loadOptionalLocation(buff)
+		thenKeywordLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param thenKeywordLoc: %w", err)
 		}
@@ -3585,12 +3590,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 		}
 		flags := LoopFlags(flags_)
 
-		keywordLoc, err := loadLocation(buff)
+		keywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordLoc: %w", err)
 		}
 
-		closingLoc, err := loadOptionalLocation(buff)
+		closingLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -3620,17 +3625,17 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 		}
 		flags := EncodingFlags(flags_)
 
-		openingLoc, err := loadLocation(buff)
+		openingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param openingLoc: %w", err)
 		}
 
-		contentLoc, err := loadLocation(buff)
+		contentLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param contentLoc: %w", err)
 		}
 
-		closingLoc, err := loadLocation(buff)
+		closingLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param closingLoc: %w", err)
 		}
@@ -3643,12 +3648,12 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 
 		return NewXStringNode(flags, openingLoc, contentLoc, closingLoc, unescaped, nodeLoc), nil
 	case 149:
-		keywordLoc, err := loadLocation(buff)
+		keywordLoc, err := loadLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param keywordLoc: %w", err)
 		}
 
-		lparenLoc, err := loadOptionalLocation(buff)
+		lparenLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param lparenLoc: %w", err)
 		}
@@ -3663,7 +3668,7 @@ func loadNode(buff *buffer, src []byte, pool *constantPool) (Node, error) {
 			return nil, fmt.Errorf("error reading param arguments: expected ArgumentsNode, got %T: %w", arguments_, err)
 		}
 
-		rparenLoc, err := loadOptionalLocation(buff)
+		rparenLoc, err := loadOptionalLocationField(buff)
 		if err != nil {
 			return nil, fmt.Errorf("error reading param rparenLoc: %w", err)
 		}
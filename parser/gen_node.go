@@ -4,6 +4,7 @@ package parser
 
 import (
 	"encoding/json"
+	"fmt"
 	"math/big"
 )
 
@@ -11,10 +12,1401 @@ type NodeVisitor interface {
 	Visit(Node)
 }
 
+// Visitor has one method per node type. Node.Accept calls the method matching
+// the type of the node.
+type Visitor interface {
+	VisitAliasGlobalVariableNode(node *AliasGlobalVariableNode)
+	VisitAliasMethodNode(node *AliasMethodNode)
+	VisitAlternationPatternNode(node *AlternationPatternNode)
+	VisitAndNode(node *AndNode)
+	VisitArgumentsNode(node *ArgumentsNode)
+	VisitArrayNode(node *ArrayNode)
+	VisitArrayPatternNode(node *ArrayPatternNode)
+	VisitAssocNode(node *AssocNode)
+	VisitAssocSplatNode(node *AssocSplatNode)
+	VisitBackReferenceReadNode(node *BackReferenceReadNode)
+	VisitBeginNode(node *BeginNode)
+	VisitBlockArgumentNode(node *BlockArgumentNode)
+	VisitBlockLocalVariableNode(node *BlockLocalVariableNode)
+	VisitBlockNode(node *BlockNode)
+	VisitBlockParameterNode(node *BlockParameterNode)
+	VisitBlockParametersNode(node *BlockParametersNode)
+	VisitBreakNode(node *BreakNode)
+	VisitCallAndWriteNode(node *CallAndWriteNode)
+	VisitCallNode(node *CallNode)
+	VisitCallOperatorWriteNode(node *CallOperatorWriteNode)
+	VisitCallOrWriteNode(node *CallOrWriteNode)
+	VisitCallTargetNode(node *CallTargetNode)
+	VisitCapturePatternNode(node *CapturePatternNode)
+	VisitCaseMatchNode(node *CaseMatchNode)
+	VisitCaseNode(node *CaseNode)
+	VisitClassNode(node *ClassNode)
+	VisitClassVariableAndWriteNode(node *ClassVariableAndWriteNode)
+	VisitClassVariableOperatorWriteNode(node *ClassVariableOperatorWriteNode)
+	VisitClassVariableOrWriteNode(node *ClassVariableOrWriteNode)
+	VisitClassVariableReadNode(node *ClassVariableReadNode)
+	VisitClassVariableTargetNode(node *ClassVariableTargetNode)
+	VisitClassVariableWriteNode(node *ClassVariableWriteNode)
+	VisitConstantAndWriteNode(node *ConstantAndWriteNode)
+	VisitConstantOperatorWriteNode(node *ConstantOperatorWriteNode)
+	VisitConstantOrWriteNode(node *ConstantOrWriteNode)
+	VisitConstantPathAndWriteNode(node *ConstantPathAndWriteNode)
+	VisitConstantPathNode(node *ConstantPathNode)
+	VisitConstantPathOperatorWriteNode(node *ConstantPathOperatorWriteNode)
+	VisitConstantPathOrWriteNode(node *ConstantPathOrWriteNode)
+	VisitConstantPathTargetNode(node *ConstantPathTargetNode)
+	VisitConstantPathWriteNode(node *ConstantPathWriteNode)
+	VisitConstantReadNode(node *ConstantReadNode)
+	VisitConstantTargetNode(node *ConstantTargetNode)
+	VisitConstantWriteNode(node *ConstantWriteNode)
+	VisitDefNode(node *DefNode)
+	VisitDefinedNode(node *DefinedNode)
+	VisitElseNode(node *ElseNode)
+	VisitEmbeddedStatementsNode(node *EmbeddedStatementsNode)
+	VisitEmbeddedVariableNode(node *EmbeddedVariableNode)
+	VisitEnsureNode(node *EnsureNode)
+	VisitFalseNode(node *FalseNode)
+	VisitFindPatternNode(node *FindPatternNode)
+	VisitFlipFlopNode(node *FlipFlopNode)
+	VisitFloatNode(node *FloatNode)
+	VisitForNode(node *ForNode)
+	VisitForwardingArgumentsNode(node *ForwardingArgumentsNode)
+	VisitForwardingParameterNode(node *ForwardingParameterNode)
+	VisitForwardingSuperNode(node *ForwardingSuperNode)
+	VisitGlobalVariableAndWriteNode(node *GlobalVariableAndWriteNode)
+	VisitGlobalVariableOperatorWriteNode(node *GlobalVariableOperatorWriteNode)
+	VisitGlobalVariableOrWriteNode(node *GlobalVariableOrWriteNode)
+	VisitGlobalVariableReadNode(node *GlobalVariableReadNode)
+	VisitGlobalVariableTargetNode(node *GlobalVariableTargetNode)
+	VisitGlobalVariableWriteNode(node *GlobalVariableWriteNode)
+	VisitHashNode(node *HashNode)
+	VisitHashPatternNode(node *HashPatternNode)
+	VisitIfNode(node *IfNode)
+	VisitImaginaryNode(node *ImaginaryNode)
+	VisitImplicitNode(node *ImplicitNode)
+	VisitImplicitRestNode(node *ImplicitRestNode)
+	VisitInNode(node *InNode)
+	VisitIndexAndWriteNode(node *IndexAndWriteNode)
+	VisitIndexOperatorWriteNode(node *IndexOperatorWriteNode)
+	VisitIndexOrWriteNode(node *IndexOrWriteNode)
+	VisitIndexTargetNode(node *IndexTargetNode)
+	VisitInstanceVariableAndWriteNode(node *InstanceVariableAndWriteNode)
+	VisitInstanceVariableOperatorWriteNode(node *InstanceVariableOperatorWriteNode)
+	VisitInstanceVariableOrWriteNode(node *InstanceVariableOrWriteNode)
+	VisitInstanceVariableReadNode(node *InstanceVariableReadNode)
+	VisitInstanceVariableTargetNode(node *InstanceVariableTargetNode)
+	VisitInstanceVariableWriteNode(node *InstanceVariableWriteNode)
+	VisitIntegerNode(node *IntegerNode)
+	VisitInterpolatedMatchLastLineNode(node *InterpolatedMatchLastLineNode)
+	VisitInterpolatedRegularExpressionNode(node *InterpolatedRegularExpressionNode)
+	VisitInterpolatedStringNode(node *InterpolatedStringNode)
+	VisitInterpolatedSymbolNode(node *InterpolatedSymbolNode)
+	VisitInterpolatedXStringNode(node *InterpolatedXStringNode)
+	VisitItParametersNode(node *ItParametersNode)
+	VisitKeywordHashNode(node *KeywordHashNode)
+	VisitKeywordRestParameterNode(node *KeywordRestParameterNode)
+	VisitLambdaNode(node *LambdaNode)
+	VisitLocalVariableAndWriteNode(node *LocalVariableAndWriteNode)
+	VisitLocalVariableOperatorWriteNode(node *LocalVariableOperatorWriteNode)
+	VisitLocalVariableOrWriteNode(node *LocalVariableOrWriteNode)
+	VisitLocalVariableReadNode(node *LocalVariableReadNode)
+	VisitLocalVariableTargetNode(node *LocalVariableTargetNode)
+	VisitLocalVariableWriteNode(node *LocalVariableWriteNode)
+	VisitMatchLastLineNode(node *MatchLastLineNode)
+	VisitMatchPredicateNode(node *MatchPredicateNode)
+	VisitMatchRequiredNode(node *MatchRequiredNode)
+	VisitMatchWriteNode(node *MatchWriteNode)
+	VisitMissingNode(node *MissingNode)
+	VisitModuleNode(node *ModuleNode)
+	VisitMultiTargetNode(node *MultiTargetNode)
+	VisitMultiWriteNode(node *MultiWriteNode)
+	VisitNextNode(node *NextNode)
+	VisitNilNode(node *NilNode)
+	VisitNoKeywordsParameterNode(node *NoKeywordsParameterNode)
+	VisitNumberedParametersNode(node *NumberedParametersNode)
+	VisitNumberedReferenceReadNode(node *NumberedReferenceReadNode)
+	VisitOptionalKeywordParameterNode(node *OptionalKeywordParameterNode)
+	VisitOptionalParameterNode(node *OptionalParameterNode)
+	VisitOrNode(node *OrNode)
+	VisitParametersNode(node *ParametersNode)
+	VisitParenthesesNode(node *ParenthesesNode)
+	VisitPinnedExpressionNode(node *PinnedExpressionNode)
+	VisitPinnedVariableNode(node *PinnedVariableNode)
+	VisitPostExecutionNode(node *PostExecutionNode)
+	VisitPreExecutionNode(node *PreExecutionNode)
+	VisitProgramNode(node *ProgramNode)
+	VisitRangeNode(node *RangeNode)
+	VisitRationalNode(node *RationalNode)
+	VisitRedoNode(node *RedoNode)
+	VisitRegularExpressionNode(node *RegularExpressionNode)
+	VisitRequiredKeywordParameterNode(node *RequiredKeywordParameterNode)
+	VisitRequiredParameterNode(node *RequiredParameterNode)
+	VisitRescueModifierNode(node *RescueModifierNode)
+	VisitRescueNode(node *RescueNode)
+	VisitRestParameterNode(node *RestParameterNode)
+	VisitRetryNode(node *RetryNode)
+	VisitReturnNode(node *ReturnNode)
+	VisitSelfNode(node *SelfNode)
+	VisitSingletonClassNode(node *SingletonClassNode)
+	VisitSourceEncodingNode(node *SourceEncodingNode)
+	VisitSourceFileNode(node *SourceFileNode)
+	VisitSourceLineNode(node *SourceLineNode)
+	VisitSplatNode(node *SplatNode)
+	VisitStatementsNode(node *StatementsNode)
+	VisitStringNode(node *StringNode)
+	VisitSuperNode(node *SuperNode)
+	VisitSymbolNode(node *SymbolNode)
+	VisitTrueNode(node *TrueNode)
+	VisitUndefNode(node *UndefNode)
+	VisitUnlessNode(node *UnlessNode)
+	VisitUntilNode(node *UntilNode)
+	VisitWhenNode(node *WhenNode)
+	VisitWhileNode(node *WhileNode)
+	VisitXStringNode(node *XStringNode)
+	VisitYieldNode(node *YieldNode)
+}
+
+// BaseVisitor is a Visitor that walks the children of every node. Embed it and
+// override the methods for the nodes you are interested in; call VisitChildren
+// from an override to keep walking below that node.
+type BaseVisitor struct {
+	visitor Visitor
+}
+
+// NewBaseVisitor returns a BaseVisitor that dispatches children to visitor,
+// which is usually the struct embedding the BaseVisitor. A nil visitor walks
+// the whole tree without doing anything.
+func NewBaseVisitor(visitor Visitor) *BaseVisitor {
+	base := &BaseVisitor{visitor: visitor}
+	if visitor == nil {
+		base.visitor = base
+	}
+
+	return base
+}
+
+func (v *BaseVisitor) VisitChildren(node Node) {
+	for _, child := range node.Children() {
+		child.Accept(v.visitor)
+	}
+}
+
+func (v *BaseVisitor) VisitAliasGlobalVariableNode(node *AliasGlobalVariableNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitAliasMethodNode(node *AliasMethodNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitAlternationPatternNode(node *AlternationPatternNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitAndNode(node *AndNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitArgumentsNode(node *ArgumentsNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitArrayNode(node *ArrayNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitArrayPatternNode(node *ArrayPatternNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitAssocNode(node *AssocNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitAssocSplatNode(node *AssocSplatNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitBackReferenceReadNode(node *BackReferenceReadNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitBeginNode(node *BeginNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitBlockArgumentNode(node *BlockArgumentNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitBlockLocalVariableNode(node *BlockLocalVariableNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitBlockNode(node *BlockNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitBlockParameterNode(node *BlockParameterNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitBlockParametersNode(node *BlockParametersNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitBreakNode(node *BreakNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitCallAndWriteNode(node *CallAndWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitCallNode(node *CallNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitCallOperatorWriteNode(node *CallOperatorWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitCallOrWriteNode(node *CallOrWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitCallTargetNode(node *CallTargetNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitCapturePatternNode(node *CapturePatternNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitCaseMatchNode(node *CaseMatchNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitCaseNode(node *CaseNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitClassNode(node *ClassNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitClassVariableAndWriteNode(node *ClassVariableAndWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitClassVariableOperatorWriteNode(node *ClassVariableOperatorWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitClassVariableOrWriteNode(node *ClassVariableOrWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitClassVariableReadNode(node *ClassVariableReadNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitClassVariableTargetNode(node *ClassVariableTargetNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitClassVariableWriteNode(node *ClassVariableWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitConstantAndWriteNode(node *ConstantAndWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitConstantOperatorWriteNode(node *ConstantOperatorWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitConstantOrWriteNode(node *ConstantOrWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitConstantPathAndWriteNode(node *ConstantPathAndWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitConstantPathNode(node *ConstantPathNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitConstantPathOperatorWriteNode(node *ConstantPathOperatorWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitConstantPathOrWriteNode(node *ConstantPathOrWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitConstantPathTargetNode(node *ConstantPathTargetNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitConstantPathWriteNode(node *ConstantPathWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitConstantReadNode(node *ConstantReadNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitConstantTargetNode(node *ConstantTargetNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitConstantWriteNode(node *ConstantWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitDefNode(node *DefNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitDefinedNode(node *DefinedNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitElseNode(node *ElseNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitEmbeddedStatementsNode(node *EmbeddedStatementsNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitEmbeddedVariableNode(node *EmbeddedVariableNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitEnsureNode(node *EnsureNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitFalseNode(node *FalseNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitFindPatternNode(node *FindPatternNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitFlipFlopNode(node *FlipFlopNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitFloatNode(node *FloatNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitForNode(node *ForNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitForwardingArgumentsNode(node *ForwardingArgumentsNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitForwardingParameterNode(node *ForwardingParameterNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitForwardingSuperNode(node *ForwardingSuperNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitGlobalVariableAndWriteNode(node *GlobalVariableAndWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitGlobalVariableOperatorWriteNode(node *GlobalVariableOperatorWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitGlobalVariableOrWriteNode(node *GlobalVariableOrWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitGlobalVariableReadNode(node *GlobalVariableReadNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitGlobalVariableTargetNode(node *GlobalVariableTargetNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitGlobalVariableWriteNode(node *GlobalVariableWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitHashNode(node *HashNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitHashPatternNode(node *HashPatternNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitIfNode(node *IfNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitImaginaryNode(node *ImaginaryNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitImplicitNode(node *ImplicitNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitImplicitRestNode(node *ImplicitRestNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitInNode(node *InNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitIndexAndWriteNode(node *IndexAndWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitIndexOperatorWriteNode(node *IndexOperatorWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitIndexOrWriteNode(node *IndexOrWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitIndexTargetNode(node *IndexTargetNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitInstanceVariableAndWriteNode(node *InstanceVariableAndWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitInstanceVariableOperatorWriteNode(node *InstanceVariableOperatorWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitInstanceVariableOrWriteNode(node *InstanceVariableOrWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitInstanceVariableReadNode(node *InstanceVariableReadNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitInstanceVariableTargetNode(node *InstanceVariableTargetNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitInstanceVariableWriteNode(node *InstanceVariableWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitIntegerNode(node *IntegerNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitInterpolatedMatchLastLineNode(node *InterpolatedMatchLastLineNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitInterpolatedRegularExpressionNode(node *InterpolatedRegularExpressionNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitInterpolatedStringNode(node *InterpolatedStringNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitInterpolatedSymbolNode(node *InterpolatedSymbolNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitInterpolatedXStringNode(node *InterpolatedXStringNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitItParametersNode(node *ItParametersNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitKeywordHashNode(node *KeywordHashNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitKeywordRestParameterNode(node *KeywordRestParameterNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitLambdaNode(node *LambdaNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitLocalVariableAndWriteNode(node *LocalVariableAndWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitLocalVariableOperatorWriteNode(node *LocalVariableOperatorWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitLocalVariableOrWriteNode(node *LocalVariableOrWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitLocalVariableReadNode(node *LocalVariableReadNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitLocalVariableTargetNode(node *LocalVariableTargetNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitLocalVariableWriteNode(node *LocalVariableWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitMatchLastLineNode(node *MatchLastLineNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitMatchPredicateNode(node *MatchPredicateNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitMatchRequiredNode(node *MatchRequiredNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitMatchWriteNode(node *MatchWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitMissingNode(node *MissingNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitModuleNode(node *ModuleNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitMultiTargetNode(node *MultiTargetNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitMultiWriteNode(node *MultiWriteNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitNextNode(node *NextNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitNilNode(node *NilNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitNoKeywordsParameterNode(node *NoKeywordsParameterNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitNumberedParametersNode(node *NumberedParametersNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitNumberedReferenceReadNode(node *NumberedReferenceReadNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitOptionalKeywordParameterNode(node *OptionalKeywordParameterNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitOptionalParameterNode(node *OptionalParameterNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitOrNode(node *OrNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitParametersNode(node *ParametersNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitParenthesesNode(node *ParenthesesNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitPinnedExpressionNode(node *PinnedExpressionNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitPinnedVariableNode(node *PinnedVariableNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitPostExecutionNode(node *PostExecutionNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitPreExecutionNode(node *PreExecutionNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitProgramNode(node *ProgramNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitRangeNode(node *RangeNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitRationalNode(node *RationalNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitRedoNode(node *RedoNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitRegularExpressionNode(node *RegularExpressionNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitRequiredKeywordParameterNode(node *RequiredKeywordParameterNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitRequiredParameterNode(node *RequiredParameterNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitRescueModifierNode(node *RescueModifierNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitRescueNode(node *RescueNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitRestParameterNode(node *RestParameterNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitRetryNode(node *RetryNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitReturnNode(node *ReturnNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitSelfNode(node *SelfNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitSingletonClassNode(node *SingletonClassNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitSourceEncodingNode(node *SourceEncodingNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitSourceFileNode(node *SourceFileNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitSourceLineNode(node *SourceLineNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitSplatNode(node *SplatNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitStatementsNode(node *StatementsNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitStringNode(node *StringNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitSuperNode(node *SuperNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitSymbolNode(node *SymbolNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitTrueNode(node *TrueNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitUndefNode(node *UndefNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitUnlessNode(node *UnlessNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitUntilNode(node *UntilNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitWhenNode(node *WhenNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitWhileNode(node *WhileNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitXStringNode(node *XStringNode) {
+	v.VisitChildren(node)
+}
+
+func (v *BaseVisitor) VisitYieldNode(node *YieldNode) {
+	v.VisitChildren(node)
+}
+
+type nodeVisitor struct {
+	visitor NodeVisitor
+}
+
+// AdaptNodeVisitor turns a NodeVisitor into a Visitor that calls Visit for
+// every node type.
+func AdaptNodeVisitor(visitor NodeVisitor) Visitor {
+	return &nodeVisitor{visitor: visitor}
+}
+
+func (v *nodeVisitor) VisitAliasGlobalVariableNode(node *AliasGlobalVariableNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitAliasMethodNode(node *AliasMethodNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitAlternationPatternNode(node *AlternationPatternNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitAndNode(node *AndNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitArgumentsNode(node *ArgumentsNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitArrayNode(node *ArrayNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitArrayPatternNode(node *ArrayPatternNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitAssocNode(node *AssocNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitAssocSplatNode(node *AssocSplatNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitBackReferenceReadNode(node *BackReferenceReadNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitBeginNode(node *BeginNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitBlockArgumentNode(node *BlockArgumentNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitBlockLocalVariableNode(node *BlockLocalVariableNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitBlockNode(node *BlockNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitBlockParameterNode(node *BlockParameterNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitBlockParametersNode(node *BlockParametersNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitBreakNode(node *BreakNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitCallAndWriteNode(node *CallAndWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitCallNode(node *CallNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitCallOperatorWriteNode(node *CallOperatorWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitCallOrWriteNode(node *CallOrWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitCallTargetNode(node *CallTargetNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitCapturePatternNode(node *CapturePatternNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitCaseMatchNode(node *CaseMatchNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitCaseNode(node *CaseNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitClassNode(node *ClassNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitClassVariableAndWriteNode(node *ClassVariableAndWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitClassVariableOperatorWriteNode(node *ClassVariableOperatorWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitClassVariableOrWriteNode(node *ClassVariableOrWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitClassVariableReadNode(node *ClassVariableReadNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitClassVariableTargetNode(node *ClassVariableTargetNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitClassVariableWriteNode(node *ClassVariableWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitConstantAndWriteNode(node *ConstantAndWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitConstantOperatorWriteNode(node *ConstantOperatorWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitConstantOrWriteNode(node *ConstantOrWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitConstantPathAndWriteNode(node *ConstantPathAndWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitConstantPathNode(node *ConstantPathNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitConstantPathOperatorWriteNode(node *ConstantPathOperatorWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitConstantPathOrWriteNode(node *ConstantPathOrWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitConstantPathTargetNode(node *ConstantPathTargetNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitConstantPathWriteNode(node *ConstantPathWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitConstantReadNode(node *ConstantReadNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitConstantTargetNode(node *ConstantTargetNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitConstantWriteNode(node *ConstantWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitDefNode(node *DefNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitDefinedNode(node *DefinedNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitElseNode(node *ElseNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitEmbeddedStatementsNode(node *EmbeddedStatementsNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitEmbeddedVariableNode(node *EmbeddedVariableNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitEnsureNode(node *EnsureNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitFalseNode(node *FalseNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitFindPatternNode(node *FindPatternNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitFlipFlopNode(node *FlipFlopNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitFloatNode(node *FloatNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitForNode(node *ForNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitForwardingArgumentsNode(node *ForwardingArgumentsNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitForwardingParameterNode(node *ForwardingParameterNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitForwardingSuperNode(node *ForwardingSuperNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitGlobalVariableAndWriteNode(node *GlobalVariableAndWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitGlobalVariableOperatorWriteNode(node *GlobalVariableOperatorWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitGlobalVariableOrWriteNode(node *GlobalVariableOrWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitGlobalVariableReadNode(node *GlobalVariableReadNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitGlobalVariableTargetNode(node *GlobalVariableTargetNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitGlobalVariableWriteNode(node *GlobalVariableWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitHashNode(node *HashNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitHashPatternNode(node *HashPatternNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitIfNode(node *IfNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitImaginaryNode(node *ImaginaryNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitImplicitNode(node *ImplicitNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitImplicitRestNode(node *ImplicitRestNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitInNode(node *InNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitIndexAndWriteNode(node *IndexAndWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitIndexOperatorWriteNode(node *IndexOperatorWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitIndexOrWriteNode(node *IndexOrWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitIndexTargetNode(node *IndexTargetNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitInstanceVariableAndWriteNode(node *InstanceVariableAndWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitInstanceVariableOperatorWriteNode(node *InstanceVariableOperatorWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitInstanceVariableOrWriteNode(node *InstanceVariableOrWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitInstanceVariableReadNode(node *InstanceVariableReadNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitInstanceVariableTargetNode(node *InstanceVariableTargetNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitInstanceVariableWriteNode(node *InstanceVariableWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitIntegerNode(node *IntegerNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitInterpolatedMatchLastLineNode(node *InterpolatedMatchLastLineNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitInterpolatedRegularExpressionNode(node *InterpolatedRegularExpressionNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitInterpolatedStringNode(node *InterpolatedStringNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitInterpolatedSymbolNode(node *InterpolatedSymbolNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitInterpolatedXStringNode(node *InterpolatedXStringNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitItParametersNode(node *ItParametersNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitKeywordHashNode(node *KeywordHashNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitKeywordRestParameterNode(node *KeywordRestParameterNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitLambdaNode(node *LambdaNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitLocalVariableAndWriteNode(node *LocalVariableAndWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitLocalVariableOperatorWriteNode(node *LocalVariableOperatorWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitLocalVariableOrWriteNode(node *LocalVariableOrWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitLocalVariableReadNode(node *LocalVariableReadNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitLocalVariableTargetNode(node *LocalVariableTargetNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitLocalVariableWriteNode(node *LocalVariableWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitMatchLastLineNode(node *MatchLastLineNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitMatchPredicateNode(node *MatchPredicateNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitMatchRequiredNode(node *MatchRequiredNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitMatchWriteNode(node *MatchWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitMissingNode(node *MissingNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitModuleNode(node *ModuleNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitMultiTargetNode(node *MultiTargetNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitMultiWriteNode(node *MultiWriteNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitNextNode(node *NextNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitNilNode(node *NilNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitNoKeywordsParameterNode(node *NoKeywordsParameterNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitNumberedParametersNode(node *NumberedParametersNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitNumberedReferenceReadNode(node *NumberedReferenceReadNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitOptionalKeywordParameterNode(node *OptionalKeywordParameterNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitOptionalParameterNode(node *OptionalParameterNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitOrNode(node *OrNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitParametersNode(node *ParametersNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitParenthesesNode(node *ParenthesesNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitPinnedExpressionNode(node *PinnedExpressionNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitPinnedVariableNode(node *PinnedVariableNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitPostExecutionNode(node *PostExecutionNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitPreExecutionNode(node *PreExecutionNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitProgramNode(node *ProgramNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitRangeNode(node *RangeNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitRationalNode(node *RationalNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitRedoNode(node *RedoNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitRegularExpressionNode(node *RegularExpressionNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitRequiredKeywordParameterNode(node *RequiredKeywordParameterNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitRequiredParameterNode(node *RequiredParameterNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitRescueModifierNode(node *RescueModifierNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitRescueNode(node *RescueNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitRestParameterNode(node *RestParameterNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitRetryNode(node *RetryNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitReturnNode(node *ReturnNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitSelfNode(node *SelfNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitSingletonClassNode(node *SingletonClassNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitSourceEncodingNode(node *SourceEncodingNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitSourceFileNode(node *SourceFileNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitSourceLineNode(node *SourceLineNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitSplatNode(node *SplatNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitStatementsNode(node *StatementsNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitStringNode(node *StringNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitSuperNode(node *SuperNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitSymbolNode(node *SymbolNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitTrueNode(node *TrueNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitUndefNode(node *UndefNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitUnlessNode(node *UnlessNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitUntilNode(node *UntilNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitWhenNode(node *WhenNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitWhileNode(node *WhileNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitXStringNode(node *XStringNode) {
+	v.visitor.Visit(node)
+}
+
+func (v *nodeVisitor) VisitYieldNode(node *YieldNode) {
+	v.visitor.Visit(node)
+}
+
 type Node interface {
-	Accept(NodeVisitor)
+	Accept(Visitor)
 	Children() []Node
+	NamedChildren() []NamedChild
 	Location() *Location
+	Inspect(source *Source) string
+}
+
+// NamedChild is a child node together with the name of the field holding it.
+// Index is the position of the child in a list field, or -1 for other fields.
+type NamedChild struct {
+	Name  string
+	Index int
+	Node  Node
 }
 
 // Represents the use of the `alias` keyword to alias a global variable.
@@ -37,8 +1429,8 @@ func NewAliasGlobalVariableNode(newName Node, oldName Node, keywordLoc *Location
 	}
 }
 
-func (node *AliasGlobalVariableNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *AliasGlobalVariableNode) Accept(visitor Visitor) {
+	visitor.VisitAliasGlobalVariableNode(node)
 }
 
 func (node *AliasGlobalVariableNode) Children() []Node {
@@ -51,6 +1443,16 @@ func (node *AliasGlobalVariableNode) Children() []Node {
 	return children
 }
 
+func (node *AliasGlobalVariableNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "newName", Index: -1, Node: node.Newname})
+
+	children = append(children, NamedChild{Name: "oldName", Index: -1, Node: node.Oldname})
+
+	return children
+}
+
 func (node *AliasGlobalVariableNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "AliasGlobalVariableNode",
@@ -61,6 +1463,53 @@ func (node *AliasGlobalVariableNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *AliasGlobalVariableNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("new_name", false, node.Newname)
+	in.node("old_name", false, node.Oldname)
+	in.location("keyword_loc", true, node.Keywordloc)
+
+	return in.String()
+}
+
+func (node *AliasGlobalVariableNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Newname    json.RawMessage `json:"newName"`
+		Oldname    json.RawMessage `json:"oldName"`
+		Keywordloc *Location       `json:"keywordLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "AliasGlobalVariableNode" {
+		return fmt.Errorf("expected a AliasGlobalVariableNode, got %q", fields.NodeName)
+	}
+
+	newName, err := UnmarshalNode(fields.Newname)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal newName of AliasGlobalVariableNode: %w", err)
+	}
+
+	oldName, err := UnmarshalNode(fields.Oldname)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal oldName of AliasGlobalVariableNode: %w", err)
+	}
+
+	*node = AliasGlobalVariableNode{
+		Newname:    newName,
+		Oldname:    oldName,
+		Keywordloc: fields.Keywordloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *AliasGlobalVariableNode) Location() *Location {
 	return node.Loc
 }
@@ -85,8 +1534,8 @@ func NewAliasMethodNode(newName Node, oldName Node, keywordLoc *Location, loc *L
 	}
 }
 
-func (node *AliasMethodNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *AliasMethodNode) Accept(visitor Visitor) {
+	visitor.VisitAliasMethodNode(node)
 }
 
 func (node *AliasMethodNode) Children() []Node {
@@ -99,6 +1548,16 @@ func (node *AliasMethodNode) Children() []Node {
 	return children
 }
 
+func (node *AliasMethodNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "newName", Index: -1, Node: node.Newname})
+
+	children = append(children, NamedChild{Name: "oldName", Index: -1, Node: node.Oldname})
+
+	return children
+}
+
 func (node *AliasMethodNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "AliasMethodNode",
@@ -109,6 +1568,53 @@ func (node *AliasMethodNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *AliasMethodNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("new_name", false, node.Newname)
+	in.node("old_name", false, node.Oldname)
+	in.location("keyword_loc", true, node.Keywordloc)
+
+	return in.String()
+}
+
+func (node *AliasMethodNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Newname    json.RawMessage `json:"newName"`
+		Oldname    json.RawMessage `json:"oldName"`
+		Keywordloc *Location       `json:"keywordLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "AliasMethodNode" {
+		return fmt.Errorf("expected a AliasMethodNode, got %q", fields.NodeName)
+	}
+
+	newName, err := UnmarshalNode(fields.Newname)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal newName of AliasMethodNode: %w", err)
+	}
+
+	oldName, err := UnmarshalNode(fields.Oldname)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal oldName of AliasMethodNode: %w", err)
+	}
+
+	*node = AliasMethodNode{
+		Newname:    newName,
+		Oldname:    oldName,
+		Keywordloc: fields.Keywordloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *AliasMethodNode) Location() *Location {
 	return node.Loc
 }
@@ -133,8 +1639,8 @@ func NewAlternationPatternNode(left Node, right Node, operatorLoc *Location, loc
 	}
 }
 
-func (node *AlternationPatternNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *AlternationPatternNode) Accept(visitor Visitor) {
+	visitor.VisitAlternationPatternNode(node)
 }
 
 func (node *AlternationPatternNode) Children() []Node {
@@ -147,6 +1653,16 @@ func (node *AlternationPatternNode) Children() []Node {
 	return children
 }
 
+func (node *AlternationPatternNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "left", Index: -1, Node: node.Left})
+
+	children = append(children, NamedChild{Name: "right", Index: -1, Node: node.Right})
+
+	return children
+}
+
 func (node *AlternationPatternNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "AlternationPatternNode",
@@ -157,6 +1673,53 @@ func (node *AlternationPatternNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *AlternationPatternNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("left", false, node.Left)
+	in.node("right", false, node.Right)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *AlternationPatternNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Left        json.RawMessage `json:"left"`
+		Right       json.RawMessage `json:"right"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "AlternationPatternNode" {
+		return fmt.Errorf("expected a AlternationPatternNode, got %q", fields.NodeName)
+	}
+
+	left, err := UnmarshalNode(fields.Left)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal left of AlternationPatternNode: %w", err)
+	}
+
+	right, err := UnmarshalNode(fields.Right)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal right of AlternationPatternNode: %w", err)
+	}
+
+	*node = AlternationPatternNode{
+		Left:        left,
+		Right:       right,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *AlternationPatternNode) Location() *Location {
 	return node.Loc
 }
@@ -181,8 +1744,8 @@ func NewAndNode(left Node, right Node, operatorLoc *Location, loc *Location) *An
 	}
 }
 
-func (node *AndNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *AndNode) Accept(visitor Visitor) {
+	visitor.VisitAndNode(node)
 }
 
 func (node *AndNode) Children() []Node {
@@ -195,6 +1758,16 @@ func (node *AndNode) Children() []Node {
 	return children
 }
 
+func (node *AndNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "left", Index: -1, Node: node.Left})
+
+	children = append(children, NamedChild{Name: "right", Index: -1, Node: node.Right})
+
+	return children
+}
+
 func (node *AndNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "AndNode",
@@ -205,6 +1778,53 @@ func (node *AndNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *AndNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("left", false, node.Left)
+	in.node("right", false, node.Right)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *AndNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Left        json.RawMessage `json:"left"`
+		Right       json.RawMessage `json:"right"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "AndNode" {
+		return fmt.Errorf("expected a AndNode, got %q", fields.NodeName)
+	}
+
+	left, err := UnmarshalNode(fields.Left)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal left of AndNode: %w", err)
+	}
+
+	right, err := UnmarshalNode(fields.Right)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal right of AndNode: %w", err)
+	}
+
+	*node = AndNode{
+		Left:        left,
+		Right:       right,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *AndNode) Location() *Location {
 	return node.Loc
 }
@@ -227,8 +1847,8 @@ func NewArgumentsNode(flags ArgumentsNodeFlags, arguments []Node, loc *Location)
 	}
 }
 
-func (node *ArgumentsNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ArgumentsNode) Accept(visitor Visitor) {
+	visitor.VisitArgumentsNode(node)
 }
 
 func (node *ArgumentsNode) IsContainsKeywordSplat() bool {
@@ -243,6 +1863,16 @@ func (node *ArgumentsNode) Children() []Node {
 	return children
 }
 
+func (node *ArgumentsNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	for i, child := range node.Arguments {
+		children = append(children, NamedChild{Name: "arguments", Index: i, Node: child})
+	}
+
+	return children
+}
+
 func (node *ArgumentsNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":  "ArgumentsNode",
@@ -252,6 +1882,55 @@ func (node *ArgumentsNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ArgumentsNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.nodes("arguments", true, node.Arguments)
+
+	return in.String()
+}
+
+func (node *ArgumentsNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+
+	return fields
+}
+
+func (node *ArgumentsNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName  string             `json:"nodeName"`
+		Flags     ArgumentsNodeFlags `json:"flags"`
+		Arguments json.RawMessage    `json:"arguments"`
+		Loc       *Location          `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ArgumentsNode" {
+		return fmt.Errorf("expected a ArgumentsNode, got %q", fields.NodeName)
+	}
+
+	arguments, err := unmarshalNodes(fields.Arguments)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal arguments of ArgumentsNode: %w", err)
+	}
+
+	*node = ArgumentsNode{
+		Flags:     fields.Flags,
+		Arguments: arguments,
+		Loc:       fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ArgumentsNode) Location() *Location {
 	return node.Loc
 }
@@ -278,8 +1957,8 @@ func NewArrayNode(flags ArrayNodeFlags, elements []Node, openingLoc *Location, c
 	}
 }
 
-func (node *ArrayNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ArrayNode) Accept(visitor Visitor) {
+	visitor.VisitArrayNode(node)
 }
 
 func (node *ArrayNode) IsContainsSplat() bool {
@@ -294,6 +1973,16 @@ func (node *ArrayNode) Children() []Node {
 	return children
 }
 
+func (node *ArrayNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	for i, child := range node.Elements {
+		children = append(children, NamedChild{Name: "elements", Index: i, Node: child})
+	}
+
+	return children
+}
+
 func (node *ArrayNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "ArrayNode",
@@ -305,6 +1994,61 @@ func (node *ArrayNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ArrayNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.nodes("elements", false, node.Elements)
+	in.location("opening_loc", false, node.Openingloc)
+	in.location("closing_loc", true, node.Closingloc)
+
+	return in.String()
+}
+
+func (node *ArrayNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+
+	return fields
+}
+
+func (node *ArrayNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Flags      ArrayNodeFlags  `json:"flags"`
+		Elements   json.RawMessage `json:"elements"`
+		Openingloc *Location       `json:"openingLoc"`
+		Closingloc *Location       `json:"closingLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ArrayNode" {
+		return fmt.Errorf("expected a ArrayNode, got %q", fields.NodeName)
+	}
+
+	elements, err := unmarshalNodes(fields.Elements)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal elements of ArrayNode: %w", err)
+	}
+
+	*node = ArrayNode{
+		Flags:      fields.Flags,
+		Elements:   elements,
+		Openingloc: fields.Openingloc,
+		Closingloc: fields.Closingloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ArrayNode) Location() *Location {
 	return node.Loc
 }
@@ -347,8 +2091,8 @@ func NewArrayPatternNode(constant Node, requireds []Node, rest Node, posts []Nod
 	}
 }
 
-func (node *ArrayPatternNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ArrayPatternNode) Accept(visitor Visitor) {
+	visitor.VisitArrayPatternNode(node)
 }
 
 func (node *ArrayPatternNode) Children() []Node {
@@ -369,6 +2113,28 @@ func (node *ArrayPatternNode) Children() []Node {
 	return children
 }
 
+func (node *ArrayPatternNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Constant != nil {
+		children = append(children, NamedChild{Name: "constant", Index: -1, Node: node.Constant})
+	}
+
+	for i, child := range node.Requireds {
+		children = append(children, NamedChild{Name: "requireds", Index: i, Node: child})
+	}
+
+	if node.Rest != nil {
+		children = append(children, NamedChild{Name: "rest", Index: -1, Node: node.Rest})
+	}
+
+	for i, child := range node.Posts {
+		children = append(children, NamedChild{Name: "posts", Index: i, Node: child})
+	}
+
+	return children
+}
+
 func (node *ArrayPatternNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "ArrayPatternNode",
@@ -382,6 +2148,72 @@ func (node *ArrayPatternNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ArrayPatternNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("constant", false, node.Constant)
+	in.nodes("requireds", false, node.Requireds)
+	in.node("rest", false, node.Rest)
+	in.nodes("posts", false, node.Posts)
+	in.location("opening_loc", false, node.Openingloc)
+	in.location("closing_loc", true, node.Closingloc)
+
+	return in.String()
+}
+
+func (node *ArrayPatternNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Constant   json.RawMessage `json:"constant"`
+		Requireds  json.RawMessage `json:"requireds"`
+		Rest       json.RawMessage `json:"rest"`
+		Posts      json.RawMessage `json:"posts"`
+		Openingloc *Location       `json:"openingLoc"`
+		Closingloc *Location       `json:"closingLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ArrayPatternNode" {
+		return fmt.Errorf("expected a ArrayPatternNode, got %q", fields.NodeName)
+	}
+
+	constant, err := UnmarshalNode(fields.Constant)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal constant of ArrayPatternNode: %w", err)
+	}
+
+	requireds, err := unmarshalNodes(fields.Requireds)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal requireds of ArrayPatternNode: %w", err)
+	}
+
+	rest, err := UnmarshalNode(fields.Rest)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal rest of ArrayPatternNode: %w", err)
+	}
+
+	posts, err := unmarshalNodes(fields.Posts)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal posts of ArrayPatternNode: %w", err)
+	}
+
+	*node = ArrayPatternNode{
+		Constant:   constant,
+		Requireds:  requireds,
+		Rest:       rest,
+		Posts:      posts,
+		Openingloc: fields.Openingloc,
+		Closingloc: fields.Closingloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ArrayPatternNode) Location() *Location {
 	return node.Loc
 }
@@ -406,8 +2238,8 @@ func NewAssocNode(key Node, value Node, operatorLoc *Location, loc *Location) *A
 	}
 }
 
-func (node *AssocNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *AssocNode) Accept(visitor Visitor) {
+	visitor.VisitAssocNode(node)
 }
 
 func (node *AssocNode) Children() []Node {
@@ -420,6 +2252,16 @@ func (node *AssocNode) Children() []Node {
 	return children
 }
 
+func (node *AssocNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "key", Index: -1, Node: node.Key})
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *AssocNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "AssocNode",
@@ -430,6 +2272,53 @@ func (node *AssocNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *AssocNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("key", false, node.Key)
+	in.node("value", false, node.Value)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *AssocNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Key         json.RawMessage `json:"key"`
+		Value       json.RawMessage `json:"value"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "AssocNode" {
+		return fmt.Errorf("expected a AssocNode, got %q", fields.NodeName)
+	}
+
+	key, err := UnmarshalNode(fields.Key)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal key of AssocNode: %w", err)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of AssocNode: %w", err)
+	}
+
+	*node = AssocNode{
+		Key:         key,
+		Value:       value,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *AssocNode) Location() *Location {
 	return node.Loc
 }
@@ -452,8 +2341,8 @@ func NewAssocSplatNode(value Node, operatorLoc *Location, loc *Location) *AssocS
 	}
 }
 
-func (node *AssocSplatNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *AssocSplatNode) Accept(visitor Visitor) {
+	visitor.VisitAssocSplatNode(node)
 }
 
 func (node *AssocSplatNode) Children() []Node {
@@ -466,6 +2355,16 @@ func (node *AssocSplatNode) Children() []Node {
 	return children
 }
 
+func (node *AssocSplatNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Value != nil {
+		children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+	}
+
+	return children
+}
+
 func (node *AssocSplatNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "AssocSplatNode",
@@ -475,6 +2374,45 @@ func (node *AssocSplatNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *AssocSplatNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("value", false, node.Value)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *AssocSplatNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Value       json.RawMessage `json:"value"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "AssocSplatNode" {
+		return fmt.Errorf("expected a AssocSplatNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of AssocSplatNode: %w", err)
+	}
+
+	*node = AssocSplatNode{
+		Value:       value,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *AssocSplatNode) Location() *Location {
 	return node.Loc
 }
@@ -495,8 +2433,8 @@ func NewBackReferenceReadNode(name string, loc *Location) *BackReferenceReadNode
 	}
 }
 
-func (node *BackReferenceReadNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *BackReferenceReadNode) Accept(visitor Visitor) {
+	visitor.VisitBackReferenceReadNode(node)
 }
 
 func (node *BackReferenceReadNode) Children() []Node {
@@ -505,6 +2443,12 @@ func (node *BackReferenceReadNode) Children() []Node {
 	return children
 }
 
+func (node *BackReferenceReadNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *BackReferenceReadNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "BackReferenceReadNode",
@@ -513,6 +2457,45 @@ func (node *BackReferenceReadNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *BackReferenceReadNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", true, inspectSymbol(node.Name))
+
+	return in.String()
+}
+
+func (node *BackReferenceReadNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *BackReferenceReadNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Name     string    `json:"name"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "BackReferenceReadNode" {
+		return fmt.Errorf("expected a BackReferenceReadNode, got %q", fields.NodeName)
+	}
+
+	*node = BackReferenceReadNode{
+		Name: fields.Name,
+		Loc:  fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *BackReferenceReadNode) Location() *Location {
 	return node.Loc
 }
@@ -545,8 +2528,8 @@ func NewBeginNode(beginKeywordLoc *Location, statements *StatementsNode, rescueC
 	}
 }
 
-func (node *BeginNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *BeginNode) Accept(visitor Visitor) {
+	visitor.VisitBeginNode(node)
 }
 
 func (node *BeginNode) Children() []Node {
@@ -571,6 +2554,28 @@ func (node *BeginNode) Children() []Node {
 	return children
 }
 
+func (node *BeginNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Statements != nil {
+		children = append(children, NamedChild{Name: "statements", Index: -1, Node: node.Statements})
+	}
+
+	if node.Rescueclause != nil {
+		children = append(children, NamedChild{Name: "rescueClause", Index: -1, Node: node.Rescueclause})
+	}
+
+	if node.Elseclause != nil {
+		children = append(children, NamedChild{Name: "elseClause", Index: -1, Node: node.Elseclause})
+	}
+
+	if node.Ensureclause != nil {
+		children = append(children, NamedChild{Name: "ensureClause", Index: -1, Node: node.Ensureclause})
+	}
+
+	return children
+}
+
 func (node *BeginNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":        "BeginNode",
@@ -584,6 +2589,52 @@ func (node *BeginNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *BeginNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("begin_keyword_loc", false, node.Beginkeywordloc)
+	in.node("statements", false, node.Statements)
+	in.node("rescue_clause", false, node.Rescueclause)
+	in.node("else_clause", false, node.Elseclause)
+	in.node("ensure_clause", false, node.Ensureclause)
+	in.location("end_keyword_loc", true, node.Endkeywordloc)
+
+	return in.String()
+}
+
+func (node *BeginNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName        string          `json:"nodeName"`
+		Beginkeywordloc *Location       `json:"beginKeywordLoc"`
+		Statements      *StatementsNode `json:"statements"`
+		Rescueclause    *RescueNode     `json:"rescueClause"`
+		Elseclause      *ElseNode       `json:"elseClause"`
+		Ensureclause    *EnsureNode     `json:"ensureClause"`
+		Endkeywordloc   *Location       `json:"endKeywordLoc"`
+		Loc             *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "BeginNode" {
+		return fmt.Errorf("expected a BeginNode, got %q", fields.NodeName)
+	}
+
+	*node = BeginNode{
+		Beginkeywordloc: fields.Beginkeywordloc,
+		Statements:      fields.Statements,
+		Rescueclause:    fields.Rescueclause,
+		Elseclause:      fields.Elseclause,
+		Ensureclause:    fields.Ensureclause,
+		Endkeywordloc:   fields.Endkeywordloc,
+		Loc:             fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *BeginNode) Location() *Location {
 	return node.Loc
 }
@@ -606,8 +2657,8 @@ func NewBlockArgumentNode(expression Node, operatorLoc *Location, loc *Location)
 	}
 }
 
-func (node *BlockArgumentNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *BlockArgumentNode) Accept(visitor Visitor) {
+	visitor.VisitBlockArgumentNode(node)
 }
 
 func (node *BlockArgumentNode) Children() []Node {
@@ -620,6 +2671,16 @@ func (node *BlockArgumentNode) Children() []Node {
 	return children
 }
 
+func (node *BlockArgumentNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Expression != nil {
+		children = append(children, NamedChild{Name: "expression", Index: -1, Node: node.Expression})
+	}
+
+	return children
+}
+
 func (node *BlockArgumentNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "BlockArgumentNode",
@@ -629,6 +2690,45 @@ func (node *BlockArgumentNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *BlockArgumentNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("expression", false, node.Expression)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *BlockArgumentNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Expression  json.RawMessage `json:"expression"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "BlockArgumentNode" {
+		return fmt.Errorf("expected a BlockArgumentNode, got %q", fields.NodeName)
+	}
+
+	expression, err := UnmarshalNode(fields.Expression)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal expression of BlockArgumentNode: %w", err)
+	}
+
+	*node = BlockArgumentNode{
+		Expression:  expression,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *BlockArgumentNode) Location() *Location {
 	return node.Loc
 }
@@ -651,8 +2751,8 @@ func NewBlockLocalVariableNode(flags ParameterFlags, name string, loc *Location)
 	}
 }
 
-func (node *BlockLocalVariableNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *BlockLocalVariableNode) Accept(visitor Visitor) {
+	visitor.VisitBlockLocalVariableNode(node)
 }
 
 func (node *BlockLocalVariableNode) IsRepeatedParameter() bool {
@@ -662,16 +2762,67 @@ func (node *BlockLocalVariableNode) IsRepeatedParameter() bool {
 func (node *BlockLocalVariableNode) Children() []Node {
 	children := make([]Node, 0)
 
-	return children
-}
+	return children
+}
+
+func (node *BlockLocalVariableNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
+func (node *BlockLocalVariableNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"nodeName": "BlockLocalVariableNode",
+		"flags":    node.Flags,
+		"name":     node.Name,
+		"loc":      node.Loc,
+	})
+}
+
+func (node *BlockLocalVariableNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.value("name", true, inspectSymbol(node.Name))
+
+	return in.String()
+}
+
+func (node *BlockLocalVariableNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *BlockLocalVariableNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string         `json:"nodeName"`
+		Flags    ParameterFlags `json:"flags"`
+		Name     string         `json:"name"`
+		Loc      *Location      `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "BlockLocalVariableNode" {
+		return fmt.Errorf("expected a BlockLocalVariableNode, got %q", fields.NodeName)
+	}
 
-func (node *BlockLocalVariableNode) MarshalJSON() ([]byte, error) {
-	return json.Marshal(map[string]interface{}{
-		"nodeName": "BlockLocalVariableNode",
-		"flags":    node.Flags,
-		"name":     node.Name,
-		"loc":      node.Loc,
-	})
+	*node = BlockLocalVariableNode{
+		Flags: fields.Flags,
+		Name:  fields.Name,
+		Loc:   fields.Loc,
+	}
+
+	return nil
 }
 
 func (node *BlockLocalVariableNode) Location() *Location {
@@ -702,8 +2853,8 @@ func NewBlockNode(locals []string, parameters Node, body Node, openingLoc *Locat
 	}
 }
 
-func (node *BlockNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *BlockNode) Accept(visitor Visitor) {
+	visitor.VisitBlockNode(node)
 }
 
 func (node *BlockNode) Children() []Node {
@@ -720,6 +2871,20 @@ func (node *BlockNode) Children() []Node {
 	return children
 }
 
+func (node *BlockNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Parameters != nil {
+		children = append(children, NamedChild{Name: "parameters", Index: -1, Node: node.Parameters})
+	}
+
+	if node.Body != nil {
+		children = append(children, NamedChild{Name: "body", Index: -1, Node: node.Body})
+	}
+
+	return children
+}
+
 func (node *BlockNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "BlockNode",
@@ -732,6 +2897,59 @@ func (node *BlockNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *BlockNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.constants("locals", false, node.Locals)
+	in.node("parameters", false, node.Parameters)
+	in.node("body", false, node.Body)
+	in.location("opening_loc", false, node.Openingloc)
+	in.location("closing_loc", true, node.Closingloc)
+
+	return in.String()
+}
+
+func (node *BlockNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Locals     []string        `json:"locals"`
+		Parameters json.RawMessage `json:"parameters"`
+		Body       json.RawMessage `json:"body"`
+		Openingloc *Location       `json:"openingLoc"`
+		Closingloc *Location       `json:"closingLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "BlockNode" {
+		return fmt.Errorf("expected a BlockNode, got %q", fields.NodeName)
+	}
+
+	parameters, err := UnmarshalNode(fields.Parameters)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal parameters of BlockNode: %w", err)
+	}
+
+	body, err := UnmarshalNode(fields.Body)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal body of BlockNode: %w", err)
+	}
+
+	*node = BlockNode{
+		Locals:     fields.Locals,
+		Parameters: parameters,
+		Body:       body,
+		Openingloc: fields.Openingloc,
+		Closingloc: fields.Closingloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *BlockNode) Location() *Location {
 	return node.Loc
 }
@@ -759,8 +2977,8 @@ func NewBlockParameterNode(flags ParameterFlags, name *string, nameLoc *Location
 	}
 }
 
-func (node *BlockParameterNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *BlockParameterNode) Accept(visitor Visitor) {
+	visitor.VisitBlockParameterNode(node)
 }
 
 func (node *BlockParameterNode) IsRepeatedParameter() bool {
@@ -773,6 +2991,12 @@ func (node *BlockParameterNode) Children() []Node {
 	return children
 }
 
+func (node *BlockParameterNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *BlockParameterNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "BlockParameterNode",
@@ -784,6 +3008,59 @@ func (node *BlockParameterNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *BlockParameterNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.optionalConstant("name", false, node.Name)
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *BlockParameterNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	if node.Name != nil {
+		fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(*node.Name)})
+	}
+
+	return fields
+}
+
+func (node *BlockParameterNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string         `json:"nodeName"`
+		Flags       ParameterFlags `json:"flags"`
+		Name        *string        `json:"name"`
+		Nameloc     *Location      `json:"nameLoc"`
+		Operatorloc *Location      `json:"operatorLoc"`
+		Loc         *Location      `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "BlockParameterNode" {
+		return fmt.Errorf("expected a BlockParameterNode, got %q", fields.NodeName)
+	}
+
+	*node = BlockParameterNode{
+		Flags:       fields.Flags,
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *BlockParameterNode) Location() *Location {
 	return node.Loc
 }
@@ -814,8 +3091,8 @@ func NewBlockParametersNode(parameters *ParametersNode, locals []Node, openingLo
 	}
 }
 
-func (node *BlockParametersNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *BlockParametersNode) Accept(visitor Visitor) {
+	visitor.VisitBlockParametersNode(node)
 }
 
 func (node *BlockParametersNode) Children() []Node {
@@ -830,6 +3107,20 @@ func (node *BlockParametersNode) Children() []Node {
 	return children
 }
 
+func (node *BlockParametersNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Parameters != nil {
+		children = append(children, NamedChild{Name: "parameters", Index: -1, Node: node.Parameters})
+	}
+
+	for i, child := range node.Locals {
+		children = append(children, NamedChild{Name: "locals", Index: i, Node: child})
+	}
+
+	return children
+}
+
 func (node *BlockParametersNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "BlockParametersNode",
@@ -841,6 +3132,51 @@ func (node *BlockParametersNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *BlockParametersNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("parameters", false, node.Parameters)
+	in.nodes("locals", false, node.Locals)
+	in.location("opening_loc", false, node.Openingloc)
+	in.location("closing_loc", true, node.Closingloc)
+
+	return in.String()
+}
+
+func (node *BlockParametersNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Parameters *ParametersNode `json:"parameters"`
+		Locals     json.RawMessage `json:"locals"`
+		Openingloc *Location       `json:"openingLoc"`
+		Closingloc *Location       `json:"closingLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "BlockParametersNode" {
+		return fmt.Errorf("expected a BlockParametersNode, got %q", fields.NodeName)
+	}
+
+	locals, err := unmarshalNodes(fields.Locals)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal locals of BlockParametersNode: %w", err)
+	}
+
+	*node = BlockParametersNode{
+		Parameters: fields.Parameters,
+		Locals:     locals,
+		Openingloc: fields.Openingloc,
+		Closingloc: fields.Closingloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *BlockParametersNode) Location() *Location {
 	return node.Loc
 }
@@ -863,8 +3199,8 @@ func NewBreakNode(arguments *ArgumentsNode, keywordLoc *Location, loc *Location)
 	}
 }
 
-func (node *BreakNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *BreakNode) Accept(visitor Visitor) {
+	visitor.VisitBreakNode(node)
 }
 
 func (node *BreakNode) Children() []Node {
@@ -877,6 +3213,16 @@ func (node *BreakNode) Children() []Node {
 	return children
 }
 
+func (node *BreakNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Arguments != nil {
+		children = append(children, NamedChild{Name: "arguments", Index: -1, Node: node.Arguments})
+	}
+
+	return children
+}
+
 func (node *BreakNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "BreakNode",
@@ -886,6 +3232,40 @@ func (node *BreakNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *BreakNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("arguments", false, node.Arguments)
+	in.location("keyword_loc", true, node.Keywordloc)
+
+	return in.String()
+}
+
+func (node *BreakNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string         `json:"nodeName"`
+		Arguments  *ArgumentsNode `json:"arguments"`
+		Keywordloc *Location      `json:"keywordLoc"`
+		Loc        *Location      `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "BreakNode" {
+		return fmt.Errorf("expected a BreakNode, got %q", fields.NodeName)
+	}
+
+	*node = BreakNode{
+		Arguments:  fields.Arguments,
+		Keywordloc: fields.Keywordloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *BreakNode) Location() *Location {
 	return node.Loc
 }
@@ -920,8 +3300,8 @@ func NewCallAndWriteNode(flags CallNodeFlags, receiver Node, callOperatorLoc *Lo
 	}
 }
 
-func (node *CallAndWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *CallAndWriteNode) Accept(visitor Visitor) {
+	visitor.VisitCallAndWriteNode(node)
 }
 
 func (node *CallAndWriteNode) IsSafeNavigation() bool {
@@ -952,6 +3332,18 @@ func (node *CallAndWriteNode) Children() []Node {
 	return children
 }
 
+func (node *CallAndWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Receiver != nil {
+		children = append(children, NamedChild{Name: "receiver", Index: -1, Node: node.Receiver})
+	}
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *CallAndWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":        "CallAndWriteNode",
@@ -967,6 +3359,80 @@ func (node *CallAndWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *CallAndWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.node("receiver", false, node.Receiver)
+	in.location("call_operator_loc", false, node.Calloperatorloc)
+	in.location("message_loc", false, node.Messageloc)
+	in.value("read_name", false, inspectSymbol(node.Readname))
+	in.value("write_name", false, inspectSymbol(node.Writename))
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *CallAndWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	fields = append(fields, scalarField{Name: "read_name", Value: inspectSymbol(node.Readname)})
+	fields = append(fields, scalarField{Name: "write_name", Value: inspectSymbol(node.Writename)})
+
+	return fields
+}
+
+func (node *CallAndWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName        string          `json:"nodeName"`
+		Flags           CallNodeFlags   `json:"flags"`
+		Receiver        json.RawMessage `json:"receiver"`
+		Calloperatorloc *Location       `json:"callOperatorLoc"`
+		Messageloc      *Location       `json:"messageLoc"`
+		Readname        string          `json:"readName"`
+		Writename       string          `json:"writeName"`
+		Operatorloc     *Location       `json:"operatorLoc"`
+		Value           json.RawMessage `json:"value"`
+		Loc             *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "CallAndWriteNode" {
+		return fmt.Errorf("expected a CallAndWriteNode, got %q", fields.NodeName)
+	}
+
+	receiver, err := UnmarshalNode(fields.Receiver)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal receiver of CallAndWriteNode: %w", err)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of CallAndWriteNode: %w", err)
+	}
+
+	*node = CallAndWriteNode{
+		Flags:           fields.Flags,
+		Receiver:        receiver,
+		Calloperatorloc: fields.Calloperatorloc,
+		Messageloc:      fields.Messageloc,
+		Readname:        fields.Readname,
+		Writename:       fields.Writename,
+		Operatorloc:     fields.Operatorloc,
+		Value:           value,
+		Loc:             fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *CallAndWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -1018,8 +3484,8 @@ func NewCallNode(flags CallNodeFlags, receiver Node, callOperatorLoc *Location,
 	}
 }
 
-func (node *CallNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *CallNode) Accept(visitor Visitor) {
+	visitor.VisitCallNode(node)
 }
 
 func (node *CallNode) IsSafeNavigation() bool {
@@ -1056,6 +3522,24 @@ func (node *CallNode) Children() []Node {
 	return children
 }
 
+func (node *CallNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Receiver != nil {
+		children = append(children, NamedChild{Name: "receiver", Index: -1, Node: node.Receiver})
+	}
+
+	if node.Arguments != nil {
+		children = append(children, NamedChild{Name: "arguments", Index: -1, Node: node.Arguments})
+	}
+
+	if node.Block != nil {
+		children = append(children, NamedChild{Name: "block", Index: -1, Node: node.Block})
+	}
+
+	return children
+}
+
 func (node *CallNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":        "CallNode",
@@ -1072,6 +3556,82 @@ func (node *CallNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *CallNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.node("receiver", false, node.Receiver)
+	in.location("call_operator_loc", false, node.Calloperatorloc)
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("message_loc", false, node.Messageloc)
+	in.location("opening_loc", false, node.Openingloc)
+	in.node("arguments", false, node.Arguments)
+	in.location("closing_loc", false, node.Closingloc)
+	in.node("block", true, node.Block)
+
+	return in.String()
+}
+
+func (node *CallNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *CallNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName        string          `json:"nodeName"`
+		Flags           CallNodeFlags   `json:"flags"`
+		Receiver        json.RawMessage `json:"receiver"`
+		Calloperatorloc *Location       `json:"callOperatorLoc"`
+		Name            string          `json:"name"`
+		Messageloc      *Location       `json:"messageLoc"`
+		Openingloc      *Location       `json:"openingLoc"`
+		Arguments       *ArgumentsNode  `json:"arguments"`
+		Closingloc      *Location       `json:"closingLoc"`
+		Block           json.RawMessage `json:"block"`
+		Loc             *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "CallNode" {
+		return fmt.Errorf("expected a CallNode, got %q", fields.NodeName)
+	}
+
+	receiver, err := UnmarshalNode(fields.Receiver)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal receiver of CallNode: %w", err)
+	}
+
+	block, err := UnmarshalNode(fields.Block)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal block of CallNode: %w", err)
+	}
+
+	*node = CallNode{
+		Flags:           fields.Flags,
+		Receiver:        receiver,
+		Calloperatorloc: fields.Calloperatorloc,
+		Name:            fields.Name,
+		Messageloc:      fields.Messageloc,
+		Openingloc:      fields.Openingloc,
+		Arguments:       fields.Arguments,
+		Closingloc:      fields.Closingloc,
+		Block:           block,
+		Loc:             fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *CallNode) Location() *Location {
 	return node.Loc
 }
@@ -1108,8 +3668,8 @@ func NewCallOperatorWriteNode(flags CallNodeFlags, receiver Node, callOperatorLo
 	}
 }
 
-func (node *CallOperatorWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *CallOperatorWriteNode) Accept(visitor Visitor) {
+	visitor.VisitCallOperatorWriteNode(node)
 }
 
 func (node *CallOperatorWriteNode) IsSafeNavigation() bool {
@@ -1140,6 +3700,18 @@ func (node *CallOperatorWriteNode) Children() []Node {
 	return children
 }
 
+func (node *CallOperatorWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Receiver != nil {
+		children = append(children, NamedChild{Name: "receiver", Index: -1, Node: node.Receiver})
+	}
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *CallOperatorWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":        "CallOperatorWriteNode",
@@ -1156,6 +3728,84 @@ func (node *CallOperatorWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *CallOperatorWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.node("receiver", false, node.Receiver)
+	in.location("call_operator_loc", false, node.Calloperatorloc)
+	in.location("message_loc", false, node.Messageloc)
+	in.value("read_name", false, inspectSymbol(node.Readname))
+	in.value("write_name", false, inspectSymbol(node.Writename))
+	in.value("operator", false, inspectSymbol(node.Operator))
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *CallOperatorWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	fields = append(fields, scalarField{Name: "read_name", Value: inspectSymbol(node.Readname)})
+	fields = append(fields, scalarField{Name: "write_name", Value: inspectSymbol(node.Writename)})
+	fields = append(fields, scalarField{Name: "operator", Value: inspectSymbol(node.Operator)})
+
+	return fields
+}
+
+func (node *CallOperatorWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName        string          `json:"nodeName"`
+		Flags           CallNodeFlags   `json:"flags"`
+		Receiver        json.RawMessage `json:"receiver"`
+		Calloperatorloc *Location       `json:"callOperatorLoc"`
+		Messageloc      *Location       `json:"messageLoc"`
+		Readname        string          `json:"readName"`
+		Writename       string          `json:"writeName"`
+		Operator        string          `json:"operator"`
+		Operatorloc     *Location       `json:"operatorLoc"`
+		Value           json.RawMessage `json:"value"`
+		Loc             *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "CallOperatorWriteNode" {
+		return fmt.Errorf("expected a CallOperatorWriteNode, got %q", fields.NodeName)
+	}
+
+	receiver, err := UnmarshalNode(fields.Receiver)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal receiver of CallOperatorWriteNode: %w", err)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of CallOperatorWriteNode: %w", err)
+	}
+
+	*node = CallOperatorWriteNode{
+		Flags:           fields.Flags,
+		Receiver:        receiver,
+		Calloperatorloc: fields.Calloperatorloc,
+		Messageloc:      fields.Messageloc,
+		Readname:        fields.Readname,
+		Writename:       fields.Writename,
+		Operator:        fields.Operator,
+		Operatorloc:     fields.Operatorloc,
+		Value:           value,
+		Loc:             fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *CallOperatorWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -1190,8 +3840,8 @@ func NewCallOrWriteNode(flags CallNodeFlags, receiver Node, callOperatorLoc *Loc
 	}
 }
 
-func (node *CallOrWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *CallOrWriteNode) Accept(visitor Visitor) {
+	visitor.VisitCallOrWriteNode(node)
 }
 
 func (node *CallOrWriteNode) IsSafeNavigation() bool {
@@ -1222,6 +3872,18 @@ func (node *CallOrWriteNode) Children() []Node {
 	return children
 }
 
+func (node *CallOrWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Receiver != nil {
+		children = append(children, NamedChild{Name: "receiver", Index: -1, Node: node.Receiver})
+	}
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *CallOrWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":        "CallOrWriteNode",
@@ -1237,6 +3899,80 @@ func (node *CallOrWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *CallOrWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.node("receiver", false, node.Receiver)
+	in.location("call_operator_loc", false, node.Calloperatorloc)
+	in.location("message_loc", false, node.Messageloc)
+	in.value("read_name", false, inspectSymbol(node.Readname))
+	in.value("write_name", false, inspectSymbol(node.Writename))
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *CallOrWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	fields = append(fields, scalarField{Name: "read_name", Value: inspectSymbol(node.Readname)})
+	fields = append(fields, scalarField{Name: "write_name", Value: inspectSymbol(node.Writename)})
+
+	return fields
+}
+
+func (node *CallOrWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName        string          `json:"nodeName"`
+		Flags           CallNodeFlags   `json:"flags"`
+		Receiver        json.RawMessage `json:"receiver"`
+		Calloperatorloc *Location       `json:"callOperatorLoc"`
+		Messageloc      *Location       `json:"messageLoc"`
+		Readname        string          `json:"readName"`
+		Writename       string          `json:"writeName"`
+		Operatorloc     *Location       `json:"operatorLoc"`
+		Value           json.RawMessage `json:"value"`
+		Loc             *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "CallOrWriteNode" {
+		return fmt.Errorf("expected a CallOrWriteNode, got %q", fields.NodeName)
+	}
+
+	receiver, err := UnmarshalNode(fields.Receiver)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal receiver of CallOrWriteNode: %w", err)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of CallOrWriteNode: %w", err)
+	}
+
+	*node = CallOrWriteNode{
+		Flags:           fields.Flags,
+		Receiver:        receiver,
+		Calloperatorloc: fields.Calloperatorloc,
+		Messageloc:      fields.Messageloc,
+		Readname:        fields.Readname,
+		Writename:       fields.Writename,
+		Operatorloc:     fields.Operatorloc,
+		Value:           value,
+		Loc:             fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *CallOrWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -1273,8 +4009,8 @@ func NewCallTargetNode(flags CallNodeFlags, receiver Node, callOperatorLoc *Loca
 	}
 }
 
-func (node *CallTargetNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *CallTargetNode) Accept(visitor Visitor) {
+	visitor.VisitCallTargetNode(node)
 }
 
 func (node *CallTargetNode) IsSafeNavigation() bool {
@@ -1301,6 +4037,14 @@ func (node *CallTargetNode) Children() []Node {
 	return children
 }
 
+func (node *CallTargetNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "receiver", Index: -1, Node: node.Receiver})
+
+	return children
+}
+
 func (node *CallTargetNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":        "CallTargetNode",
@@ -1313,6 +4057,65 @@ func (node *CallTargetNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *CallTargetNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.node("receiver", false, node.Receiver)
+	in.location("call_operator_loc", false, node.Calloperatorloc)
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("message_loc", true, node.Messageloc)
+
+	return in.String()
+}
+
+func (node *CallTargetNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *CallTargetNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName        string          `json:"nodeName"`
+		Flags           CallNodeFlags   `json:"flags"`
+		Receiver        json.RawMessage `json:"receiver"`
+		Calloperatorloc *Location       `json:"callOperatorLoc"`
+		Name            string          `json:"name"`
+		Messageloc      *Location       `json:"messageLoc"`
+		Loc             *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "CallTargetNode" {
+		return fmt.Errorf("expected a CallTargetNode, got %q", fields.NodeName)
+	}
+
+	receiver, err := UnmarshalNode(fields.Receiver)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal receiver of CallTargetNode: %w", err)
+	}
+
+	*node = CallTargetNode{
+		Flags:           fields.Flags,
+		Receiver:        receiver,
+		Calloperatorloc: fields.Calloperatorloc,
+		Name:            fields.Name,
+		Messageloc:      fields.Messageloc,
+		Loc:             fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *CallTargetNode) Location() *Location {
 	return node.Loc
 }
@@ -1337,8 +4140,8 @@ func NewCapturePatternNode(value Node, target Node, operatorLoc *Location, loc *
 	}
 }
 
-func (node *CapturePatternNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *CapturePatternNode) Accept(visitor Visitor) {
+	visitor.VisitCapturePatternNode(node)
 }
 
 func (node *CapturePatternNode) Children() []Node {
@@ -1351,6 +4154,16 @@ func (node *CapturePatternNode) Children() []Node {
 	return children
 }
 
+func (node *CapturePatternNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	children = append(children, NamedChild{Name: "target", Index: -1, Node: node.Target})
+
+	return children
+}
+
 func (node *CapturePatternNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "CapturePatternNode",
@@ -1361,6 +4174,53 @@ func (node *CapturePatternNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *CapturePatternNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("value", false, node.Value)
+	in.node("target", false, node.Target)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *CapturePatternNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Value       json.RawMessage `json:"value"`
+		Target      json.RawMessage `json:"target"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "CapturePatternNode" {
+		return fmt.Errorf("expected a CapturePatternNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of CapturePatternNode: %w", err)
+	}
+
+	target, err := UnmarshalNode(fields.Target)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal target of CapturePatternNode: %w", err)
+	}
+
+	*node = CapturePatternNode{
+		Value:       value,
+		Target:      target,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *CapturePatternNode) Location() *Location {
 	return node.Loc
 }
@@ -1391,8 +4251,8 @@ func NewCaseMatchNode(predicate Node, conditions []Node, consequent *ElseNode, c
 	}
 }
 
-func (node *CaseMatchNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *CaseMatchNode) Accept(visitor Visitor) {
+	visitor.VisitCaseMatchNode(node)
 }
 
 func (node *CaseMatchNode) Children() []Node {
@@ -1411,6 +4271,24 @@ func (node *CaseMatchNode) Children() []Node {
 	return children
 }
 
+func (node *CaseMatchNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Predicate != nil {
+		children = append(children, NamedChild{Name: "predicate", Index: -1, Node: node.Predicate})
+	}
+
+	for i, child := range node.Conditions {
+		children = append(children, NamedChild{Name: "conditions", Index: i, Node: child})
+	}
+
+	if node.Consequent != nil {
+		children = append(children, NamedChild{Name: "consequent", Index: -1, Node: node.Consequent})
+	}
+
+	return children
+}
+
 func (node *CaseMatchNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":       "CaseMatchNode",
@@ -1423,6 +4301,59 @@ func (node *CaseMatchNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *CaseMatchNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("predicate", false, node.Predicate)
+	in.nodes("conditions", false, node.Conditions)
+	in.node("consequent", false, node.Consequent)
+	in.location("case_keyword_loc", false, node.Casekeywordloc)
+	in.location("end_keyword_loc", true, node.Endkeywordloc)
+
+	return in.String()
+}
+
+func (node *CaseMatchNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName       string          `json:"nodeName"`
+		Predicate      json.RawMessage `json:"predicate"`
+		Conditions     json.RawMessage `json:"conditions"`
+		Consequent     *ElseNode       `json:"consequent"`
+		Casekeywordloc *Location       `json:"caseKeywordLoc"`
+		Endkeywordloc  *Location       `json:"endKeywordLoc"`
+		Loc            *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "CaseMatchNode" {
+		return fmt.Errorf("expected a CaseMatchNode, got %q", fields.NodeName)
+	}
+
+	predicate, err := UnmarshalNode(fields.Predicate)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal predicate of CaseMatchNode: %w", err)
+	}
+
+	conditions, err := unmarshalNodes(fields.Conditions)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal conditions of CaseMatchNode: %w", err)
+	}
+
+	*node = CaseMatchNode{
+		Predicate:      predicate,
+		Conditions:     conditions,
+		Consequent:     fields.Consequent,
+		Casekeywordloc: fields.Casekeywordloc,
+		Endkeywordloc:  fields.Endkeywordloc,
+		Loc:            fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *CaseMatchNode) Location() *Location {
 	return node.Loc
 }
@@ -1453,8 +4384,8 @@ func NewCaseNode(predicate Node, conditions []Node, consequent *ElseNode, caseKe
 	}
 }
 
-func (node *CaseNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *CaseNode) Accept(visitor Visitor) {
+	visitor.VisitCaseNode(node)
 }
 
 func (node *CaseNode) Children() []Node {
@@ -1473,6 +4404,24 @@ func (node *CaseNode) Children() []Node {
 	return children
 }
 
+func (node *CaseNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Predicate != nil {
+		children = append(children, NamedChild{Name: "predicate", Index: -1, Node: node.Predicate})
+	}
+
+	for i, child := range node.Conditions {
+		children = append(children, NamedChild{Name: "conditions", Index: i, Node: child})
+	}
+
+	if node.Consequent != nil {
+		children = append(children, NamedChild{Name: "consequent", Index: -1, Node: node.Consequent})
+	}
+
+	return children
+}
+
 func (node *CaseNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":       "CaseNode",
@@ -1485,6 +4434,59 @@ func (node *CaseNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *CaseNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("predicate", false, node.Predicate)
+	in.nodes("conditions", false, node.Conditions)
+	in.node("consequent", false, node.Consequent)
+	in.location("case_keyword_loc", false, node.Casekeywordloc)
+	in.location("end_keyword_loc", true, node.Endkeywordloc)
+
+	return in.String()
+}
+
+func (node *CaseNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName       string          `json:"nodeName"`
+		Predicate      json.RawMessage `json:"predicate"`
+		Conditions     json.RawMessage `json:"conditions"`
+		Consequent     *ElseNode       `json:"consequent"`
+		Casekeywordloc *Location       `json:"caseKeywordLoc"`
+		Endkeywordloc  *Location       `json:"endKeywordLoc"`
+		Loc            *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "CaseNode" {
+		return fmt.Errorf("expected a CaseNode, got %q", fields.NodeName)
+	}
+
+	predicate, err := UnmarshalNode(fields.Predicate)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal predicate of CaseNode: %w", err)
+	}
+
+	conditions, err := unmarshalNodes(fields.Conditions)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal conditions of CaseNode: %w", err)
+	}
+
+	*node = CaseNode{
+		Predicate:      predicate,
+		Conditions:     conditions,
+		Consequent:     fields.Consequent,
+		Casekeywordloc: fields.Casekeywordloc,
+		Endkeywordloc:  fields.Endkeywordloc,
+		Loc:            fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *CaseNode) Location() *Location {
 	return node.Loc
 }
@@ -1519,8 +4521,8 @@ func NewClassNode(locals []string, classKeywordLoc *Location, constantPath Node,
 	}
 }
 
-func (node *ClassNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ClassNode) Accept(visitor Visitor) {
+	visitor.VisitClassNode(node)
 }
 
 func (node *ClassNode) Children() []Node {
@@ -1539,6 +4541,22 @@ func (node *ClassNode) Children() []Node {
 	return children
 }
 
+func (node *ClassNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "constantPath", Index: -1, Node: node.Constantpath})
+
+	if node.Superclass != nil {
+		children = append(children, NamedChild{Name: "superclass", Index: -1, Node: node.Superclass})
+	}
+
+	if node.Body != nil {
+		children = append(children, NamedChild{Name: "body", Index: -1, Node: node.Body})
+	}
+
+	return children
+}
+
 func (node *ClassNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":               "ClassNode",
@@ -1554,6 +4572,81 @@ func (node *ClassNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ClassNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.constants("locals", false, node.Locals)
+	in.location("class_keyword_loc", false, node.Classkeywordloc)
+	in.node("constant_path", false, node.Constantpath)
+	in.location("inheritance_operator_loc", false, node.Inheritanceoperatorloc)
+	in.node("superclass", false, node.Superclass)
+	in.node("body", false, node.Body)
+	in.location("end_keyword_loc", false, node.Endkeywordloc)
+	in.value("name", true, inspectSymbol(node.Name))
+
+	return in.String()
+}
+
+func (node *ClassNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *ClassNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName               string          `json:"nodeName"`
+		Locals                 []string        `json:"locals"`
+		Classkeywordloc        *Location       `json:"classKeywordLoc"`
+		Constantpath           json.RawMessage `json:"constantPath"`
+		Inheritanceoperatorloc *Location       `json:"inheritanceOperatorLoc"`
+		Superclass             json.RawMessage `json:"superclass"`
+		Body                   json.RawMessage `json:"body"`
+		Endkeywordloc          *Location       `json:"endKeywordLoc"`
+		Name                   string          `json:"name"`
+		Loc                    *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ClassNode" {
+		return fmt.Errorf("expected a ClassNode, got %q", fields.NodeName)
+	}
+
+	constantPath, err := UnmarshalNode(fields.Constantpath)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal constantPath of ClassNode: %w", err)
+	}
+
+	superclass, err := UnmarshalNode(fields.Superclass)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal superclass of ClassNode: %w", err)
+	}
+
+	body, err := UnmarshalNode(fields.Body)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal body of ClassNode: %w", err)
+	}
+
+	*node = ClassNode{
+		Locals:                 fields.Locals,
+		Classkeywordloc:        fields.Classkeywordloc,
+		Constantpath:           constantPath,
+		Inheritanceoperatorloc: fields.Inheritanceoperatorloc,
+		Superclass:             superclass,
+		Body:                   body,
+		Endkeywordloc:          fields.Endkeywordloc,
+		Name:                   fields.Name,
+		Loc:                    fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ClassNode) Location() *Location {
 	return node.Loc
 }
@@ -1580,8 +4673,8 @@ func NewClassVariableAndWriteNode(name string, nameLoc *Location, operatorLoc *L
 	}
 }
 
-func (node *ClassVariableAndWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ClassVariableAndWriteNode) Accept(visitor Visitor) {
+	visitor.VisitClassVariableAndWriteNode(node)
 }
 
 func (node *ClassVariableAndWriteNode) Children() []Node {
@@ -1592,6 +4685,14 @@ func (node *ClassVariableAndWriteNode) Children() []Node {
 	return children
 }
 
+func (node *ClassVariableAndWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *ClassVariableAndWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "ClassVariableAndWriteNode",
@@ -1603,6 +4704,59 @@ func (node *ClassVariableAndWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ClassVariableAndWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *ClassVariableAndWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *ClassVariableAndWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Name        string          `json:"name"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Value       json.RawMessage `json:"value"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ClassVariableAndWriteNode" {
+		return fmt.Errorf("expected a ClassVariableAndWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of ClassVariableAndWriteNode: %w", err)
+	}
+
+	*node = ClassVariableAndWriteNode{
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ClassVariableAndWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -1631,8 +4785,8 @@ func NewClassVariableOperatorWriteNode(name string, nameLoc *Location, operatorL
 	}
 }
 
-func (node *ClassVariableOperatorWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ClassVariableOperatorWriteNode) Accept(visitor Visitor) {
+	visitor.VisitClassVariableOperatorWriteNode(node)
 }
 
 func (node *ClassVariableOperatorWriteNode) Children() []Node {
@@ -1643,6 +4797,14 @@ func (node *ClassVariableOperatorWriteNode) Children() []Node {
 	return children
 }
 
+func (node *ClassVariableOperatorWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *ClassVariableOperatorWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "ClassVariableOperatorWriteNode",
@@ -1655,6 +4817,63 @@ func (node *ClassVariableOperatorWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ClassVariableOperatorWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", false, node.Value)
+	in.value("operator", true, inspectSymbol(node.Operator))
+
+	return in.String()
+}
+
+func (node *ClassVariableOperatorWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+	fields = append(fields, scalarField{Name: "operator", Value: inspectSymbol(node.Operator)})
+
+	return fields
+}
+
+func (node *ClassVariableOperatorWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Name        string          `json:"name"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Value       json.RawMessage `json:"value"`
+		Operator    string          `json:"operator"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ClassVariableOperatorWriteNode" {
+		return fmt.Errorf("expected a ClassVariableOperatorWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of ClassVariableOperatorWriteNode: %w", err)
+	}
+
+	*node = ClassVariableOperatorWriteNode{
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Operator:    fields.Operator,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ClassVariableOperatorWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -1681,8 +4900,8 @@ func NewClassVariableOrWriteNode(name string, nameLoc *Location, operatorLoc *Lo
 	}
 }
 
-func (node *ClassVariableOrWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ClassVariableOrWriteNode) Accept(visitor Visitor) {
+	visitor.VisitClassVariableOrWriteNode(node)
 }
 
 func (node *ClassVariableOrWriteNode) Children() []Node {
@@ -1693,6 +4912,14 @@ func (node *ClassVariableOrWriteNode) Children() []Node {
 	return children
 }
 
+func (node *ClassVariableOrWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *ClassVariableOrWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "ClassVariableOrWriteNode",
@@ -1704,6 +4931,59 @@ func (node *ClassVariableOrWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ClassVariableOrWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *ClassVariableOrWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *ClassVariableOrWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Name        string          `json:"name"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Value       json.RawMessage `json:"value"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ClassVariableOrWriteNode" {
+		return fmt.Errorf("expected a ClassVariableOrWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of ClassVariableOrWriteNode: %w", err)
+	}
+
+	*node = ClassVariableOrWriteNode{
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ClassVariableOrWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -1724,8 +5004,8 @@ func NewClassVariableReadNode(name string, loc *Location) *ClassVariableReadNode
 	}
 }
 
-func (node *ClassVariableReadNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ClassVariableReadNode) Accept(visitor Visitor) {
+	visitor.VisitClassVariableReadNode(node)
 }
 
 func (node *ClassVariableReadNode) Children() []Node {
@@ -1734,6 +5014,12 @@ func (node *ClassVariableReadNode) Children() []Node {
 	return children
 }
 
+func (node *ClassVariableReadNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *ClassVariableReadNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "ClassVariableReadNode",
@@ -1742,6 +5028,45 @@ func (node *ClassVariableReadNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ClassVariableReadNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", true, inspectSymbol(node.Name))
+
+	return in.String()
+}
+
+func (node *ClassVariableReadNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *ClassVariableReadNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Name     string    `json:"name"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ClassVariableReadNode" {
+		return fmt.Errorf("expected a ClassVariableReadNode, got %q", fields.NodeName)
+	}
+
+	*node = ClassVariableReadNode{
+		Name: fields.Name,
+		Loc:  fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ClassVariableReadNode) Location() *Location {
 	return node.Loc
 }
@@ -1762,8 +5087,8 @@ func NewClassVariableTargetNode(name string, loc *Location) *ClassVariableTarget
 	}
 }
 
-func (node *ClassVariableTargetNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ClassVariableTargetNode) Accept(visitor Visitor) {
+	visitor.VisitClassVariableTargetNode(node)
 }
 
 func (node *ClassVariableTargetNode) Children() []Node {
@@ -1772,6 +5097,12 @@ func (node *ClassVariableTargetNode) Children() []Node {
 	return children
 }
 
+func (node *ClassVariableTargetNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *ClassVariableTargetNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "ClassVariableTargetNode",
@@ -1780,6 +5111,45 @@ func (node *ClassVariableTargetNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ClassVariableTargetNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", true, inspectSymbol(node.Name))
+
+	return in.String()
+}
+
+func (node *ClassVariableTargetNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *ClassVariableTargetNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Name     string    `json:"name"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ClassVariableTargetNode" {
+		return fmt.Errorf("expected a ClassVariableTargetNode, got %q", fields.NodeName)
+	}
+
+	*node = ClassVariableTargetNode{
+		Name: fields.Name,
+		Loc:  fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ClassVariableTargetNode) Location() *Location {
 	return node.Loc
 }
@@ -1806,8 +5176,8 @@ func NewClassVariableWriteNode(name string, nameLoc *Location, value Node, opera
 	}
 }
 
-func (node *ClassVariableWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ClassVariableWriteNode) Accept(visitor Visitor) {
+	visitor.VisitClassVariableWriteNode(node)
 }
 
 func (node *ClassVariableWriteNode) Children() []Node {
@@ -1818,6 +5188,14 @@ func (node *ClassVariableWriteNode) Children() []Node {
 	return children
 }
 
+func (node *ClassVariableWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *ClassVariableWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "ClassVariableWriteNode",
@@ -1829,6 +5207,59 @@ func (node *ClassVariableWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ClassVariableWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.node("value", false, node.Value)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *ClassVariableWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *ClassVariableWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Name        string          `json:"name"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Value       json.RawMessage `json:"value"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ClassVariableWriteNode" {
+		return fmt.Errorf("expected a ClassVariableWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of ClassVariableWriteNode: %w", err)
+	}
+
+	*node = ClassVariableWriteNode{
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Value:       value,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ClassVariableWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -1855,8 +5286,8 @@ func NewConstantAndWriteNode(name string, nameLoc *Location, operatorLoc *Locati
 	}
 }
 
-func (node *ConstantAndWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ConstantAndWriteNode) Accept(visitor Visitor) {
+	visitor.VisitConstantAndWriteNode(node)
 }
 
 func (node *ConstantAndWriteNode) Children() []Node {
@@ -1867,6 +5298,14 @@ func (node *ConstantAndWriteNode) Children() []Node {
 	return children
 }
 
+func (node *ConstantAndWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *ConstantAndWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "ConstantAndWriteNode",
@@ -1878,6 +5317,59 @@ func (node *ConstantAndWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ConstantAndWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *ConstantAndWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *ConstantAndWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Name        string          `json:"name"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Value       json.RawMessage `json:"value"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ConstantAndWriteNode" {
+		return fmt.Errorf("expected a ConstantAndWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of ConstantAndWriteNode: %w", err)
+	}
+
+	*node = ConstantAndWriteNode{
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ConstantAndWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -1906,8 +5398,8 @@ func NewConstantOperatorWriteNode(name string, nameLoc *Location, operatorLoc *L
 	}
 }
 
-func (node *ConstantOperatorWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ConstantOperatorWriteNode) Accept(visitor Visitor) {
+	visitor.VisitConstantOperatorWriteNode(node)
 }
 
 func (node *ConstantOperatorWriteNode) Children() []Node {
@@ -1918,6 +5410,14 @@ func (node *ConstantOperatorWriteNode) Children() []Node {
 	return children
 }
 
+func (node *ConstantOperatorWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *ConstantOperatorWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "ConstantOperatorWriteNode",
@@ -1930,6 +5430,63 @@ func (node *ConstantOperatorWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ConstantOperatorWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", false, node.Value)
+	in.value("operator", true, inspectSymbol(node.Operator))
+
+	return in.String()
+}
+
+func (node *ConstantOperatorWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+	fields = append(fields, scalarField{Name: "operator", Value: inspectSymbol(node.Operator)})
+
+	return fields
+}
+
+func (node *ConstantOperatorWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Name        string          `json:"name"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Value       json.RawMessage `json:"value"`
+		Operator    string          `json:"operator"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ConstantOperatorWriteNode" {
+		return fmt.Errorf("expected a ConstantOperatorWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of ConstantOperatorWriteNode: %w", err)
+	}
+
+	*node = ConstantOperatorWriteNode{
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Operator:    fields.Operator,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ConstantOperatorWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -1956,8 +5513,8 @@ func NewConstantOrWriteNode(name string, nameLoc *Location, operatorLoc *Locatio
 	}
 }
 
-func (node *ConstantOrWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ConstantOrWriteNode) Accept(visitor Visitor) {
+	visitor.VisitConstantOrWriteNode(node)
 }
 
 func (node *ConstantOrWriteNode) Children() []Node {
@@ -1968,6 +5525,14 @@ func (node *ConstantOrWriteNode) Children() []Node {
 	return children
 }
 
+func (node *ConstantOrWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *ConstantOrWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "ConstantOrWriteNode",
@@ -1979,6 +5544,59 @@ func (node *ConstantOrWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ConstantOrWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *ConstantOrWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *ConstantOrWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Name        string          `json:"name"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Value       json.RawMessage `json:"value"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ConstantOrWriteNode" {
+		return fmt.Errorf("expected a ConstantOrWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of ConstantOrWriteNode: %w", err)
+	}
+
+	*node = ConstantOrWriteNode{
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ConstantOrWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -2003,8 +5621,8 @@ func NewConstantPathAndWriteNode(target *ConstantPathNode, operatorLoc *Location
 	}
 }
 
-func (node *ConstantPathAndWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ConstantPathAndWriteNode) Accept(visitor Visitor) {
+	visitor.VisitConstantPathAndWriteNode(node)
 }
 
 func (node *ConstantPathAndWriteNode) Children() []Node {
@@ -2017,6 +5635,16 @@ func (node *ConstantPathAndWriteNode) Children() []Node {
 	return children
 }
 
+func (node *ConstantPathAndWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "target", Index: -1, Node: node.Target})
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *ConstantPathAndWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "ConstantPathAndWriteNode",
@@ -2027,6 +5655,48 @@ func (node *ConstantPathAndWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ConstantPathAndWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("target", false, node.Target)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *ConstantPathAndWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string            `json:"nodeName"`
+		Target      *ConstantPathNode `json:"target"`
+		Operatorloc *Location         `json:"operatorLoc"`
+		Value       json.RawMessage   `json:"value"`
+		Loc         *Location         `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ConstantPathAndWriteNode" {
+		return fmt.Errorf("expected a ConstantPathAndWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of ConstantPathAndWriteNode: %w", err)
+	}
+
+	*node = ConstantPathAndWriteNode{
+		Target:      fields.Target,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ConstantPathAndWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -2051,8 +5721,8 @@ func NewConstantPathNode(parent Node, child Node, delimiterLoc *Location, loc *L
 	}
 }
 
-func (node *ConstantPathNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ConstantPathNode) Accept(visitor Visitor) {
+	visitor.VisitConstantPathNode(node)
 }
 
 func (node *ConstantPathNode) Children() []Node {
@@ -2067,6 +5737,18 @@ func (node *ConstantPathNode) Children() []Node {
 	return children
 }
 
+func (node *ConstantPathNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Parent != nil {
+		children = append(children, NamedChild{Name: "parent", Index: -1, Node: node.Parent})
+	}
+
+	children = append(children, NamedChild{Name: "child", Index: -1, Node: node.Child})
+
+	return children
+}
+
 func (node *ConstantPathNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":     "ConstantPathNode",
@@ -2077,6 +5759,53 @@ func (node *ConstantPathNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ConstantPathNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("parent", false, node.Parent)
+	in.node("child", false, node.Child)
+	in.location("delimiter_loc", true, node.Delimiterloc)
+
+	return in.String()
+}
+
+func (node *ConstantPathNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName     string          `json:"nodeName"`
+		Parent       json.RawMessage `json:"parent"`
+		Child        json.RawMessage `json:"child"`
+		Delimiterloc *Location       `json:"delimiterLoc"`
+		Loc          *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ConstantPathNode" {
+		return fmt.Errorf("expected a ConstantPathNode, got %q", fields.NodeName)
+	}
+
+	parent, err := UnmarshalNode(fields.Parent)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal parent of ConstantPathNode: %w", err)
+	}
+
+	child, err := UnmarshalNode(fields.Child)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal child of ConstantPathNode: %w", err)
+	}
+
+	*node = ConstantPathNode{
+		Parent:       parent,
+		Child:        child,
+		Delimiterloc: fields.Delimiterloc,
+		Loc:          fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ConstantPathNode) Location() *Location {
 	return node.Loc
 }
@@ -2103,8 +5832,8 @@ func NewConstantPathOperatorWriteNode(target *ConstantPathNode, operatorLoc *Loc
 	}
 }
 
-func (node *ConstantPathOperatorWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ConstantPathOperatorWriteNode) Accept(visitor Visitor) {
+	visitor.VisitConstantPathOperatorWriteNode(node)
 }
 
 func (node *ConstantPathOperatorWriteNode) Children() []Node {
@@ -2117,6 +5846,16 @@ func (node *ConstantPathOperatorWriteNode) Children() []Node {
 	return children
 }
 
+func (node *ConstantPathOperatorWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "target", Index: -1, Node: node.Target})
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *ConstantPathOperatorWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "ConstantPathOperatorWriteNode",
@@ -2128,6 +5867,59 @@ func (node *ConstantPathOperatorWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ConstantPathOperatorWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("target", false, node.Target)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", false, node.Value)
+	in.value("operator", true, inspectSymbol(node.Operator))
+
+	return in.String()
+}
+
+func (node *ConstantPathOperatorWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "operator", Value: inspectSymbol(node.Operator)})
+
+	return fields
+}
+
+func (node *ConstantPathOperatorWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string            `json:"nodeName"`
+		Target      *ConstantPathNode `json:"target"`
+		Operatorloc *Location         `json:"operatorLoc"`
+		Value       json.RawMessage   `json:"value"`
+		Operator    string            `json:"operator"`
+		Loc         *Location         `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ConstantPathOperatorWriteNode" {
+		return fmt.Errorf("expected a ConstantPathOperatorWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of ConstantPathOperatorWriteNode: %w", err)
+	}
+
+	*node = ConstantPathOperatorWriteNode{
+		Target:      fields.Target,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Operator:    fields.Operator,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ConstantPathOperatorWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -2152,8 +5944,8 @@ func NewConstantPathOrWriteNode(target *ConstantPathNode, operatorLoc *Location,
 	}
 }
 
-func (node *ConstantPathOrWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ConstantPathOrWriteNode) Accept(visitor Visitor) {
+	visitor.VisitConstantPathOrWriteNode(node)
 }
 
 func (node *ConstantPathOrWriteNode) Children() []Node {
@@ -2166,6 +5958,16 @@ func (node *ConstantPathOrWriteNode) Children() []Node {
 	return children
 }
 
+func (node *ConstantPathOrWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "target", Index: -1, Node: node.Target})
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *ConstantPathOrWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "ConstantPathOrWriteNode",
@@ -2176,6 +5978,48 @@ func (node *ConstantPathOrWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ConstantPathOrWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("target", false, node.Target)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *ConstantPathOrWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string            `json:"nodeName"`
+		Target      *ConstantPathNode `json:"target"`
+		Operatorloc *Location         `json:"operatorLoc"`
+		Value       json.RawMessage   `json:"value"`
+		Loc         *Location         `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ConstantPathOrWriteNode" {
+		return fmt.Errorf("expected a ConstantPathOrWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of ConstantPathOrWriteNode: %w", err)
+	}
+
+	*node = ConstantPathOrWriteNode{
+		Target:      fields.Target,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ConstantPathOrWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -2200,8 +6044,8 @@ func NewConstantPathTargetNode(parent Node, child Node, delimiterLoc *Location,
 	}
 }
 
-func (node *ConstantPathTargetNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ConstantPathTargetNode) Accept(visitor Visitor) {
+	visitor.VisitConstantPathTargetNode(node)
 }
 
 func (node *ConstantPathTargetNode) Children() []Node {
@@ -2216,6 +6060,18 @@ func (node *ConstantPathTargetNode) Children() []Node {
 	return children
 }
 
+func (node *ConstantPathTargetNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Parent != nil {
+		children = append(children, NamedChild{Name: "parent", Index: -1, Node: node.Parent})
+	}
+
+	children = append(children, NamedChild{Name: "child", Index: -1, Node: node.Child})
+
+	return children
+}
+
 func (node *ConstantPathTargetNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":     "ConstantPathTargetNode",
@@ -2226,6 +6082,53 @@ func (node *ConstantPathTargetNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ConstantPathTargetNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("parent", false, node.Parent)
+	in.node("child", false, node.Child)
+	in.location("delimiter_loc", true, node.Delimiterloc)
+
+	return in.String()
+}
+
+func (node *ConstantPathTargetNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName     string          `json:"nodeName"`
+		Parent       json.RawMessage `json:"parent"`
+		Child        json.RawMessage `json:"child"`
+		Delimiterloc *Location       `json:"delimiterLoc"`
+		Loc          *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ConstantPathTargetNode" {
+		return fmt.Errorf("expected a ConstantPathTargetNode, got %q", fields.NodeName)
+	}
+
+	parent, err := UnmarshalNode(fields.Parent)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal parent of ConstantPathTargetNode: %w", err)
+	}
+
+	child, err := UnmarshalNode(fields.Child)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal child of ConstantPathTargetNode: %w", err)
+	}
+
+	*node = ConstantPathTargetNode{
+		Parent:       parent,
+		Child:        child,
+		Delimiterloc: fields.Delimiterloc,
+		Loc:          fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ConstantPathTargetNode) Location() *Location {
 	return node.Loc
 }
@@ -2256,8 +6159,8 @@ func NewConstantPathWriteNode(target *ConstantPathNode, operatorLoc *Location, v
 	}
 }
 
-func (node *ConstantPathWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ConstantPathWriteNode) Accept(visitor Visitor) {
+	visitor.VisitConstantPathWriteNode(node)
 }
 
 func (node *ConstantPathWriteNode) Children() []Node {
@@ -2270,6 +6173,16 @@ func (node *ConstantPathWriteNode) Children() []Node {
 	return children
 }
 
+func (node *ConstantPathWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "target", Index: -1, Node: node.Target})
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *ConstantPathWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "ConstantPathWriteNode",
@@ -2280,6 +6193,48 @@ func (node *ConstantPathWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ConstantPathWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("target", false, node.Target)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *ConstantPathWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string            `json:"nodeName"`
+		Target      *ConstantPathNode `json:"target"`
+		Operatorloc *Location         `json:"operatorLoc"`
+		Value       json.RawMessage   `json:"value"`
+		Loc         *Location         `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ConstantPathWriteNode" {
+		return fmt.Errorf("expected a ConstantPathWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of ConstantPathWriteNode: %w", err)
+	}
+
+	*node = ConstantPathWriteNode{
+		Target:      fields.Target,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ConstantPathWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -2300,8 +6255,8 @@ func NewConstantReadNode(name string, loc *Location) *ConstantReadNode {
 	}
 }
 
-func (node *ConstantReadNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ConstantReadNode) Accept(visitor Visitor) {
+	visitor.VisitConstantReadNode(node)
 }
 
 func (node *ConstantReadNode) Children() []Node {
@@ -2310,6 +6265,12 @@ func (node *ConstantReadNode) Children() []Node {
 	return children
 }
 
+func (node *ConstantReadNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *ConstantReadNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "ConstantReadNode",
@@ -2318,6 +6279,45 @@ func (node *ConstantReadNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ConstantReadNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", true, inspectSymbol(node.Name))
+
+	return in.String()
+}
+
+func (node *ConstantReadNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *ConstantReadNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Name     string    `json:"name"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ConstantReadNode" {
+		return fmt.Errorf("expected a ConstantReadNode, got %q", fields.NodeName)
+	}
+
+	*node = ConstantReadNode{
+		Name: fields.Name,
+		Loc:  fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ConstantReadNode) Location() *Location {
 	return node.Loc
 }
@@ -2338,8 +6338,8 @@ func NewConstantTargetNode(name string, loc *Location) *ConstantTargetNode {
 	}
 }
 
-func (node *ConstantTargetNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ConstantTargetNode) Accept(visitor Visitor) {
+	visitor.VisitConstantTargetNode(node)
 }
 
 func (node *ConstantTargetNode) Children() []Node {
@@ -2348,6 +6348,12 @@ func (node *ConstantTargetNode) Children() []Node {
 	return children
 }
 
+func (node *ConstantTargetNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *ConstantTargetNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "ConstantTargetNode",
@@ -2356,6 +6362,45 @@ func (node *ConstantTargetNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ConstantTargetNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", true, inspectSymbol(node.Name))
+
+	return in.String()
+}
+
+func (node *ConstantTargetNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *ConstantTargetNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Name     string    `json:"name"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ConstantTargetNode" {
+		return fmt.Errorf("expected a ConstantTargetNode, got %q", fields.NodeName)
+	}
+
+	*node = ConstantTargetNode{
+		Name: fields.Name,
+		Loc:  fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ConstantTargetNode) Location() *Location {
 	return node.Loc
 }
@@ -2382,8 +6427,8 @@ func NewConstantWriteNode(name string, nameLoc *Location, value Node, operatorLo
 	}
 }
 
-func (node *ConstantWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ConstantWriteNode) Accept(visitor Visitor) {
+	visitor.VisitConstantWriteNode(node)
 }
 
 func (node *ConstantWriteNode) Children() []Node {
@@ -2394,6 +6439,14 @@ func (node *ConstantWriteNode) Children() []Node {
 	return children
 }
 
+func (node *ConstantWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *ConstantWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "ConstantWriteNode",
@@ -2405,6 +6458,59 @@ func (node *ConstantWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ConstantWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.node("value", false, node.Value)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *ConstantWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *ConstantWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Name        string          `json:"name"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Value       json.RawMessage `json:"value"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ConstantWriteNode" {
+		return fmt.Errorf("expected a ConstantWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of ConstantWriteNode: %w", err)
+	}
+
+	*node = ConstantWriteNode{
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Value:       value,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ConstantWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -2448,8 +6554,8 @@ func NewDefNode(name string, nameLoc *Location, receiver Node, parameters *Param
 	}
 }
 
-func (node *DefNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *DefNode) Accept(visitor Visitor) {
+	visitor.VisitDefNode(node)
 }
 
 func (node *DefNode) Children() []Node {
@@ -2470,6 +6576,24 @@ func (node *DefNode) Children() []Node {
 	return children
 }
 
+func (node *DefNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Receiver != nil {
+		children = append(children, NamedChild{Name: "receiver", Index: -1, Node: node.Receiver})
+	}
+
+	if node.Parameters != nil {
+		children = append(children, NamedChild{Name: "parameters", Index: -1, Node: node.Parameters})
+	}
+
+	if node.Body != nil {
+		children = append(children, NamedChild{Name: "body", Index: -1, Node: node.Body})
+	}
+
+	return children
+}
+
 func (node *DefNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":      "DefNode",
@@ -2489,6 +6613,88 @@ func (node *DefNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *DefNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.node("receiver", false, node.Receiver)
+	in.node("parameters", false, node.Parameters)
+	in.node("body", false, node.Body)
+	in.constants("locals", false, node.Locals)
+	in.location("def_keyword_loc", false, node.Defkeywordloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.location("lparen_loc", false, node.Lparenloc)
+	in.location("rparen_loc", false, node.Rparenloc)
+	in.location("equal_loc", false, node.Equalloc)
+	in.location("end_keyword_loc", true, node.Endkeywordloc)
+
+	return in.String()
+}
+
+func (node *DefNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *DefNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName      string          `json:"nodeName"`
+		Name          string          `json:"name"`
+		Nameloc       *Location       `json:"nameLoc"`
+		Receiver      json.RawMessage `json:"receiver"`
+		Parameters    *ParametersNode `json:"parameters"`
+		Body          json.RawMessage `json:"body"`
+		Locals        []string        `json:"locals"`
+		Defkeywordloc *Location       `json:"defKeywordLoc"`
+		Operatorloc   *Location       `json:"operatorLoc"`
+		Lparenloc     *Location       `json:"lparenLoc"`
+		Rparenloc     *Location       `json:"rparenLoc"`
+		Equalloc      *Location       `json:"equalLoc"`
+		Endkeywordloc *Location       `json:"endKeywordLoc"`
+		Loc           *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "DefNode" {
+		return fmt.Errorf("expected a DefNode, got %q", fields.NodeName)
+	}
+
+	receiver, err := UnmarshalNode(fields.Receiver)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal receiver of DefNode: %w", err)
+	}
+
+	body, err := UnmarshalNode(fields.Body)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal body of DefNode: %w", err)
+	}
+
+	*node = DefNode{
+		Name:          fields.Name,
+		Nameloc:       fields.Nameloc,
+		Receiver:      receiver,
+		Parameters:    fields.Parameters,
+		Body:          body,
+		Locals:        fields.Locals,
+		Defkeywordloc: fields.Defkeywordloc,
+		Operatorloc:   fields.Operatorloc,
+		Lparenloc:     fields.Lparenloc,
+		Rparenloc:     fields.Rparenloc,
+		Equalloc:      fields.Equalloc,
+		Endkeywordloc: fields.Endkeywordloc,
+		Loc:           fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *DefNode) Location() *Location {
 	return node.Loc
 }
@@ -2515,8 +6721,8 @@ func NewDefinedNode(lparenLoc *Location, value Node, rparenLoc *Location, keywor
 	}
 }
 
-func (node *DefinedNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *DefinedNode) Accept(visitor Visitor) {
+	visitor.VisitDefinedNode(node)
 }
 
 func (node *DefinedNode) Children() []Node {
@@ -2527,6 +6733,14 @@ func (node *DefinedNode) Children() []Node {
 	return children
 }
 
+func (node *DefinedNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *DefinedNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "DefinedNode",
@@ -2538,6 +6752,51 @@ func (node *DefinedNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *DefinedNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("lparen_loc", false, node.Lparenloc)
+	in.node("value", false, node.Value)
+	in.location("rparen_loc", false, node.Rparenloc)
+	in.location("keyword_loc", true, node.Keywordloc)
+
+	return in.String()
+}
+
+func (node *DefinedNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Lparenloc  *Location       `json:"lparenLoc"`
+		Value      json.RawMessage `json:"value"`
+		Rparenloc  *Location       `json:"rparenLoc"`
+		Keywordloc *Location       `json:"keywordLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "DefinedNode" {
+		return fmt.Errorf("expected a DefinedNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of DefinedNode: %w", err)
+	}
+
+	*node = DefinedNode{
+		Lparenloc:  fields.Lparenloc,
+		Value:      value,
+		Rparenloc:  fields.Rparenloc,
+		Keywordloc: fields.Keywordloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *DefinedNode) Location() *Location {
 	return node.Loc
 }
@@ -2562,8 +6821,8 @@ func NewElseNode(elseKeywordLoc *Location, statements *StatementsNode, endKeywor
 	}
 }
 
-func (node *ElseNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ElseNode) Accept(visitor Visitor) {
+	visitor.VisitElseNode(node)
 }
 
 func (node *ElseNode) Children() []Node {
@@ -2576,6 +6835,16 @@ func (node *ElseNode) Children() []Node {
 	return children
 }
 
+func (node *ElseNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Statements != nil {
+		children = append(children, NamedChild{Name: "statements", Index: -1, Node: node.Statements})
+	}
+
+	return children
+}
+
 func (node *ElseNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":       "ElseNode",
@@ -2586,6 +6855,43 @@ func (node *ElseNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ElseNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("else_keyword_loc", false, node.Elsekeywordloc)
+	in.node("statements", false, node.Statements)
+	in.location("end_keyword_loc", true, node.Endkeywordloc)
+
+	return in.String()
+}
+
+func (node *ElseNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName       string          `json:"nodeName"`
+		Elsekeywordloc *Location       `json:"elseKeywordLoc"`
+		Statements     *StatementsNode `json:"statements"`
+		Endkeywordloc  *Location       `json:"endKeywordLoc"`
+		Loc            *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ElseNode" {
+		return fmt.Errorf("expected a ElseNode, got %q", fields.NodeName)
+	}
+
+	*node = ElseNode{
+		Elsekeywordloc: fields.Elsekeywordloc,
+		Statements:     fields.Statements,
+		Endkeywordloc:  fields.Endkeywordloc,
+		Loc:            fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ElseNode) Location() *Location {
 	return node.Loc
 }
@@ -2610,8 +6916,8 @@ func NewEmbeddedStatementsNode(openingLoc *Location, statements *StatementsNode,
 	}
 }
 
-func (node *EmbeddedStatementsNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *EmbeddedStatementsNode) Accept(visitor Visitor) {
+	visitor.VisitEmbeddedStatementsNode(node)
 }
 
 func (node *EmbeddedStatementsNode) Children() []Node {
@@ -2624,6 +6930,16 @@ func (node *EmbeddedStatementsNode) Children() []Node {
 	return children
 }
 
+func (node *EmbeddedStatementsNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Statements != nil {
+		children = append(children, NamedChild{Name: "statements", Index: -1, Node: node.Statements})
+	}
+
+	return children
+}
+
 func (node *EmbeddedStatementsNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "EmbeddedStatementsNode",
@@ -2634,6 +6950,43 @@ func (node *EmbeddedStatementsNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *EmbeddedStatementsNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("opening_loc", false, node.Openingloc)
+	in.node("statements", false, node.Statements)
+	in.location("closing_loc", true, node.Closingloc)
+
+	return in.String()
+}
+
+func (node *EmbeddedStatementsNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Openingloc *Location       `json:"openingLoc"`
+		Statements *StatementsNode `json:"statements"`
+		Closingloc *Location       `json:"closingLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "EmbeddedStatementsNode" {
+		return fmt.Errorf("expected a EmbeddedStatementsNode, got %q", fields.NodeName)
+	}
+
+	*node = EmbeddedStatementsNode{
+		Openingloc: fields.Openingloc,
+		Statements: fields.Statements,
+		Closingloc: fields.Closingloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *EmbeddedStatementsNode) Location() *Location {
 	return node.Loc
 }
@@ -2656,8 +7009,8 @@ func NewEmbeddedVariableNode(operatorLoc *Location, variable Node, loc *Location
 	}
 }
 
-func (node *EmbeddedVariableNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *EmbeddedVariableNode) Accept(visitor Visitor) {
+	visitor.VisitEmbeddedVariableNode(node)
 }
 
 func (node *EmbeddedVariableNode) Children() []Node {
@@ -2668,6 +7021,14 @@ func (node *EmbeddedVariableNode) Children() []Node {
 	return children
 }
 
+func (node *EmbeddedVariableNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "variable", Index: -1, Node: node.Variable})
+
+	return children
+}
+
 func (node *EmbeddedVariableNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "EmbeddedVariableNode",
@@ -2677,6 +7038,45 @@ func (node *EmbeddedVariableNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *EmbeddedVariableNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("variable", true, node.Variable)
+
+	return in.String()
+}
+
+func (node *EmbeddedVariableNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Variable    json.RawMessage `json:"variable"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "EmbeddedVariableNode" {
+		return fmt.Errorf("expected a EmbeddedVariableNode, got %q", fields.NodeName)
+	}
+
+	variable, err := UnmarshalNode(fields.Variable)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal variable of EmbeddedVariableNode: %w", err)
+	}
+
+	*node = EmbeddedVariableNode{
+		Operatorloc: fields.Operatorloc,
+		Variable:    variable,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *EmbeddedVariableNode) Location() *Location {
 	return node.Loc
 }
@@ -2705,8 +7105,8 @@ func NewEnsureNode(ensureKeywordLoc *Location, statements *StatementsNode, endKe
 	}
 }
 
-func (node *EnsureNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *EnsureNode) Accept(visitor Visitor) {
+	visitor.VisitEnsureNode(node)
 }
 
 func (node *EnsureNode) Children() []Node {
@@ -2719,6 +7119,16 @@ func (node *EnsureNode) Children() []Node {
 	return children
 }
 
+func (node *EnsureNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Statements != nil {
+		children = append(children, NamedChild{Name: "statements", Index: -1, Node: node.Statements})
+	}
+
+	return children
+}
+
 func (node *EnsureNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":         "EnsureNode",
@@ -2729,6 +7139,43 @@ func (node *EnsureNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *EnsureNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("ensure_keyword_loc", false, node.Ensurekeywordloc)
+	in.node("statements", false, node.Statements)
+	in.location("end_keyword_loc", true, node.Endkeywordloc)
+
+	return in.String()
+}
+
+func (node *EnsureNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName         string          `json:"nodeName"`
+		Ensurekeywordloc *Location       `json:"ensureKeywordLoc"`
+		Statements       *StatementsNode `json:"statements"`
+		Endkeywordloc    *Location       `json:"endKeywordLoc"`
+		Loc              *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "EnsureNode" {
+		return fmt.Errorf("expected a EnsureNode, got %q", fields.NodeName)
+	}
+
+	*node = EnsureNode{
+		Ensurekeywordloc: fields.Ensurekeywordloc,
+		Statements:       fields.Statements,
+		Endkeywordloc:    fields.Endkeywordloc,
+		Loc:              fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *EnsureNode) Location() *Location {
 	return node.Loc
 }
@@ -2747,8 +7194,8 @@ func NewFalseNode(loc *Location) *FalseNode {
 	}
 }
 
-func (node *FalseNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *FalseNode) Accept(visitor Visitor) {
+	visitor.VisitFalseNode(node)
 }
 
 func (node *FalseNode) Children() []Node {
@@ -2757,6 +7204,12 @@ func (node *FalseNode) Children() []Node {
 	return children
 }
 
+func (node *FalseNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *FalseNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "FalseNode",
@@ -2764,6 +7217,33 @@ func (node *FalseNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *FalseNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	return in.String()
+}
+
+func (node *FalseNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "FalseNode" {
+		return fmt.Errorf("expected a FalseNode, got %q", fields.NodeName)
+	}
+
+	*node = FalseNode{
+		Loc: fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *FalseNode) Location() *Location {
 	return node.Loc
 }
@@ -2800,8 +7280,8 @@ func NewFindPatternNode(constant Node, left Node, requireds []Node, right Node,
 	}
 }
 
-func (node *FindPatternNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *FindPatternNode) Accept(visitor Visitor) {
+	visitor.VisitFindPatternNode(node)
 }
 
 func (node *FindPatternNode) Children() []Node {
@@ -2820,6 +7300,24 @@ func (node *FindPatternNode) Children() []Node {
 	return children
 }
 
+func (node *FindPatternNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Constant != nil {
+		children = append(children, NamedChild{Name: "constant", Index: -1, Node: node.Constant})
+	}
+
+	children = append(children, NamedChild{Name: "left", Index: -1, Node: node.Left})
+
+	for i, child := range node.Requireds {
+		children = append(children, NamedChild{Name: "requireds", Index: i, Node: child})
+	}
+
+	children = append(children, NamedChild{Name: "right", Index: -1, Node: node.Right})
+
+	return children
+}
+
 func (node *FindPatternNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "FindPatternNode",
@@ -2833,6 +7331,72 @@ func (node *FindPatternNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *FindPatternNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("constant", false, node.Constant)
+	in.node("left", false, node.Left)
+	in.nodes("requireds", false, node.Requireds)
+	in.node("right", false, node.Right)
+	in.location("opening_loc", false, node.Openingloc)
+	in.location("closing_loc", true, node.Closingloc)
+
+	return in.String()
+}
+
+func (node *FindPatternNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Constant   json.RawMessage `json:"constant"`
+		Left       json.RawMessage `json:"left"`
+		Requireds  json.RawMessage `json:"requireds"`
+		Right      json.RawMessage `json:"right"`
+		Openingloc *Location       `json:"openingLoc"`
+		Closingloc *Location       `json:"closingLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "FindPatternNode" {
+		return fmt.Errorf("expected a FindPatternNode, got %q", fields.NodeName)
+	}
+
+	constant, err := UnmarshalNode(fields.Constant)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal constant of FindPatternNode: %w", err)
+	}
+
+	left, err := UnmarshalNode(fields.Left)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal left of FindPatternNode: %w", err)
+	}
+
+	requireds, err := unmarshalNodes(fields.Requireds)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal requireds of FindPatternNode: %w", err)
+	}
+
+	right, err := UnmarshalNode(fields.Right)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal right of FindPatternNode: %w", err)
+	}
+
+	*node = FindPatternNode{
+		Constant:   constant,
+		Left:       left,
+		Requireds:  requireds,
+		Right:      right,
+		Openingloc: fields.Openingloc,
+		Closingloc: fields.Closingloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *FindPatternNode) Location() *Location {
 	return node.Loc
 }
@@ -2859,8 +7423,8 @@ func NewFlipFlopNode(flags RangeFlags, left Node, right Node, operatorLoc *Locat
 	}
 }
 
-func (node *FlipFlopNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *FlipFlopNode) Accept(visitor Visitor) {
+	visitor.VisitFlipFlopNode(node)
 }
 
 func (node *FlipFlopNode) IsExcludeEnd() bool {
@@ -2881,6 +7445,20 @@ func (node *FlipFlopNode) Children() []Node {
 	return children
 }
 
+func (node *FlipFlopNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Left != nil {
+		children = append(children, NamedChild{Name: "left", Index: -1, Node: node.Left})
+	}
+
+	if node.Right != nil {
+		children = append(children, NamedChild{Name: "right", Index: -1, Node: node.Right})
+	}
+
+	return children
+}
+
 func (node *FlipFlopNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "FlipFlopNode",
@@ -2892,6 +7470,66 @@ func (node *FlipFlopNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *FlipFlopNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.node("left", false, node.Left)
+	in.node("right", false, node.Right)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *FlipFlopNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+
+	return fields
+}
+
+func (node *FlipFlopNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Flags       RangeFlags      `json:"flags"`
+		Left        json.RawMessage `json:"left"`
+		Right       json.RawMessage `json:"right"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "FlipFlopNode" {
+		return fmt.Errorf("expected a FlipFlopNode, got %q", fields.NodeName)
+	}
+
+	left, err := UnmarshalNode(fields.Left)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal left of FlipFlopNode: %w", err)
+	}
+
+	right, err := UnmarshalNode(fields.Right)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal right of FlipFlopNode: %w", err)
+	}
+
+	*node = FlipFlopNode{
+		Flags:       fields.Flags,
+		Left:        left,
+		Right:       right,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *FlipFlopNode) Location() *Location {
 	return node.Loc
 }
@@ -2912,8 +7550,8 @@ func NewFloatNode(value float64, loc *Location) *FloatNode {
 	}
 }
 
-func (node *FloatNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *FloatNode) Accept(visitor Visitor) {
+	visitor.VisitFloatNode(node)
 }
 
 func (node *FloatNode) Children() []Node {
@@ -2922,14 +7560,59 @@ func (node *FloatNode) Children() []Node {
 	return children
 }
 
+func (node *FloatNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *FloatNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "FloatNode",
-		"value":    node.Value,
+		"value":    jsonFloat(node.Value),
 		"loc":      node.Loc,
 	})
 }
 
+func (node *FloatNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("value", true, inspectFloat(node.Value))
+
+	return in.String()
+}
+
+func (node *FloatNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "value", Value: inspectFloat(node.Value)})
+
+	return fields
+}
+
+func (node *FloatNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Value    jsonFloat `json:"value"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "FloatNode" {
+		return fmt.Errorf("expected a FloatNode, got %q", fields.NodeName)
+	}
+
+	*node = FloatNode{
+		Value: float64(fields.Value),
+		Loc:   fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *FloatNode) Location() *Location {
 	return node.Loc
 }
@@ -2962,8 +7645,8 @@ func NewForNode(index Node, collection Node, statements *StatementsNode, forKeyw
 	}
 }
 
-func (node *ForNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ForNode) Accept(visitor Visitor) {
+	visitor.VisitForNode(node)
 }
 
 func (node *ForNode) Children() []Node {
@@ -2980,6 +7663,20 @@ func (node *ForNode) Children() []Node {
 	return children
 }
 
+func (node *ForNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "index", Index: -1, Node: node.Index})
+
+	children = append(children, NamedChild{Name: "collection", Index: -1, Node: node.Collection})
+
+	if node.Statements != nil {
+		children = append(children, NamedChild{Name: "statements", Index: -1, Node: node.Statements})
+	}
+
+	return children
+}
+
 func (node *ForNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":      "ForNode",
@@ -2994,6 +7691,65 @@ func (node *ForNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ForNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("index", false, node.Index)
+	in.node("collection", false, node.Collection)
+	in.node("statements", false, node.Statements)
+	in.location("for_keyword_loc", false, node.Forkeywordloc)
+	in.location("in_keyword_loc", false, node.Inkeywordloc)
+	in.location("do_keyword_loc", false, node.Dokeywordloc)
+	in.location("end_keyword_loc", true, node.Endkeywordloc)
+
+	return in.String()
+}
+
+func (node *ForNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName      string          `json:"nodeName"`
+		Index         json.RawMessage `json:"index"`
+		Collection    json.RawMessage `json:"collection"`
+		Statements    *StatementsNode `json:"statements"`
+		Forkeywordloc *Location       `json:"forKeywordLoc"`
+		Inkeywordloc  *Location       `json:"inKeywordLoc"`
+		Dokeywordloc  *Location       `json:"doKeywordLoc"`
+		Endkeywordloc *Location       `json:"endKeywordLoc"`
+		Loc           *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ForNode" {
+		return fmt.Errorf("expected a ForNode, got %q", fields.NodeName)
+	}
+
+	index, err := UnmarshalNode(fields.Index)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal index of ForNode: %w", err)
+	}
+
+	collection, err := UnmarshalNode(fields.Collection)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal collection of ForNode: %w", err)
+	}
+
+	*node = ForNode{
+		Index:         index,
+		Collection:    collection,
+		Statements:    fields.Statements,
+		Forkeywordloc: fields.Forkeywordloc,
+		Inkeywordloc:  fields.Inkeywordloc,
+		Dokeywordloc:  fields.Dokeywordloc,
+		Endkeywordloc: fields.Endkeywordloc,
+		Loc:           fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ForNode) Location() *Location {
 	return node.Loc
 }
@@ -3014,8 +7770,8 @@ func NewForwardingArgumentsNode(loc *Location) *ForwardingArgumentsNode {
 	}
 }
 
-func (node *ForwardingArgumentsNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ForwardingArgumentsNode) Accept(visitor Visitor) {
+	visitor.VisitForwardingArgumentsNode(node)
 }
 
 func (node *ForwardingArgumentsNode) Children() []Node {
@@ -3024,6 +7780,12 @@ func (node *ForwardingArgumentsNode) Children() []Node {
 	return children
 }
 
+func (node *ForwardingArgumentsNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *ForwardingArgumentsNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "ForwardingArgumentsNode",
@@ -3031,6 +7793,33 @@ func (node *ForwardingArgumentsNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ForwardingArgumentsNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	return in.String()
+}
+
+func (node *ForwardingArgumentsNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ForwardingArgumentsNode" {
+		return fmt.Errorf("expected a ForwardingArgumentsNode, got %q", fields.NodeName)
+	}
+
+	*node = ForwardingArgumentsNode{
+		Loc: fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ForwardingArgumentsNode) Location() *Location {
 	return node.Loc
 }
@@ -3050,8 +7839,8 @@ func NewForwardingParameterNode(loc *Location) *ForwardingParameterNode {
 	}
 }
 
-func (node *ForwardingParameterNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ForwardingParameterNode) Accept(visitor Visitor) {
+	visitor.VisitForwardingParameterNode(node)
 }
 
 func (node *ForwardingParameterNode) Children() []Node {
@@ -3060,6 +7849,12 @@ func (node *ForwardingParameterNode) Children() []Node {
 	return children
 }
 
+func (node *ForwardingParameterNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *ForwardingParameterNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "ForwardingParameterNode",
@@ -3067,6 +7862,33 @@ func (node *ForwardingParameterNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ForwardingParameterNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	return in.String()
+}
+
+func (node *ForwardingParameterNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ForwardingParameterNode" {
+		return fmt.Errorf("expected a ForwardingParameterNode, got %q", fields.NodeName)
+	}
+
+	*node = ForwardingParameterNode{
+		Loc: fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ForwardingParameterNode) Location() *Location {
 	return node.Loc
 }
@@ -3087,8 +7909,8 @@ func NewForwardingSuperNode(block *BlockNode, loc *Location) *ForwardingSuperNod
 	}
 }
 
-func (node *ForwardingSuperNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ForwardingSuperNode) Accept(visitor Visitor) {
+	visitor.VisitForwardingSuperNode(node)
 }
 
 func (node *ForwardingSuperNode) Children() []Node {
@@ -3101,6 +7923,16 @@ func (node *ForwardingSuperNode) Children() []Node {
 	return children
 }
 
+func (node *ForwardingSuperNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Block != nil {
+		children = append(children, NamedChild{Name: "block", Index: -1, Node: node.Block})
+	}
+
+	return children
+}
+
 func (node *ForwardingSuperNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "ForwardingSuperNode",
@@ -3109,6 +7941,37 @@ func (node *ForwardingSuperNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ForwardingSuperNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("block", true, node.Block)
+
+	return in.String()
+}
+
+func (node *ForwardingSuperNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string     `json:"nodeName"`
+		Block    *BlockNode `json:"block"`
+		Loc      *Location  `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ForwardingSuperNode" {
+		return fmt.Errorf("expected a ForwardingSuperNode, got %q", fields.NodeName)
+	}
+
+	*node = ForwardingSuperNode{
+		Block: fields.Block,
+		Loc:   fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ForwardingSuperNode) Location() *Location {
 	return node.Loc
 }
@@ -3135,8 +7998,8 @@ func NewGlobalVariableAndWriteNode(name string, nameLoc *Location, operatorLoc *
 	}
 }
 
-func (node *GlobalVariableAndWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *GlobalVariableAndWriteNode) Accept(visitor Visitor) {
+	visitor.VisitGlobalVariableAndWriteNode(node)
 }
 
 func (node *GlobalVariableAndWriteNode) Children() []Node {
@@ -3147,6 +8010,14 @@ func (node *GlobalVariableAndWriteNode) Children() []Node {
 	return children
 }
 
+func (node *GlobalVariableAndWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *GlobalVariableAndWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "GlobalVariableAndWriteNode",
@@ -3158,6 +8029,59 @@ func (node *GlobalVariableAndWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *GlobalVariableAndWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *GlobalVariableAndWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *GlobalVariableAndWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Name        string          `json:"name"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Value       json.RawMessage `json:"value"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "GlobalVariableAndWriteNode" {
+		return fmt.Errorf("expected a GlobalVariableAndWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of GlobalVariableAndWriteNode: %w", err)
+	}
+
+	*node = GlobalVariableAndWriteNode{
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *GlobalVariableAndWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -3186,8 +8110,8 @@ func NewGlobalVariableOperatorWriteNode(name string, nameLoc *Location, operator
 	}
 }
 
-func (node *GlobalVariableOperatorWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *GlobalVariableOperatorWriteNode) Accept(visitor Visitor) {
+	visitor.VisitGlobalVariableOperatorWriteNode(node)
 }
 
 func (node *GlobalVariableOperatorWriteNode) Children() []Node {
@@ -3198,6 +8122,14 @@ func (node *GlobalVariableOperatorWriteNode) Children() []Node {
 	return children
 }
 
+func (node *GlobalVariableOperatorWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *GlobalVariableOperatorWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "GlobalVariableOperatorWriteNode",
@@ -3210,6 +8142,63 @@ func (node *GlobalVariableOperatorWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *GlobalVariableOperatorWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", false, node.Value)
+	in.value("operator", true, inspectSymbol(node.Operator))
+
+	return in.String()
+}
+
+func (node *GlobalVariableOperatorWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+	fields = append(fields, scalarField{Name: "operator", Value: inspectSymbol(node.Operator)})
+
+	return fields
+}
+
+func (node *GlobalVariableOperatorWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Name        string          `json:"name"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Value       json.RawMessage `json:"value"`
+		Operator    string          `json:"operator"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "GlobalVariableOperatorWriteNode" {
+		return fmt.Errorf("expected a GlobalVariableOperatorWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of GlobalVariableOperatorWriteNode: %w", err)
+	}
+
+	*node = GlobalVariableOperatorWriteNode{
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Operator:    fields.Operator,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *GlobalVariableOperatorWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -3236,8 +8225,8 @@ func NewGlobalVariableOrWriteNode(name string, nameLoc *Location, operatorLoc *L
 	}
 }
 
-func (node *GlobalVariableOrWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *GlobalVariableOrWriteNode) Accept(visitor Visitor) {
+	visitor.VisitGlobalVariableOrWriteNode(node)
 }
 
 func (node *GlobalVariableOrWriteNode) Children() []Node {
@@ -3248,6 +8237,14 @@ func (node *GlobalVariableOrWriteNode) Children() []Node {
 	return children
 }
 
+func (node *GlobalVariableOrWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *GlobalVariableOrWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "GlobalVariableOrWriteNode",
@@ -3259,6 +8256,59 @@ func (node *GlobalVariableOrWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *GlobalVariableOrWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *GlobalVariableOrWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *GlobalVariableOrWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Name        string          `json:"name"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Value       json.RawMessage `json:"value"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "GlobalVariableOrWriteNode" {
+		return fmt.Errorf("expected a GlobalVariableOrWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of GlobalVariableOrWriteNode: %w", err)
+	}
+
+	*node = GlobalVariableOrWriteNode{
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *GlobalVariableOrWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -3279,22 +8329,67 @@ func NewGlobalVariableReadNode(name string, loc *Location) *GlobalVariableReadNo
 	}
 }
 
-func (node *GlobalVariableReadNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *GlobalVariableReadNode) Accept(visitor Visitor) {
+	visitor.VisitGlobalVariableReadNode(node)
+}
+
+func (node *GlobalVariableReadNode) Children() []Node {
+	children := make([]Node, 0)
+
+	return children
+}
+
+func (node *GlobalVariableReadNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
+func (node *GlobalVariableReadNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"nodeName": "GlobalVariableReadNode",
+		"name":     node.Name,
+		"loc":      node.Loc,
+	})
+}
+
+func (node *GlobalVariableReadNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", true, inspectSymbol(node.Name))
+
+	return in.String()
+}
+
+func (node *GlobalVariableReadNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
 }
 
-func (node *GlobalVariableReadNode) Children() []Node {
-	children := make([]Node, 0)
+func (node *GlobalVariableReadNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Name     string    `json:"name"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "GlobalVariableReadNode" {
+		return fmt.Errorf("expected a GlobalVariableReadNode, got %q", fields.NodeName)
+	}
 
-	return children
-}
+	*node = GlobalVariableReadNode{
+		Name: fields.Name,
+		Loc:  fields.Loc,
+	}
 
-func (node *GlobalVariableReadNode) MarshalJSON() ([]byte, error) {
-	return json.Marshal(map[string]interface{}{
-		"nodeName": "GlobalVariableReadNode",
-		"name":     node.Name,
-		"loc":      node.Loc,
-	})
+	return nil
 }
 
 func (node *GlobalVariableReadNode) Location() *Location {
@@ -3317,8 +8412,8 @@ func NewGlobalVariableTargetNode(name string, loc *Location) *GlobalVariableTarg
 	}
 }
 
-func (node *GlobalVariableTargetNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *GlobalVariableTargetNode) Accept(visitor Visitor) {
+	visitor.VisitGlobalVariableTargetNode(node)
 }
 
 func (node *GlobalVariableTargetNode) Children() []Node {
@@ -3327,6 +8422,12 @@ func (node *GlobalVariableTargetNode) Children() []Node {
 	return children
 }
 
+func (node *GlobalVariableTargetNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *GlobalVariableTargetNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "GlobalVariableTargetNode",
@@ -3335,6 +8436,45 @@ func (node *GlobalVariableTargetNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *GlobalVariableTargetNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", true, inspectSymbol(node.Name))
+
+	return in.String()
+}
+
+func (node *GlobalVariableTargetNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *GlobalVariableTargetNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Name     string    `json:"name"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "GlobalVariableTargetNode" {
+		return fmt.Errorf("expected a GlobalVariableTargetNode, got %q", fields.NodeName)
+	}
+
+	*node = GlobalVariableTargetNode{
+		Name: fields.Name,
+		Loc:  fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *GlobalVariableTargetNode) Location() *Location {
 	return node.Loc
 }
@@ -3361,8 +8501,8 @@ func NewGlobalVariableWriteNode(name string, nameLoc *Location, value Node, oper
 	}
 }
 
-func (node *GlobalVariableWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *GlobalVariableWriteNode) Accept(visitor Visitor) {
+	visitor.VisitGlobalVariableWriteNode(node)
 }
 
 func (node *GlobalVariableWriteNode) Children() []Node {
@@ -3373,6 +8513,14 @@ func (node *GlobalVariableWriteNode) Children() []Node {
 	return children
 }
 
+func (node *GlobalVariableWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *GlobalVariableWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "GlobalVariableWriteNode",
@@ -3384,6 +8532,59 @@ func (node *GlobalVariableWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *GlobalVariableWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.node("value", false, node.Value)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *GlobalVariableWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *GlobalVariableWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Name        string          `json:"name"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Value       json.RawMessage `json:"value"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "GlobalVariableWriteNode" {
+		return fmt.Errorf("expected a GlobalVariableWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of GlobalVariableWriteNode: %w", err)
+	}
+
+	*node = GlobalVariableWriteNode{
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Value:       value,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *GlobalVariableWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -3408,8 +8609,8 @@ func NewHashNode(openingLoc *Location, elements []Node, closingLoc *Location, lo
 	}
 }
 
-func (node *HashNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *HashNode) Accept(visitor Visitor) {
+	visitor.VisitHashNode(node)
 }
 
 func (node *HashNode) Children() []Node {
@@ -3420,6 +8621,16 @@ func (node *HashNode) Children() []Node {
 	return children
 }
 
+func (node *HashNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	for i, child := range node.Elements {
+		children = append(children, NamedChild{Name: "elements", Index: i, Node: child})
+	}
+
+	return children
+}
+
 func (node *HashNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "HashNode",
@@ -3430,6 +8641,48 @@ func (node *HashNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *HashNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("opening_loc", false, node.Openingloc)
+	in.nodes("elements", false, node.Elements)
+	in.location("closing_loc", true, node.Closingloc)
+
+	return in.String()
+}
+
+func (node *HashNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Openingloc *Location       `json:"openingLoc"`
+		Elements   json.RawMessage `json:"elements"`
+		Closingloc *Location       `json:"closingLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "HashNode" {
+		return fmt.Errorf("expected a HashNode, got %q", fields.NodeName)
+	}
+
+	elements, err := unmarshalNodes(fields.Elements)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal elements of HashNode: %w", err)
+	}
+
+	*node = HashNode{
+		Openingloc: fields.Openingloc,
+		Elements:   elements,
+		Closingloc: fields.Closingloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *HashNode) Location() *Location {
 	return node.Loc
 }
@@ -3461,8 +8714,8 @@ func NewHashPatternNode(constant Node, elements []Node, rest Node, openingLoc *L
 	}
 }
 
-func (node *HashPatternNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *HashPatternNode) Accept(visitor Visitor) {
+	visitor.VisitHashPatternNode(node)
 }
 
 func (node *HashPatternNode) Children() []Node {
@@ -3481,6 +8734,24 @@ func (node *HashPatternNode) Children() []Node {
 	return children
 }
 
+func (node *HashPatternNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Constant != nil {
+		children = append(children, NamedChild{Name: "constant", Index: -1, Node: node.Constant})
+	}
+
+	for i, child := range node.Elements {
+		children = append(children, NamedChild{Name: "elements", Index: i, Node: child})
+	}
+
+	if node.Rest != nil {
+		children = append(children, NamedChild{Name: "rest", Index: -1, Node: node.Rest})
+	}
+
+	return children
+}
+
 func (node *HashPatternNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "HashPatternNode",
@@ -3493,6 +8764,64 @@ func (node *HashPatternNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *HashPatternNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("constant", false, node.Constant)
+	in.nodes("elements", false, node.Elements)
+	in.node("rest", false, node.Rest)
+	in.location("opening_loc", false, node.Openingloc)
+	in.location("closing_loc", true, node.Closingloc)
+
+	return in.String()
+}
+
+func (node *HashPatternNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Constant   json.RawMessage `json:"constant"`
+		Elements   json.RawMessage `json:"elements"`
+		Rest       json.RawMessage `json:"rest"`
+		Openingloc *Location       `json:"openingLoc"`
+		Closingloc *Location       `json:"closingLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "HashPatternNode" {
+		return fmt.Errorf("expected a HashPatternNode, got %q", fields.NodeName)
+	}
+
+	constant, err := UnmarshalNode(fields.Constant)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal constant of HashPatternNode: %w", err)
+	}
+
+	elements, err := unmarshalNodes(fields.Elements)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal elements of HashPatternNode: %w", err)
+	}
+
+	rest, err := UnmarshalNode(fields.Rest)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal rest of HashPatternNode: %w", err)
+	}
+
+	*node = HashPatternNode{
+		Constant:   constant,
+		Elements:   elements,
+		Rest:       rest,
+		Openingloc: fields.Openingloc,
+		Closingloc: fields.Closingloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *HashPatternNode) Location() *Location {
 	return node.Loc
 }
@@ -3526,8 +8855,8 @@ func NewIfNode(ifKeywordLoc *Location, predicate Node, thenKeywordLoc *Location,
 	}
 }
 
-func (node *IfNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *IfNode) Accept(visitor Visitor) {
+	visitor.VisitIfNode(node)
 }
 
 func (node *IfNode) Children() []Node {
@@ -3546,6 +8875,22 @@ func (node *IfNode) Children() []Node {
 	return children
 }
 
+func (node *IfNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "predicate", Index: -1, Node: node.Predicate})
+
+	if node.Statements != nil {
+		children = append(children, NamedChild{Name: "statements", Index: -1, Node: node.Statements})
+	}
+
+	if node.Consequent != nil {
+		children = append(children, NamedChild{Name: "consequent", Index: -1, Node: node.Consequent})
+	}
+
+	return children
+}
+
 func (node *IfNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":       "IfNode",
@@ -3559,6 +8904,62 @@ func (node *IfNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *IfNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("if_keyword_loc", false, node.Ifkeywordloc)
+	in.node("predicate", false, node.Predicate)
+	in.location("then_keyword_loc", false, node.Thenkeywordloc)
+	in.node("statements", false, node.Statements)
+	in.node("consequent", false, node.Consequent)
+	in.location("end_keyword_loc", true, node.Endkeywordloc)
+
+	return in.String()
+}
+
+func (node *IfNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName       string          `json:"nodeName"`
+		Ifkeywordloc   *Location       `json:"ifKeywordLoc"`
+		Predicate      json.RawMessage `json:"predicate"`
+		Thenkeywordloc *Location       `json:"thenKeywordLoc"`
+		Statements     *StatementsNode `json:"statements"`
+		Consequent     json.RawMessage `json:"consequent"`
+		Endkeywordloc  *Location       `json:"endKeywordLoc"`
+		Loc            *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "IfNode" {
+		return fmt.Errorf("expected a IfNode, got %q", fields.NodeName)
+	}
+
+	predicate, err := UnmarshalNode(fields.Predicate)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal predicate of IfNode: %w", err)
+	}
+
+	consequent, err := UnmarshalNode(fields.Consequent)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal consequent of IfNode: %w", err)
+	}
+
+	*node = IfNode{
+		Ifkeywordloc:   fields.Ifkeywordloc,
+		Predicate:      predicate,
+		Thenkeywordloc: fields.Thenkeywordloc,
+		Statements:     fields.Statements,
+		Consequent:     consequent,
+		Endkeywordloc:  fields.Endkeywordloc,
+		Loc:            fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *IfNode) Location() *Location {
 	return node.Loc
 }
@@ -3579,8 +8980,8 @@ func NewImaginaryNode(numeric Node, loc *Location) *ImaginaryNode {
 	}
 }
 
-func (node *ImaginaryNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ImaginaryNode) Accept(visitor Visitor) {
+	visitor.VisitImaginaryNode(node)
 }
 
 func (node *ImaginaryNode) Children() []Node {
@@ -3591,6 +8992,14 @@ func (node *ImaginaryNode) Children() []Node {
 	return children
 }
 
+func (node *ImaginaryNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "numeric", Index: -1, Node: node.Numeric})
+
+	return children
+}
+
 func (node *ImaginaryNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "ImaginaryNode",
@@ -3599,6 +9008,42 @@ func (node *ImaginaryNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ImaginaryNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("numeric", true, node.Numeric)
+
+	return in.String()
+}
+
+func (node *ImaginaryNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string          `json:"nodeName"`
+		Numeric  json.RawMessage `json:"numeric"`
+		Loc      *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ImaginaryNode" {
+		return fmt.Errorf("expected a ImaginaryNode, got %q", fields.NodeName)
+	}
+
+	numeric, err := UnmarshalNode(fields.Numeric)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal numeric of ImaginaryNode: %w", err)
+	}
+
+	*node = ImaginaryNode{
+		Numeric: numeric,
+		Loc:     fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ImaginaryNode) Location() *Location {
 	return node.Loc
 }
@@ -3625,8 +9070,8 @@ func NewImplicitNode(value Node, loc *Location) *ImplicitNode {
 	}
 }
 
-func (node *ImplicitNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ImplicitNode) Accept(visitor Visitor) {
+	visitor.VisitImplicitNode(node)
 }
 
 func (node *ImplicitNode) Children() []Node {
@@ -3637,6 +9082,14 @@ func (node *ImplicitNode) Children() []Node {
 	return children
 }
 
+func (node *ImplicitNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *ImplicitNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "ImplicitNode",
@@ -3645,6 +9098,42 @@ func (node *ImplicitNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ImplicitNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *ImplicitNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string          `json:"nodeName"`
+		Value    json.RawMessage `json:"value"`
+		Loc      *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ImplicitNode" {
+		return fmt.Errorf("expected a ImplicitNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of ImplicitNode: %w", err)
+	}
+
+	*node = ImplicitNode{
+		Value: value,
+		Loc:   fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ImplicitNode) Location() *Location {
 	return node.Loc
 }
@@ -3672,8 +9161,8 @@ func NewImplicitRestNode(loc *Location) *ImplicitRestNode {
 	}
 }
 
-func (node *ImplicitRestNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ImplicitRestNode) Accept(visitor Visitor) {
+	visitor.VisitImplicitRestNode(node)
 }
 
 func (node *ImplicitRestNode) Children() []Node {
@@ -3682,6 +9171,12 @@ func (node *ImplicitRestNode) Children() []Node {
 	return children
 }
 
+func (node *ImplicitRestNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *ImplicitRestNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "ImplicitRestNode",
@@ -3689,6 +9184,33 @@ func (node *ImplicitRestNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ImplicitRestNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	return in.String()
+}
+
+func (node *ImplicitRestNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ImplicitRestNode" {
+		return fmt.Errorf("expected a ImplicitRestNode, got %q", fields.NodeName)
+	}
+
+	*node = ImplicitRestNode{
+		Loc: fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ImplicitRestNode) Location() *Location {
 	return node.Loc
 }
@@ -3715,8 +9237,8 @@ func NewInNode(pattern Node, statements *StatementsNode, inLoc *Location, thenLo
 	}
 }
 
-func (node *InNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *InNode) Accept(visitor Visitor) {
+	visitor.VisitInNode(node)
 }
 
 func (node *InNode) Children() []Node {
@@ -3731,6 +9253,18 @@ func (node *InNode) Children() []Node {
 	return children
 }
 
+func (node *InNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "pattern", Index: -1, Node: node.Pattern})
+
+	if node.Statements != nil {
+		children = append(children, NamedChild{Name: "statements", Index: -1, Node: node.Statements})
+	}
+
+	return children
+}
+
 func (node *InNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "InNode",
@@ -3742,6 +9276,51 @@ func (node *InNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *InNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("pattern", false, node.Pattern)
+	in.node("statements", false, node.Statements)
+	in.location("in_loc", false, node.Inloc)
+	in.location("then_loc", true, node.Thenloc)
+
+	return in.String()
+}
+
+func (node *InNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Pattern    json.RawMessage `json:"pattern"`
+		Statements *StatementsNode `json:"statements"`
+		Inloc      *Location       `json:"inLoc"`
+		Thenloc    *Location       `json:"thenLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "InNode" {
+		return fmt.Errorf("expected a InNode, got %q", fields.NodeName)
+	}
+
+	pattern, err := UnmarshalNode(fields.Pattern)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal pattern of InNode: %w", err)
+	}
+
+	*node = InNode{
+		Pattern:    pattern,
+		Statements: fields.Statements,
+		Inloc:      fields.Inloc,
+		Thenloc:    fields.Thenloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *InNode) Location() *Location {
 	return node.Loc
 }
@@ -3778,8 +9357,8 @@ func NewIndexAndWriteNode(flags CallNodeFlags, receiver Node, callOperatorLoc *L
 	}
 }
 
-func (node *IndexAndWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *IndexAndWriteNode) Accept(visitor Visitor) {
+	visitor.VisitIndexAndWriteNode(node)
 }
 
 func (node *IndexAndWriteNode) IsSafeNavigation() bool {
@@ -3794,26 +9373,46 @@ func (node *IndexAndWriteNode) IsAttributeWrite() bool {
 	return (node.Flags & CALL_NODE_ATTRIBUTE_WRITE) != 0
 }
 
-func (node *IndexAndWriteNode) IsIgnoreVisibility() bool {
-	return (node.Flags & CALL_NODE_IGNORE_VISIBILITY) != 0
+func (node *IndexAndWriteNode) IsIgnoreVisibility() bool {
+	return (node.Flags & CALL_NODE_IGNORE_VISIBILITY) != 0
+}
+
+func (node *IndexAndWriteNode) Children() []Node {
+	children := make([]Node, 0)
+
+	if node.Receiver != nil {
+		children = append(children, node.Receiver)
+	}
+
+	if node.Arguments != nil {
+		children = append(children, node.Arguments)
+	}
+
+	if node.Block != nil {
+		children = append(children, node.Block)
+	}
+
+	children = append(children, node.Value)
+
+	return children
 }
 
-func (node *IndexAndWriteNode) Children() []Node {
-	children := make([]Node, 0)
+func (node *IndexAndWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
 
 	if node.Receiver != nil {
-		children = append(children, node.Receiver)
+		children = append(children, NamedChild{Name: "receiver", Index: -1, Node: node.Receiver})
 	}
 
 	if node.Arguments != nil {
-		children = append(children, node.Arguments)
+		children = append(children, NamedChild{Name: "arguments", Index: -1, Node: node.Arguments})
 	}
 
 	if node.Block != nil {
-		children = append(children, node.Block)
+		children = append(children, NamedChild{Name: "block", Index: -1, Node: node.Block})
 	}
 
-	children = append(children, node.Value)
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
 
 	return children
 }
@@ -3834,6 +9433,86 @@ func (node *IndexAndWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *IndexAndWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.node("receiver", false, node.Receiver)
+	in.location("call_operator_loc", false, node.Calloperatorloc)
+	in.location("opening_loc", false, node.Openingloc)
+	in.node("arguments", false, node.Arguments)
+	in.location("closing_loc", false, node.Closingloc)
+	in.node("block", false, node.Block)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *IndexAndWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+
+	return fields
+}
+
+func (node *IndexAndWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName        string          `json:"nodeName"`
+		Flags           CallNodeFlags   `json:"flags"`
+		Receiver        json.RawMessage `json:"receiver"`
+		Calloperatorloc *Location       `json:"callOperatorLoc"`
+		Openingloc      *Location       `json:"openingLoc"`
+		Arguments       *ArgumentsNode  `json:"arguments"`
+		Closingloc      *Location       `json:"closingLoc"`
+		Block           json.RawMessage `json:"block"`
+		Operatorloc     *Location       `json:"operatorLoc"`
+		Value           json.RawMessage `json:"value"`
+		Loc             *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "IndexAndWriteNode" {
+		return fmt.Errorf("expected a IndexAndWriteNode, got %q", fields.NodeName)
+	}
+
+	receiver, err := UnmarshalNode(fields.Receiver)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal receiver of IndexAndWriteNode: %w", err)
+	}
+
+	block, err := UnmarshalNode(fields.Block)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal block of IndexAndWriteNode: %w", err)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of IndexAndWriteNode: %w", err)
+	}
+
+	*node = IndexAndWriteNode{
+		Flags:           fields.Flags,
+		Receiver:        receiver,
+		Calloperatorloc: fields.Calloperatorloc,
+		Openingloc:      fields.Openingloc,
+		Arguments:       fields.Arguments,
+		Closingloc:      fields.Closingloc,
+		Block:           block,
+		Operatorloc:     fields.Operatorloc,
+		Value:           value,
+		Loc:             fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *IndexAndWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -3872,8 +9551,8 @@ func NewIndexOperatorWriteNode(flags CallNodeFlags, receiver Node, callOperatorL
 	}
 }
 
-func (node *IndexOperatorWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *IndexOperatorWriteNode) Accept(visitor Visitor) {
+	visitor.VisitIndexOperatorWriteNode(node)
 }
 
 func (node *IndexOperatorWriteNode) IsSafeNavigation() bool {
@@ -3912,6 +9591,26 @@ func (node *IndexOperatorWriteNode) Children() []Node {
 	return children
 }
 
+func (node *IndexOperatorWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Receiver != nil {
+		children = append(children, NamedChild{Name: "receiver", Index: -1, Node: node.Receiver})
+	}
+
+	if node.Arguments != nil {
+		children = append(children, NamedChild{Name: "arguments", Index: -1, Node: node.Arguments})
+	}
+
+	if node.Block != nil {
+		children = append(children, NamedChild{Name: "block", Index: -1, Node: node.Block})
+	}
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *IndexOperatorWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":        "IndexOperatorWriteNode",
@@ -3929,6 +9628,90 @@ func (node *IndexOperatorWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *IndexOperatorWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.node("receiver", false, node.Receiver)
+	in.location("call_operator_loc", false, node.Calloperatorloc)
+	in.location("opening_loc", false, node.Openingloc)
+	in.node("arguments", false, node.Arguments)
+	in.location("closing_loc", false, node.Closingloc)
+	in.node("block", false, node.Block)
+	in.value("operator", false, inspectSymbol(node.Operator))
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *IndexOperatorWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	fields = append(fields, scalarField{Name: "operator", Value: inspectSymbol(node.Operator)})
+
+	return fields
+}
+
+func (node *IndexOperatorWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName        string          `json:"nodeName"`
+		Flags           CallNodeFlags   `json:"flags"`
+		Receiver        json.RawMessage `json:"receiver"`
+		Calloperatorloc *Location       `json:"callOperatorLoc"`
+		Openingloc      *Location       `json:"openingLoc"`
+		Arguments       *ArgumentsNode  `json:"arguments"`
+		Closingloc      *Location       `json:"closingLoc"`
+		Block           json.RawMessage `json:"block"`
+		Operator        string          `json:"operator"`
+		Operatorloc     *Location       `json:"operatorLoc"`
+		Value           json.RawMessage `json:"value"`
+		Loc             *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "IndexOperatorWriteNode" {
+		return fmt.Errorf("expected a IndexOperatorWriteNode, got %q", fields.NodeName)
+	}
+
+	receiver, err := UnmarshalNode(fields.Receiver)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal receiver of IndexOperatorWriteNode: %w", err)
+	}
+
+	block, err := UnmarshalNode(fields.Block)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal block of IndexOperatorWriteNode: %w", err)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of IndexOperatorWriteNode: %w", err)
+	}
+
+	*node = IndexOperatorWriteNode{
+		Flags:           fields.Flags,
+		Receiver:        receiver,
+		Calloperatorloc: fields.Calloperatorloc,
+		Openingloc:      fields.Openingloc,
+		Arguments:       fields.Arguments,
+		Closingloc:      fields.Closingloc,
+		Block:           block,
+		Operator:        fields.Operator,
+		Operatorloc:     fields.Operatorloc,
+		Value:           value,
+		Loc:             fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *IndexOperatorWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -3965,8 +9748,8 @@ func NewIndexOrWriteNode(flags CallNodeFlags, receiver Node, callOperatorLoc *Lo
 	}
 }
 
-func (node *IndexOrWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *IndexOrWriteNode) Accept(visitor Visitor) {
+	visitor.VisitIndexOrWriteNode(node)
 }
 
 func (node *IndexOrWriteNode) IsSafeNavigation() bool {
@@ -4005,6 +9788,26 @@ func (node *IndexOrWriteNode) Children() []Node {
 	return children
 }
 
+func (node *IndexOrWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Receiver != nil {
+		children = append(children, NamedChild{Name: "receiver", Index: -1, Node: node.Receiver})
+	}
+
+	if node.Arguments != nil {
+		children = append(children, NamedChild{Name: "arguments", Index: -1, Node: node.Arguments})
+	}
+
+	if node.Block != nil {
+		children = append(children, NamedChild{Name: "block", Index: -1, Node: node.Block})
+	}
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *IndexOrWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":        "IndexOrWriteNode",
@@ -4021,6 +9824,86 @@ func (node *IndexOrWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *IndexOrWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.node("receiver", false, node.Receiver)
+	in.location("call_operator_loc", false, node.Calloperatorloc)
+	in.location("opening_loc", false, node.Openingloc)
+	in.node("arguments", false, node.Arguments)
+	in.location("closing_loc", false, node.Closingloc)
+	in.node("block", false, node.Block)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *IndexOrWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+
+	return fields
+}
+
+func (node *IndexOrWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName        string          `json:"nodeName"`
+		Flags           CallNodeFlags   `json:"flags"`
+		Receiver        json.RawMessage `json:"receiver"`
+		Calloperatorloc *Location       `json:"callOperatorLoc"`
+		Openingloc      *Location       `json:"openingLoc"`
+		Arguments       *ArgumentsNode  `json:"arguments"`
+		Closingloc      *Location       `json:"closingLoc"`
+		Block           json.RawMessage `json:"block"`
+		Operatorloc     *Location       `json:"operatorLoc"`
+		Value           json.RawMessage `json:"value"`
+		Loc             *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "IndexOrWriteNode" {
+		return fmt.Errorf("expected a IndexOrWriteNode, got %q", fields.NodeName)
+	}
+
+	receiver, err := UnmarshalNode(fields.Receiver)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal receiver of IndexOrWriteNode: %w", err)
+	}
+
+	block, err := UnmarshalNode(fields.Block)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal block of IndexOrWriteNode: %w", err)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of IndexOrWriteNode: %w", err)
+	}
+
+	*node = IndexOrWriteNode{
+		Flags:           fields.Flags,
+		Receiver:        receiver,
+		Calloperatorloc: fields.Calloperatorloc,
+		Openingloc:      fields.Openingloc,
+		Arguments:       fields.Arguments,
+		Closingloc:      fields.Closingloc,
+		Block:           block,
+		Operatorloc:     fields.Operatorloc,
+		Value:           value,
+		Loc:             fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *IndexOrWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -4059,8 +9942,8 @@ func NewIndexTargetNode(flags CallNodeFlags, receiver Node, openingLoc *Location
 	}
 }
 
-func (node *IndexTargetNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *IndexTargetNode) Accept(visitor Visitor) {
+	visitor.VisitIndexTargetNode(node)
 }
 
 func (node *IndexTargetNode) IsSafeNavigation() bool {
@@ -4095,6 +9978,22 @@ func (node *IndexTargetNode) Children() []Node {
 	return children
 }
 
+func (node *IndexTargetNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "receiver", Index: -1, Node: node.Receiver})
+
+	if node.Arguments != nil {
+		children = append(children, NamedChild{Name: "arguments", Index: -1, Node: node.Arguments})
+	}
+
+	if node.Block != nil {
+		children = append(children, NamedChild{Name: "block", Index: -1, Node: node.Block})
+	}
+
+	return children
+}
+
 func (node *IndexTargetNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "IndexTargetNode",
@@ -4108,6 +10007,72 @@ func (node *IndexTargetNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *IndexTargetNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.node("receiver", false, node.Receiver)
+	in.location("opening_loc", false, node.Openingloc)
+	in.node("arguments", false, node.Arguments)
+	in.location("closing_loc", false, node.Closingloc)
+	in.node("block", true, node.Block)
+
+	return in.String()
+}
+
+func (node *IndexTargetNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+
+	return fields
+}
+
+func (node *IndexTargetNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Flags      CallNodeFlags   `json:"flags"`
+		Receiver   json.RawMessage `json:"receiver"`
+		Openingloc *Location       `json:"openingLoc"`
+		Arguments  *ArgumentsNode  `json:"arguments"`
+		Closingloc *Location       `json:"closingLoc"`
+		Block      json.RawMessage `json:"block"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "IndexTargetNode" {
+		return fmt.Errorf("expected a IndexTargetNode, got %q", fields.NodeName)
+	}
+
+	receiver, err := UnmarshalNode(fields.Receiver)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal receiver of IndexTargetNode: %w", err)
+	}
+
+	block, err := UnmarshalNode(fields.Block)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal block of IndexTargetNode: %w", err)
+	}
+
+	*node = IndexTargetNode{
+		Flags:      fields.Flags,
+		Receiver:   receiver,
+		Openingloc: fields.Openingloc,
+		Arguments:  fields.Arguments,
+		Closingloc: fields.Closingloc,
+		Block:      block,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *IndexTargetNode) Location() *Location {
 	return node.Loc
 }
@@ -4134,8 +10099,8 @@ func NewInstanceVariableAndWriteNode(name string, nameLoc *Location, operatorLoc
 	}
 }
 
-func (node *InstanceVariableAndWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *InstanceVariableAndWriteNode) Accept(visitor Visitor) {
+	visitor.VisitInstanceVariableAndWriteNode(node)
 }
 
 func (node *InstanceVariableAndWriteNode) Children() []Node {
@@ -4146,6 +10111,14 @@ func (node *InstanceVariableAndWriteNode) Children() []Node {
 	return children
 }
 
+func (node *InstanceVariableAndWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *InstanceVariableAndWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "InstanceVariableAndWriteNode",
@@ -4157,6 +10130,59 @@ func (node *InstanceVariableAndWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *InstanceVariableAndWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *InstanceVariableAndWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *InstanceVariableAndWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Name        string          `json:"name"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Value       json.RawMessage `json:"value"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "InstanceVariableAndWriteNode" {
+		return fmt.Errorf("expected a InstanceVariableAndWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of InstanceVariableAndWriteNode: %w", err)
+	}
+
+	*node = InstanceVariableAndWriteNode{
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *InstanceVariableAndWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -4185,8 +10211,8 @@ func NewInstanceVariableOperatorWriteNode(name string, nameLoc *Location, operat
 	}
 }
 
-func (node *InstanceVariableOperatorWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *InstanceVariableOperatorWriteNode) Accept(visitor Visitor) {
+	visitor.VisitInstanceVariableOperatorWriteNode(node)
 }
 
 func (node *InstanceVariableOperatorWriteNode) Children() []Node {
@@ -4197,6 +10223,14 @@ func (node *InstanceVariableOperatorWriteNode) Children() []Node {
 	return children
 }
 
+func (node *InstanceVariableOperatorWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *InstanceVariableOperatorWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "InstanceVariableOperatorWriteNode",
@@ -4209,6 +10243,63 @@ func (node *InstanceVariableOperatorWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *InstanceVariableOperatorWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", false, node.Value)
+	in.value("operator", true, inspectSymbol(node.Operator))
+
+	return in.String()
+}
+
+func (node *InstanceVariableOperatorWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+	fields = append(fields, scalarField{Name: "operator", Value: inspectSymbol(node.Operator)})
+
+	return fields
+}
+
+func (node *InstanceVariableOperatorWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Name        string          `json:"name"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Value       json.RawMessage `json:"value"`
+		Operator    string          `json:"operator"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "InstanceVariableOperatorWriteNode" {
+		return fmt.Errorf("expected a InstanceVariableOperatorWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of InstanceVariableOperatorWriteNode: %w", err)
+	}
+
+	*node = InstanceVariableOperatorWriteNode{
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Operator:    fields.Operator,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *InstanceVariableOperatorWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -4235,8 +10326,8 @@ func NewInstanceVariableOrWriteNode(name string, nameLoc *Location, operatorLoc
 	}
 }
 
-func (node *InstanceVariableOrWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *InstanceVariableOrWriteNode) Accept(visitor Visitor) {
+	visitor.VisitInstanceVariableOrWriteNode(node)
 }
 
 func (node *InstanceVariableOrWriteNode) Children() []Node {
@@ -4247,6 +10338,14 @@ func (node *InstanceVariableOrWriteNode) Children() []Node {
 	return children
 }
 
+func (node *InstanceVariableOrWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *InstanceVariableOrWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "InstanceVariableOrWriteNode",
@@ -4258,6 +10357,59 @@ func (node *InstanceVariableOrWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *InstanceVariableOrWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *InstanceVariableOrWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *InstanceVariableOrWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Name        string          `json:"name"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Value       json.RawMessage `json:"value"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "InstanceVariableOrWriteNode" {
+		return fmt.Errorf("expected a InstanceVariableOrWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of InstanceVariableOrWriteNode: %w", err)
+	}
+
+	*node = InstanceVariableOrWriteNode{
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *InstanceVariableOrWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -4278,8 +10430,8 @@ func NewInstanceVariableReadNode(name string, loc *Location) *InstanceVariableRe
 	}
 }
 
-func (node *InstanceVariableReadNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *InstanceVariableReadNode) Accept(visitor Visitor) {
+	visitor.VisitInstanceVariableReadNode(node)
 }
 
 func (node *InstanceVariableReadNode) Children() []Node {
@@ -4288,6 +10440,12 @@ func (node *InstanceVariableReadNode) Children() []Node {
 	return children
 }
 
+func (node *InstanceVariableReadNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *InstanceVariableReadNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "InstanceVariableReadNode",
@@ -4296,6 +10454,45 @@ func (node *InstanceVariableReadNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *InstanceVariableReadNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", true, inspectSymbol(node.Name))
+
+	return in.String()
+}
+
+func (node *InstanceVariableReadNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *InstanceVariableReadNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Name     string    `json:"name"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "InstanceVariableReadNode" {
+		return fmt.Errorf("expected a InstanceVariableReadNode, got %q", fields.NodeName)
+	}
+
+	*node = InstanceVariableReadNode{
+		Name: fields.Name,
+		Loc:  fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *InstanceVariableReadNode) Location() *Location {
 	return node.Loc
 }
@@ -4316,12 +10513,18 @@ func NewInstanceVariableTargetNode(name string, loc *Location) *InstanceVariable
 	}
 }
 
-func (node *InstanceVariableTargetNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *InstanceVariableTargetNode) Accept(visitor Visitor) {
+	visitor.VisitInstanceVariableTargetNode(node)
+}
+
+func (node *InstanceVariableTargetNode) Children() []Node {
+	children := make([]Node, 0)
+
+	return children
 }
 
-func (node *InstanceVariableTargetNode) Children() []Node {
-	children := make([]Node, 0)
+func (node *InstanceVariableTargetNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
 
 	return children
 }
@@ -4334,6 +10537,45 @@ func (node *InstanceVariableTargetNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *InstanceVariableTargetNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", true, inspectSymbol(node.Name))
+
+	return in.String()
+}
+
+func (node *InstanceVariableTargetNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *InstanceVariableTargetNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Name     string    `json:"name"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "InstanceVariableTargetNode" {
+		return fmt.Errorf("expected a InstanceVariableTargetNode, got %q", fields.NodeName)
+	}
+
+	*node = InstanceVariableTargetNode{
+		Name: fields.Name,
+		Loc:  fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *InstanceVariableTargetNode) Location() *Location {
 	return node.Loc
 }
@@ -4360,8 +10602,8 @@ func NewInstanceVariableWriteNode(name string, nameLoc *Location, value Node, op
 	}
 }
 
-func (node *InstanceVariableWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *InstanceVariableWriteNode) Accept(visitor Visitor) {
+	visitor.VisitInstanceVariableWriteNode(node)
 }
 
 func (node *InstanceVariableWriteNode) Children() []Node {
@@ -4372,6 +10614,14 @@ func (node *InstanceVariableWriteNode) Children() []Node {
 	return children
 }
 
+func (node *InstanceVariableWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *InstanceVariableWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "InstanceVariableWriteNode",
@@ -4383,6 +10633,59 @@ func (node *InstanceVariableWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *InstanceVariableWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.node("value", false, node.Value)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *InstanceVariableWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *InstanceVariableWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Name        string          `json:"name"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Value       json.RawMessage `json:"value"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "InstanceVariableWriteNode" {
+		return fmt.Errorf("expected a InstanceVariableWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of InstanceVariableWriteNode: %w", err)
+	}
+
+	*node = InstanceVariableWriteNode{
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Value:       value,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *InstanceVariableWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -4405,8 +10708,8 @@ func NewIntegerNode(flags IntegerBaseFlags, value *big.Int, loc *Location) *Inte
 	}
 }
 
-func (node *IntegerNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *IntegerNode) Accept(visitor Visitor) {
+	visitor.VisitIntegerNode(node)
 }
 
 func (node *IntegerNode) IsBinary() bool {
@@ -4431,6 +10734,12 @@ func (node *IntegerNode) Children() []Node {
 	return children
 }
 
+func (node *IntegerNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *IntegerNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "IntegerNode",
@@ -4440,6 +10749,51 @@ func (node *IntegerNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *IntegerNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.value("value", true, inspectInteger(node.Value))
+
+	return in.String()
+}
+
+func (node *IntegerNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	fields = append(fields, scalarField{Name: "value", Value: inspectInteger(node.Value)})
+
+	return fields
+}
+
+func (node *IntegerNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string           `json:"nodeName"`
+		Flags    IntegerBaseFlags `json:"flags"`
+		Value    *big.Int         `json:"value"`
+		Loc      *Location        `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "IntegerNode" {
+		return fmt.Errorf("expected a IntegerNode, got %q", fields.NodeName)
+	}
+
+	*node = IntegerNode{
+		Flags: fields.Flags,
+		Value: fields.Value,
+		Loc:   fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *IntegerNode) Location() *Location {
 	return node.Loc
 }
@@ -4466,8 +10820,8 @@ func NewInterpolatedMatchLastLineNode(flags RegularExpressionFlags, openingLoc *
 	}
 }
 
-func (node *InterpolatedMatchLastLineNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *InterpolatedMatchLastLineNode) Accept(visitor Visitor) {
+	visitor.VisitInterpolatedMatchLastLineNode(node)
 }
 
 func (node *InterpolatedMatchLastLineNode) IsIgnoreCase() bool {
@@ -4522,6 +10876,16 @@ func (node *InterpolatedMatchLastLineNode) Children() []Node {
 	return children
 }
 
+func (node *InterpolatedMatchLastLineNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	for i, child := range node.Parts {
+		children = append(children, NamedChild{Name: "parts", Index: i, Node: child})
+	}
+
+	return children
+}
+
 func (node *InterpolatedMatchLastLineNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "InterpolatedMatchLastLineNode",
@@ -4533,6 +10897,61 @@ func (node *InterpolatedMatchLastLineNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *InterpolatedMatchLastLineNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.location("opening_loc", false, node.Openingloc)
+	in.nodes("parts", false, node.Parts)
+	in.location("closing_loc", true, node.Closingloc)
+
+	return in.String()
+}
+
+func (node *InterpolatedMatchLastLineNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+
+	return fields
+}
+
+func (node *InterpolatedMatchLastLineNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string                 `json:"nodeName"`
+		Flags      RegularExpressionFlags `json:"flags"`
+		Openingloc *Location              `json:"openingLoc"`
+		Parts      json.RawMessage        `json:"parts"`
+		Closingloc *Location              `json:"closingLoc"`
+		Loc        *Location              `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "InterpolatedMatchLastLineNode" {
+		return fmt.Errorf("expected a InterpolatedMatchLastLineNode, got %q", fields.NodeName)
+	}
+
+	parts, err := unmarshalNodes(fields.Parts)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal parts of InterpolatedMatchLastLineNode: %w", err)
+	}
+
+	*node = InterpolatedMatchLastLineNode{
+		Flags:      fields.Flags,
+		Openingloc: fields.Openingloc,
+		Parts:      parts,
+		Closingloc: fields.Closingloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *InterpolatedMatchLastLineNode) Location() *Location {
 	return node.Loc
 }
@@ -4559,8 +10978,8 @@ func NewInterpolatedRegularExpressionNode(flags RegularExpressionFlags, openingL
 	}
 }
 
-func (node *InterpolatedRegularExpressionNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *InterpolatedRegularExpressionNode) Accept(visitor Visitor) {
+	visitor.VisitInterpolatedRegularExpressionNode(node)
 }
 
 func (node *InterpolatedRegularExpressionNode) IsIgnoreCase() bool {
@@ -4615,6 +11034,16 @@ func (node *InterpolatedRegularExpressionNode) Children() []Node {
 	return children
 }
 
+func (node *InterpolatedRegularExpressionNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	for i, child := range node.Parts {
+		children = append(children, NamedChild{Name: "parts", Index: i, Node: child})
+	}
+
+	return children
+}
+
 func (node *InterpolatedRegularExpressionNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "InterpolatedRegularExpressionNode",
@@ -4626,6 +11055,61 @@ func (node *InterpolatedRegularExpressionNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *InterpolatedRegularExpressionNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.location("opening_loc", false, node.Openingloc)
+	in.nodes("parts", false, node.Parts)
+	in.location("closing_loc", true, node.Closingloc)
+
+	return in.String()
+}
+
+func (node *InterpolatedRegularExpressionNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+
+	return fields
+}
+
+func (node *InterpolatedRegularExpressionNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string                 `json:"nodeName"`
+		Flags      RegularExpressionFlags `json:"flags"`
+		Openingloc *Location              `json:"openingLoc"`
+		Parts      json.RawMessage        `json:"parts"`
+		Closingloc *Location              `json:"closingLoc"`
+		Loc        *Location              `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "InterpolatedRegularExpressionNode" {
+		return fmt.Errorf("expected a InterpolatedRegularExpressionNode, got %q", fields.NodeName)
+	}
+
+	parts, err := unmarshalNodes(fields.Parts)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal parts of InterpolatedRegularExpressionNode: %w", err)
+	}
+
+	*node = InterpolatedRegularExpressionNode{
+		Flags:      fields.Flags,
+		Openingloc: fields.Openingloc,
+		Parts:      parts,
+		Closingloc: fields.Closingloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *InterpolatedRegularExpressionNode) Location() *Location {
 	return node.Loc
 }
@@ -4650,8 +11134,8 @@ func NewInterpolatedStringNode(openingLoc *Location, parts []Node, closingLoc *L
 	}
 }
 
-func (node *InterpolatedStringNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *InterpolatedStringNode) Accept(visitor Visitor) {
+	visitor.VisitInterpolatedStringNode(node)
 }
 
 func (node *InterpolatedStringNode) Children() []Node {
@@ -4662,6 +11146,16 @@ func (node *InterpolatedStringNode) Children() []Node {
 	return children
 }
 
+func (node *InterpolatedStringNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	for i, child := range node.Parts {
+		children = append(children, NamedChild{Name: "parts", Index: i, Node: child})
+	}
+
+	return children
+}
+
 func (node *InterpolatedStringNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "InterpolatedStringNode",
@@ -4672,6 +11166,48 @@ func (node *InterpolatedStringNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *InterpolatedStringNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("opening_loc", false, node.Openingloc)
+	in.nodes("parts", false, node.Parts)
+	in.location("closing_loc", true, node.Closingloc)
+
+	return in.String()
+}
+
+func (node *InterpolatedStringNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Openingloc *Location       `json:"openingLoc"`
+		Parts      json.RawMessage `json:"parts"`
+		Closingloc *Location       `json:"closingLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "InterpolatedStringNode" {
+		return fmt.Errorf("expected a InterpolatedStringNode, got %q", fields.NodeName)
+	}
+
+	parts, err := unmarshalNodes(fields.Parts)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal parts of InterpolatedStringNode: %w", err)
+	}
+
+	*node = InterpolatedStringNode{
+		Openingloc: fields.Openingloc,
+		Parts:      parts,
+		Closingloc: fields.Closingloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *InterpolatedStringNode) Location() *Location {
 	return node.Loc
 }
@@ -4696,8 +11232,8 @@ func NewInterpolatedSymbolNode(openingLoc *Location, parts []Node, closingLoc *L
 	}
 }
 
-func (node *InterpolatedSymbolNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *InterpolatedSymbolNode) Accept(visitor Visitor) {
+	visitor.VisitInterpolatedSymbolNode(node)
 }
 
 func (node *InterpolatedSymbolNode) Children() []Node {
@@ -4708,6 +11244,16 @@ func (node *InterpolatedSymbolNode) Children() []Node {
 	return children
 }
 
+func (node *InterpolatedSymbolNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	for i, child := range node.Parts {
+		children = append(children, NamedChild{Name: "parts", Index: i, Node: child})
+	}
+
+	return children
+}
+
 func (node *InterpolatedSymbolNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "InterpolatedSymbolNode",
@@ -4718,6 +11264,48 @@ func (node *InterpolatedSymbolNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *InterpolatedSymbolNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("opening_loc", false, node.Openingloc)
+	in.nodes("parts", false, node.Parts)
+	in.location("closing_loc", true, node.Closingloc)
+
+	return in.String()
+}
+
+func (node *InterpolatedSymbolNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Openingloc *Location       `json:"openingLoc"`
+		Parts      json.RawMessage `json:"parts"`
+		Closingloc *Location       `json:"closingLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "InterpolatedSymbolNode" {
+		return fmt.Errorf("expected a InterpolatedSymbolNode, got %q", fields.NodeName)
+	}
+
+	parts, err := unmarshalNodes(fields.Parts)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal parts of InterpolatedSymbolNode: %w", err)
+	}
+
+	*node = InterpolatedSymbolNode{
+		Openingloc: fields.Openingloc,
+		Parts:      parts,
+		Closingloc: fields.Closingloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *InterpolatedSymbolNode) Location() *Location {
 	return node.Loc
 }
@@ -4742,8 +11330,8 @@ func NewInterpolatedXStringNode(openingLoc *Location, parts []Node, closingLoc *
 	}
 }
 
-func (node *InterpolatedXStringNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *InterpolatedXStringNode) Accept(visitor Visitor) {
+	visitor.VisitInterpolatedXStringNode(node)
 }
 
 func (node *InterpolatedXStringNode) Children() []Node {
@@ -4754,6 +11342,16 @@ func (node *InterpolatedXStringNode) Children() []Node {
 	return children
 }
 
+func (node *InterpolatedXStringNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	for i, child := range node.Parts {
+		children = append(children, NamedChild{Name: "parts", Index: i, Node: child})
+	}
+
+	return children
+}
+
 func (node *InterpolatedXStringNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "InterpolatedXStringNode",
@@ -4764,6 +11362,48 @@ func (node *InterpolatedXStringNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *InterpolatedXStringNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("opening_loc", false, node.Openingloc)
+	in.nodes("parts", false, node.Parts)
+	in.location("closing_loc", true, node.Closingloc)
+
+	return in.String()
+}
+
+func (node *InterpolatedXStringNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Openingloc *Location       `json:"openingLoc"`
+		Parts      json.RawMessage `json:"parts"`
+		Closingloc *Location       `json:"closingLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "InterpolatedXStringNode" {
+		return fmt.Errorf("expected a InterpolatedXStringNode, got %q", fields.NodeName)
+	}
+
+	parts, err := unmarshalNodes(fields.Parts)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal parts of InterpolatedXStringNode: %w", err)
+	}
+
+	*node = InterpolatedXStringNode{
+		Openingloc: fields.Openingloc,
+		Parts:      parts,
+		Closingloc: fields.Closingloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *InterpolatedXStringNode) Location() *Location {
 	return node.Loc
 }
@@ -4782,8 +11422,8 @@ func NewItParametersNode(loc *Location) *ItParametersNode {
 	}
 }
 
-func (node *ItParametersNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ItParametersNode) Accept(visitor Visitor) {
+	visitor.VisitItParametersNode(node)
 }
 
 func (node *ItParametersNode) Children() []Node {
@@ -4792,6 +11432,12 @@ func (node *ItParametersNode) Children() []Node {
 	return children
 }
 
+func (node *ItParametersNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *ItParametersNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "ItParametersNode",
@@ -4799,6 +11445,33 @@ func (node *ItParametersNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ItParametersNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	return in.String()
+}
+
+func (node *ItParametersNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ItParametersNode" {
+		return fmt.Errorf("expected a ItParametersNode, got %q", fields.NodeName)
+	}
+
+	*node = ItParametersNode{
+		Loc: fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ItParametersNode) Location() *Location {
 	return node.Loc
 }
@@ -4821,8 +11494,8 @@ func NewKeywordHashNode(flags KeywordHashNodeFlags, elements []Node, loc *Locati
 	}
 }
 
-func (node *KeywordHashNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *KeywordHashNode) Accept(visitor Visitor) {
+	visitor.VisitKeywordHashNode(node)
 }
 
 func (node *KeywordHashNode) IsSymbolKeys() bool {
@@ -4837,6 +11510,16 @@ func (node *KeywordHashNode) Children() []Node {
 	return children
 }
 
+func (node *KeywordHashNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	for i, child := range node.Elements {
+		children = append(children, NamedChild{Name: "elements", Index: i, Node: child})
+	}
+
+	return children
+}
+
 func (node *KeywordHashNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "KeywordHashNode",
@@ -4846,6 +11529,55 @@ func (node *KeywordHashNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *KeywordHashNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.nodes("elements", true, node.Elements)
+
+	return in.String()
+}
+
+func (node *KeywordHashNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+
+	return fields
+}
+
+func (node *KeywordHashNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string               `json:"nodeName"`
+		Flags    KeywordHashNodeFlags `json:"flags"`
+		Elements json.RawMessage      `json:"elements"`
+		Loc      *Location            `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "KeywordHashNode" {
+		return fmt.Errorf("expected a KeywordHashNode, got %q", fields.NodeName)
+	}
+
+	elements, err := unmarshalNodes(fields.Elements)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal elements of KeywordHashNode: %w", err)
+	}
+
+	*node = KeywordHashNode{
+		Flags:    fields.Flags,
+		Elements: elements,
+		Loc:      fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *KeywordHashNode) Location() *Location {
 	return node.Loc
 }
@@ -4873,8 +11605,8 @@ func NewKeywordRestParameterNode(flags ParameterFlags, name *string, nameLoc *Lo
 	}
 }
 
-func (node *KeywordRestParameterNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *KeywordRestParameterNode) Accept(visitor Visitor) {
+	visitor.VisitKeywordRestParameterNode(node)
 }
 
 func (node *KeywordRestParameterNode) IsRepeatedParameter() bool {
@@ -4887,6 +11619,12 @@ func (node *KeywordRestParameterNode) Children() []Node {
 	return children
 }
 
+func (node *KeywordRestParameterNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *KeywordRestParameterNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "KeywordRestParameterNode",
@@ -4898,6 +11636,59 @@ func (node *KeywordRestParameterNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *KeywordRestParameterNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.optionalConstant("name", false, node.Name)
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *KeywordRestParameterNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	if node.Name != nil {
+		fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(*node.Name)})
+	}
+
+	return fields
+}
+
+func (node *KeywordRestParameterNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string         `json:"nodeName"`
+		Flags       ParameterFlags `json:"flags"`
+		Name        *string        `json:"name"`
+		Nameloc     *Location      `json:"nameLoc"`
+		Operatorloc *Location      `json:"operatorLoc"`
+		Loc         *Location      `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "KeywordRestParameterNode" {
+		return fmt.Errorf("expected a KeywordRestParameterNode, got %q", fields.NodeName)
+	}
+
+	*node = KeywordRestParameterNode{
+		Flags:       fields.Flags,
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *KeywordRestParameterNode) Location() *Location {
 	return node.Loc
 }
@@ -4928,8 +11719,8 @@ func NewLambdaNode(locals []string, operatorLoc *Location, openingLoc *Location,
 	}
 }
 
-func (node *LambdaNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *LambdaNode) Accept(visitor Visitor) {
+	visitor.VisitLambdaNode(node)
 }
 
 func (node *LambdaNode) Children() []Node {
@@ -4946,6 +11737,20 @@ func (node *LambdaNode) Children() []Node {
 	return children
 }
 
+func (node *LambdaNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Parameters != nil {
+		children = append(children, NamedChild{Name: "parameters", Index: -1, Node: node.Parameters})
+	}
+
+	if node.Body != nil {
+		children = append(children, NamedChild{Name: "body", Index: -1, Node: node.Body})
+	}
+
+	return children
+}
+
 func (node *LambdaNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "LambdaNode",
@@ -4959,6 +11764,62 @@ func (node *LambdaNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *LambdaNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.constants("locals", false, node.Locals)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.location("opening_loc", false, node.Openingloc)
+	in.location("closing_loc", false, node.Closingloc)
+	in.node("parameters", false, node.Parameters)
+	in.node("body", true, node.Body)
+
+	return in.String()
+}
+
+func (node *LambdaNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Locals      []string        `json:"locals"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Openingloc  *Location       `json:"openingLoc"`
+		Closingloc  *Location       `json:"closingLoc"`
+		Parameters  json.RawMessage `json:"parameters"`
+		Body        json.RawMessage `json:"body"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "LambdaNode" {
+		return fmt.Errorf("expected a LambdaNode, got %q", fields.NodeName)
+	}
+
+	parameters, err := UnmarshalNode(fields.Parameters)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal parameters of LambdaNode: %w", err)
+	}
+
+	body, err := UnmarshalNode(fields.Body)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal body of LambdaNode: %w", err)
+	}
+
+	*node = LambdaNode{
+		Locals:      fields.Locals,
+		Operatorloc: fields.Operatorloc,
+		Openingloc:  fields.Openingloc,
+		Closingloc:  fields.Closingloc,
+		Parameters:  parameters,
+		Body:        body,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *LambdaNode) Location() *Location {
 	return node.Loc
 }
@@ -4987,8 +11848,8 @@ func NewLocalVariableAndWriteNode(nameLoc *Location, operatorLoc *Location, valu
 	}
 }
 
-func (node *LocalVariableAndWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *LocalVariableAndWriteNode) Accept(visitor Visitor) {
+	visitor.VisitLocalVariableAndWriteNode(node)
 }
 
 func (node *LocalVariableAndWriteNode) Children() []Node {
@@ -4999,6 +11860,14 @@ func (node *LocalVariableAndWriteNode) Children() []Node {
 	return children
 }
 
+func (node *LocalVariableAndWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *LocalVariableAndWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "LocalVariableAndWriteNode",
@@ -5011,6 +11880,63 @@ func (node *LocalVariableAndWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *LocalVariableAndWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", false, node.Value)
+	in.value("name", false, inspectSymbol(node.Name))
+	in.value("depth", true, fmt.Sprint(node.Depth))
+
+	return in.String()
+}
+
+func (node *LocalVariableAndWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+	fields = append(fields, scalarField{Name: "depth", Value: fmt.Sprint(node.Depth)})
+
+	return fields
+}
+
+func (node *LocalVariableAndWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Value       json.RawMessage `json:"value"`
+		Name        string          `json:"name"`
+		Depth       uint32          `json:"depth"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "LocalVariableAndWriteNode" {
+		return fmt.Errorf("expected a LocalVariableAndWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of LocalVariableAndWriteNode: %w", err)
+	}
+
+	*node = LocalVariableAndWriteNode{
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Name:        fields.Name,
+		Depth:       fields.Depth,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *LocalVariableAndWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -5041,8 +11967,8 @@ func NewLocalVariableOperatorWriteNode(nameLoc *Location, operatorLoc *Location,
 	}
 }
 
-func (node *LocalVariableOperatorWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *LocalVariableOperatorWriteNode) Accept(visitor Visitor) {
+	visitor.VisitLocalVariableOperatorWriteNode(node)
 }
 
 func (node *LocalVariableOperatorWriteNode) Children() []Node {
@@ -5053,6 +11979,14 @@ func (node *LocalVariableOperatorWriteNode) Children() []Node {
 	return children
 }
 
+func (node *LocalVariableOperatorWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *LocalVariableOperatorWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "LocalVariableOperatorWriteNode",
@@ -5066,6 +12000,67 @@ func (node *LocalVariableOperatorWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *LocalVariableOperatorWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", false, node.Value)
+	in.value("name", false, inspectSymbol(node.Name))
+	in.value("operator", false, inspectSymbol(node.Operator))
+	in.value("depth", true, fmt.Sprint(node.Depth))
+
+	return in.String()
+}
+
+func (node *LocalVariableOperatorWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+	fields = append(fields, scalarField{Name: "operator", Value: inspectSymbol(node.Operator)})
+	fields = append(fields, scalarField{Name: "depth", Value: fmt.Sprint(node.Depth)})
+
+	return fields
+}
+
+func (node *LocalVariableOperatorWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Value       json.RawMessage `json:"value"`
+		Name        string          `json:"name"`
+		Operator    string          `json:"operator"`
+		Depth       uint32          `json:"depth"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "LocalVariableOperatorWriteNode" {
+		return fmt.Errorf("expected a LocalVariableOperatorWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of LocalVariableOperatorWriteNode: %w", err)
+	}
+
+	*node = LocalVariableOperatorWriteNode{
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Name:        fields.Name,
+		Operator:    fields.Operator,
+		Depth:       fields.Depth,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *LocalVariableOperatorWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -5094,8 +12089,8 @@ func NewLocalVariableOrWriteNode(nameLoc *Location, operatorLoc *Location, value
 	}
 }
 
-func (node *LocalVariableOrWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *LocalVariableOrWriteNode) Accept(visitor Visitor) {
+	visitor.VisitLocalVariableOrWriteNode(node)
 }
 
 func (node *LocalVariableOrWriteNode) Children() []Node {
@@ -5106,6 +12101,14 @@ func (node *LocalVariableOrWriteNode) Children() []Node {
 	return children
 }
 
+func (node *LocalVariableOrWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *LocalVariableOrWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "LocalVariableOrWriteNode",
@@ -5118,6 +12121,63 @@ func (node *LocalVariableOrWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *LocalVariableOrWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", false, node.Value)
+	in.value("name", false, inspectSymbol(node.Name))
+	in.value("depth", true, fmt.Sprint(node.Depth))
+
+	return in.String()
+}
+
+func (node *LocalVariableOrWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+	fields = append(fields, scalarField{Name: "depth", Value: fmt.Sprint(node.Depth)})
+
+	return fields
+}
+
+func (node *LocalVariableOrWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Value       json.RawMessage `json:"value"`
+		Name        string          `json:"name"`
+		Depth       uint32          `json:"depth"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "LocalVariableOrWriteNode" {
+		return fmt.Errorf("expected a LocalVariableOrWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of LocalVariableOrWriteNode: %w", err)
+	}
+
+	*node = LocalVariableOrWriteNode{
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Name:        fields.Name,
+		Depth:       fields.Depth,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *LocalVariableOrWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -5140,8 +12200,8 @@ func NewLocalVariableReadNode(name string, depth uint32, loc *Location) *LocalVa
 	}
 }
 
-func (node *LocalVariableReadNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *LocalVariableReadNode) Accept(visitor Visitor) {
+	visitor.VisitLocalVariableReadNode(node)
 }
 
 func (node *LocalVariableReadNode) Children() []Node {
@@ -5150,6 +12210,12 @@ func (node *LocalVariableReadNode) Children() []Node {
 	return children
 }
 
+func (node *LocalVariableReadNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *LocalVariableReadNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "LocalVariableReadNode",
@@ -5159,6 +12225,49 @@ func (node *LocalVariableReadNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *LocalVariableReadNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.value("depth", true, fmt.Sprint(node.Depth))
+
+	return in.String()
+}
+
+func (node *LocalVariableReadNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+	fields = append(fields, scalarField{Name: "depth", Value: fmt.Sprint(node.Depth)})
+
+	return fields
+}
+
+func (node *LocalVariableReadNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Name     string    `json:"name"`
+		Depth    uint32    `json:"depth"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "LocalVariableReadNode" {
+		return fmt.Errorf("expected a LocalVariableReadNode, got %q", fields.NodeName)
+	}
+
+	*node = LocalVariableReadNode{
+		Name:  fields.Name,
+		Depth: fields.Depth,
+		Loc:   fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *LocalVariableReadNode) Location() *Location {
 	return node.Loc
 }
@@ -5181,8 +12290,8 @@ func NewLocalVariableTargetNode(name string, depth uint32, loc *Location) *Local
 	}
 }
 
-func (node *LocalVariableTargetNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *LocalVariableTargetNode) Accept(visitor Visitor) {
+	visitor.VisitLocalVariableTargetNode(node)
 }
 
 func (node *LocalVariableTargetNode) Children() []Node {
@@ -5191,6 +12300,12 @@ func (node *LocalVariableTargetNode) Children() []Node {
 	return children
 }
 
+func (node *LocalVariableTargetNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *LocalVariableTargetNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "LocalVariableTargetNode",
@@ -5200,6 +12315,49 @@ func (node *LocalVariableTargetNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *LocalVariableTargetNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.value("depth", true, fmt.Sprint(node.Depth))
+
+	return in.String()
+}
+
+func (node *LocalVariableTargetNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+	fields = append(fields, scalarField{Name: "depth", Value: fmt.Sprint(node.Depth)})
+
+	return fields
+}
+
+func (node *LocalVariableTargetNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Name     string    `json:"name"`
+		Depth    uint32    `json:"depth"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "LocalVariableTargetNode" {
+		return fmt.Errorf("expected a LocalVariableTargetNode, got %q", fields.NodeName)
+	}
+
+	*node = LocalVariableTargetNode{
+		Name:  fields.Name,
+		Depth: fields.Depth,
+		Loc:   fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *LocalVariableTargetNode) Location() *Location {
 	return node.Loc
 }
@@ -5228,8 +12386,8 @@ func NewLocalVariableWriteNode(name string, depth uint32, nameLoc *Location, val
 	}
 }
 
-func (node *LocalVariableWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *LocalVariableWriteNode) Accept(visitor Visitor) {
+	visitor.VisitLocalVariableWriteNode(node)
 }
 
 func (node *LocalVariableWriteNode) Children() []Node {
@@ -5240,6 +12398,14 @@ func (node *LocalVariableWriteNode) Children() []Node {
 	return children
 }
 
+func (node *LocalVariableWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *LocalVariableWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "LocalVariableWriteNode",
@@ -5252,6 +12418,63 @@ func (node *LocalVariableWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *LocalVariableWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("name", false, inspectSymbol(node.Name))
+	in.value("depth", false, fmt.Sprint(node.Depth))
+	in.location("name_loc", false, node.Nameloc)
+	in.node("value", false, node.Value)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *LocalVariableWriteNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+	fields = append(fields, scalarField{Name: "depth", Value: fmt.Sprint(node.Depth)})
+
+	return fields
+}
+
+func (node *LocalVariableWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Name        string          `json:"name"`
+		Depth       uint32          `json:"depth"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Value       json.RawMessage `json:"value"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "LocalVariableWriteNode" {
+		return fmt.Errorf("expected a LocalVariableWriteNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of LocalVariableWriteNode: %w", err)
+	}
+
+	*node = LocalVariableWriteNode{
+		Name:        fields.Name,
+		Depth:       fields.Depth,
+		Nameloc:     fields.Nameloc,
+		Value:       value,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *LocalVariableWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -5280,8 +12503,8 @@ func NewMatchLastLineNode(flags RegularExpressionFlags, openingLoc *Location, co
 	}
 }
 
-func (node *MatchLastLineNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *MatchLastLineNode) Accept(visitor Visitor) {
+	visitor.VisitMatchLastLineNode(node)
 }
 
 func (node *MatchLastLineNode) IsIgnoreCase() bool {
@@ -5334,6 +12557,12 @@ func (node *MatchLastLineNode) Children() []Node {
 	return children
 }
 
+func (node *MatchLastLineNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *MatchLastLineNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "MatchLastLineNode",
@@ -5346,6 +12575,60 @@ func (node *MatchLastLineNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *MatchLastLineNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.location("opening_loc", false, node.Openingloc)
+	in.location("content_loc", false, node.Contentloc)
+	in.location("closing_loc", false, node.Closingloc)
+	in.value("unescaped", true, inspectString([]byte(node.Unescaped)))
+
+	return in.String()
+}
+
+func (node *MatchLastLineNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	fields = append(fields, scalarField{Name: "unescaped", Value: inspectString([]byte(node.Unescaped))})
+
+	return fields
+}
+
+func (node *MatchLastLineNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string                 `json:"nodeName"`
+		Flags      RegularExpressionFlags `json:"flags"`
+		Openingloc *Location              `json:"openingLoc"`
+		Contentloc *Location              `json:"contentLoc"`
+		Closingloc *Location              `json:"closingLoc"`
+		Unescaped  string                 `json:"unescaped"`
+		Loc        *Location              `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "MatchLastLineNode" {
+		return fmt.Errorf("expected a MatchLastLineNode, got %q", fields.NodeName)
+	}
+
+	*node = MatchLastLineNode{
+		Flags:      fields.Flags,
+		Openingloc: fields.Openingloc,
+		Contentloc: fields.Contentloc,
+		Closingloc: fields.Closingloc,
+		Unescaped:  fields.Unescaped,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *MatchLastLineNode) Location() *Location {
 	return node.Loc
 }
@@ -5370,8 +12653,8 @@ func NewMatchPredicateNode(value Node, pattern Node, operatorLoc *Location, loc
 	}
 }
 
-func (node *MatchPredicateNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *MatchPredicateNode) Accept(visitor Visitor) {
+	visitor.VisitMatchPredicateNode(node)
 }
 
 func (node *MatchPredicateNode) Children() []Node {
@@ -5384,6 +12667,16 @@ func (node *MatchPredicateNode) Children() []Node {
 	return children
 }
 
+func (node *MatchPredicateNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	children = append(children, NamedChild{Name: "pattern", Index: -1, Node: node.Pattern})
+
+	return children
+}
+
 func (node *MatchPredicateNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "MatchPredicateNode",
@@ -5394,6 +12687,53 @@ func (node *MatchPredicateNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *MatchPredicateNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("value", false, node.Value)
+	in.node("pattern", false, node.Pattern)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *MatchPredicateNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Value       json.RawMessage `json:"value"`
+		Pattern     json.RawMessage `json:"pattern"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "MatchPredicateNode" {
+		return fmt.Errorf("expected a MatchPredicateNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of MatchPredicateNode: %w", err)
+	}
+
+	pattern, err := UnmarshalNode(fields.Pattern)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal pattern of MatchPredicateNode: %w", err)
+	}
+
+	*node = MatchPredicateNode{
+		Value:       value,
+		Pattern:     pattern,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *MatchPredicateNode) Location() *Location {
 	return node.Loc
 }
@@ -5418,8 +12758,8 @@ func NewMatchRequiredNode(value Node, pattern Node, operatorLoc *Location, loc *
 	}
 }
 
-func (node *MatchRequiredNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *MatchRequiredNode) Accept(visitor Visitor) {
+	visitor.VisitMatchRequiredNode(node)
 }
 
 func (node *MatchRequiredNode) Children() []Node {
@@ -5432,6 +12772,16 @@ func (node *MatchRequiredNode) Children() []Node {
 	return children
 }
 
+func (node *MatchRequiredNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	children = append(children, NamedChild{Name: "pattern", Index: -1, Node: node.Pattern})
+
+	return children
+}
+
 func (node *MatchRequiredNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "MatchRequiredNode",
@@ -5442,6 +12792,53 @@ func (node *MatchRequiredNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *MatchRequiredNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("value", false, node.Value)
+	in.node("pattern", false, node.Pattern)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *MatchRequiredNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Value       json.RawMessage `json:"value"`
+		Pattern     json.RawMessage `json:"pattern"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "MatchRequiredNode" {
+		return fmt.Errorf("expected a MatchRequiredNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of MatchRequiredNode: %w", err)
+	}
+
+	pattern, err := UnmarshalNode(fields.Pattern)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal pattern of MatchRequiredNode: %w", err)
+	}
+
+	*node = MatchRequiredNode{
+		Value:       value,
+		Pattern:     pattern,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *MatchRequiredNode) Location() *Location {
 	return node.Loc
 }
@@ -5464,8 +12861,8 @@ func NewMatchWriteNode(call *CallNode, targets []Node, loc *Location) *MatchWrit
 	}
 }
 
-func (node *MatchWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *MatchWriteNode) Accept(visitor Visitor) {
+	visitor.VisitMatchWriteNode(node)
 }
 
 func (node *MatchWriteNode) Children() []Node {
@@ -5478,6 +12875,18 @@ func (node *MatchWriteNode) Children() []Node {
 	return children
 }
 
+func (node *MatchWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "call", Index: -1, Node: node.Call})
+
+	for i, child := range node.Targets {
+		children = append(children, NamedChild{Name: "targets", Index: i, Node: child})
+	}
+
+	return children
+}
+
 func (node *MatchWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "MatchWriteNode",
@@ -5487,6 +12896,45 @@ func (node *MatchWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *MatchWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("call", false, node.Call)
+	in.nodes("targets", true, node.Targets)
+
+	return in.String()
+}
+
+func (node *MatchWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string          `json:"nodeName"`
+		Call     *CallNode       `json:"call"`
+		Targets  json.RawMessage `json:"targets"`
+		Loc      *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "MatchWriteNode" {
+		return fmt.Errorf("expected a MatchWriteNode, got %q", fields.NodeName)
+	}
+
+	targets, err := unmarshalNodes(fields.Targets)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal targets of MatchWriteNode: %w", err)
+	}
+
+	*node = MatchWriteNode{
+		Call:    fields.Call,
+		Targets: targets,
+		Loc:     fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *MatchWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -5502,8 +12950,8 @@ func NewMissingNode(loc *Location) *MissingNode {
 	}
 }
 
-func (node *MissingNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *MissingNode) Accept(visitor Visitor) {
+	visitor.VisitMissingNode(node)
 }
 
 func (node *MissingNode) Children() []Node {
@@ -5512,6 +12960,12 @@ func (node *MissingNode) Children() []Node {
 	return children
 }
 
+func (node *MissingNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *MissingNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "MissingNode",
@@ -5519,6 +12973,33 @@ func (node *MissingNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *MissingNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	return in.String()
+}
+
+func (node *MissingNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "MissingNode" {
+		return fmt.Errorf("expected a MissingNode, got %q", fields.NodeName)
+	}
+
+	*node = MissingNode{
+		Loc: fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *MissingNode) Location() *Location {
 	return node.Loc
 }
@@ -5549,8 +13030,8 @@ func NewModuleNode(locals []string, moduleKeywordLoc *Location, constantPath Nod
 	}
 }
 
-func (node *ModuleNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ModuleNode) Accept(visitor Visitor) {
+	visitor.VisitModuleNode(node)
 }
 
 func (node *ModuleNode) Children() []Node {
@@ -5565,6 +13046,18 @@ func (node *ModuleNode) Children() []Node {
 	return children
 }
 
+func (node *ModuleNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "constantPath", Index: -1, Node: node.Constantpath})
+
+	if node.Body != nil {
+		children = append(children, NamedChild{Name: "body", Index: -1, Node: node.Body})
+	}
+
+	return children
+}
+
 func (node *ModuleNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":         "ModuleNode",
@@ -5578,6 +13071,70 @@ func (node *ModuleNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ModuleNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.constants("locals", false, node.Locals)
+	in.location("module_keyword_loc", false, node.Modulekeywordloc)
+	in.node("constant_path", false, node.Constantpath)
+	in.node("body", false, node.Body)
+	in.location("end_keyword_loc", false, node.Endkeywordloc)
+	in.value("name", true, inspectSymbol(node.Name))
+
+	return in.String()
+}
+
+func (node *ModuleNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *ModuleNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName         string          `json:"nodeName"`
+		Locals           []string        `json:"locals"`
+		Modulekeywordloc *Location       `json:"moduleKeywordLoc"`
+		Constantpath     json.RawMessage `json:"constantPath"`
+		Body             json.RawMessage `json:"body"`
+		Endkeywordloc    *Location       `json:"endKeywordLoc"`
+		Name             string          `json:"name"`
+		Loc              *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ModuleNode" {
+		return fmt.Errorf("expected a ModuleNode, got %q", fields.NodeName)
+	}
+
+	constantPath, err := UnmarshalNode(fields.Constantpath)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal constantPath of ModuleNode: %w", err)
+	}
+
+	body, err := UnmarshalNode(fields.Body)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal body of ModuleNode: %w", err)
+	}
+
+	*node = ModuleNode{
+		Locals:           fields.Locals,
+		Modulekeywordloc: fields.Modulekeywordloc,
+		Constantpath:     constantPath,
+		Body:             body,
+		Endkeywordloc:    fields.Endkeywordloc,
+		Name:             fields.Name,
+		Loc:              fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ModuleNode) Location() *Location {
 	return node.Loc
 }
@@ -5606,8 +13163,8 @@ func NewMultiTargetNode(lefts []Node, rest Node, rights []Node, lparenLoc *Locat
 	}
 }
 
-func (node *MultiTargetNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *MultiTargetNode) Accept(visitor Visitor) {
+	visitor.VisitMultiTargetNode(node)
 }
 
 func (node *MultiTargetNode) Children() []Node {
@@ -5624,6 +13181,24 @@ func (node *MultiTargetNode) Children() []Node {
 	return children
 }
 
+func (node *MultiTargetNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	for i, child := range node.Lefts {
+		children = append(children, NamedChild{Name: "lefts", Index: i, Node: child})
+	}
+
+	if node.Rest != nil {
+		children = append(children, NamedChild{Name: "rest", Index: -1, Node: node.Rest})
+	}
+
+	for i, child := range node.Rights {
+		children = append(children, NamedChild{Name: "rights", Index: i, Node: child})
+	}
+
+	return children
+}
+
 func (node *MultiTargetNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":  "MultiTargetNode",
@@ -5636,6 +13211,64 @@ func (node *MultiTargetNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *MultiTargetNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.nodes("lefts", false, node.Lefts)
+	in.node("rest", false, node.Rest)
+	in.nodes("rights", false, node.Rights)
+	in.location("lparen_loc", false, node.Lparenloc)
+	in.location("rparen_loc", true, node.Rparenloc)
+
+	return in.String()
+}
+
+func (node *MultiTargetNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName  string          `json:"nodeName"`
+		Lefts     json.RawMessage `json:"lefts"`
+		Rest      json.RawMessage `json:"rest"`
+		Rights    json.RawMessage `json:"rights"`
+		Lparenloc *Location       `json:"lparenLoc"`
+		Rparenloc *Location       `json:"rparenLoc"`
+		Loc       *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "MultiTargetNode" {
+		return fmt.Errorf("expected a MultiTargetNode, got %q", fields.NodeName)
+	}
+
+	lefts, err := unmarshalNodes(fields.Lefts)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal lefts of MultiTargetNode: %w", err)
+	}
+
+	rest, err := UnmarshalNode(fields.Rest)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal rest of MultiTargetNode: %w", err)
+	}
+
+	rights, err := unmarshalNodes(fields.Rights)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal rights of MultiTargetNode: %w", err)
+	}
+
+	*node = MultiTargetNode{
+		Lefts:     lefts,
+		Rest:      rest,
+		Rights:    rights,
+		Lparenloc: fields.Lparenloc,
+		Rparenloc: fields.Rparenloc,
+		Loc:       fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *MultiTargetNode) Location() *Location {
 	return node.Loc
 }
@@ -5668,8 +13301,8 @@ func NewMultiWriteNode(lefts []Node, rest Node, rights []Node, lparenLoc *Locati
 	}
 }
 
-func (node *MultiWriteNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *MultiWriteNode) Accept(visitor Visitor) {
+	visitor.VisitMultiWriteNode(node)
 }
 
 func (node *MultiWriteNode) Children() []Node {
@@ -5688,6 +13321,26 @@ func (node *MultiWriteNode) Children() []Node {
 	return children
 }
 
+func (node *MultiWriteNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	for i, child := range node.Lefts {
+		children = append(children, NamedChild{Name: "lefts", Index: i, Node: child})
+	}
+
+	if node.Rest != nil {
+		children = append(children, NamedChild{Name: "rest", Index: -1, Node: node.Rest})
+	}
+
+	for i, child := range node.Rights {
+		children = append(children, NamedChild{Name: "rights", Index: i, Node: child})
+	}
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *MultiWriteNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "MultiWriteNode",
@@ -5702,6 +13355,75 @@ func (node *MultiWriteNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *MultiWriteNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.nodes("lefts", false, node.Lefts)
+	in.node("rest", false, node.Rest)
+	in.nodes("rights", false, node.Rights)
+	in.location("lparen_loc", false, node.Lparenloc)
+	in.location("rparen_loc", false, node.Rparenloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *MultiWriteNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Lefts       json.RawMessage `json:"lefts"`
+		Rest        json.RawMessage `json:"rest"`
+		Rights      json.RawMessage `json:"rights"`
+		Lparenloc   *Location       `json:"lparenLoc"`
+		Rparenloc   *Location       `json:"rparenLoc"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Value       json.RawMessage `json:"value"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "MultiWriteNode" {
+		return fmt.Errorf("expected a MultiWriteNode, got %q", fields.NodeName)
+	}
+
+	lefts, err := unmarshalNodes(fields.Lefts)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal lefts of MultiWriteNode: %w", err)
+	}
+
+	rest, err := UnmarshalNode(fields.Rest)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal rest of MultiWriteNode: %w", err)
+	}
+
+	rights, err := unmarshalNodes(fields.Rights)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal rights of MultiWriteNode: %w", err)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of MultiWriteNode: %w", err)
+	}
+
+	*node = MultiWriteNode{
+		Lefts:       lefts,
+		Rest:        rest,
+		Rights:      rights,
+		Lparenloc:   fields.Lparenloc,
+		Rparenloc:   fields.Rparenloc,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *MultiWriteNode) Location() *Location {
 	return node.Loc
 }
@@ -5724,8 +13446,8 @@ func NewNextNode(arguments *ArgumentsNode, keywordLoc *Location, loc *Location)
 	}
 }
 
-func (node *NextNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *NextNode) Accept(visitor Visitor) {
+	visitor.VisitNextNode(node)
 }
 
 func (node *NextNode) Children() []Node {
@@ -5738,6 +13460,16 @@ func (node *NextNode) Children() []Node {
 	return children
 }
 
+func (node *NextNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Arguments != nil {
+		children = append(children, NamedChild{Name: "arguments", Index: -1, Node: node.Arguments})
+	}
+
+	return children
+}
+
 func (node *NextNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "NextNode",
@@ -5747,6 +13479,40 @@ func (node *NextNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *NextNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("arguments", false, node.Arguments)
+	in.location("keyword_loc", true, node.Keywordloc)
+
+	return in.String()
+}
+
+func (node *NextNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string         `json:"nodeName"`
+		Arguments  *ArgumentsNode `json:"arguments"`
+		Keywordloc *Location      `json:"keywordLoc"`
+		Loc        *Location      `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "NextNode" {
+		return fmt.Errorf("expected a NextNode, got %q", fields.NodeName)
+	}
+
+	*node = NextNode{
+		Arguments:  fields.Arguments,
+		Keywordloc: fields.Keywordloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *NextNode) Location() *Location {
 	return node.Loc
 }
@@ -5765,8 +13531,8 @@ func NewNilNode(loc *Location) *NilNode {
 	}
 }
 
-func (node *NilNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *NilNode) Accept(visitor Visitor) {
+	visitor.VisitNilNode(node)
 }
 
 func (node *NilNode) Children() []Node {
@@ -5775,6 +13541,12 @@ func (node *NilNode) Children() []Node {
 	return children
 }
 
+func (node *NilNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *NilNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "NilNode",
@@ -5782,6 +13554,33 @@ func (node *NilNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *NilNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	return in.String()
+}
+
+func (node *NilNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "NilNode" {
+		return fmt.Errorf("expected a NilNode, got %q", fields.NodeName)
+	}
+
+	*node = NilNode{
+		Loc: fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *NilNode) Location() *Location {
 	return node.Loc
 }
@@ -5805,8 +13604,8 @@ func NewNoKeywordsParameterNode(operatorLoc *Location, keywordLoc *Location, loc
 	}
 }
 
-func (node *NoKeywordsParameterNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *NoKeywordsParameterNode) Accept(visitor Visitor) {
+	visitor.VisitNoKeywordsParameterNode(node)
 }
 
 func (node *NoKeywordsParameterNode) Children() []Node {
@@ -5815,6 +13614,12 @@ func (node *NoKeywordsParameterNode) Children() []Node {
 	return children
 }
 
+func (node *NoKeywordsParameterNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *NoKeywordsParameterNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "NoKeywordsParameterNode",
@@ -5824,6 +13629,40 @@ func (node *NoKeywordsParameterNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *NoKeywordsParameterNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("operator_loc", false, node.Operatorloc)
+	in.location("keyword_loc", true, node.Keywordloc)
+
+	return in.String()
+}
+
+func (node *NoKeywordsParameterNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string    `json:"nodeName"`
+		Operatorloc *Location `json:"operatorLoc"`
+		Keywordloc  *Location `json:"keywordLoc"`
+		Loc         *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "NoKeywordsParameterNode" {
+		return fmt.Errorf("expected a NoKeywordsParameterNode, got %q", fields.NodeName)
+	}
+
+	*node = NoKeywordsParameterNode{
+		Operatorloc: fields.Operatorloc,
+		Keywordloc:  fields.Keywordloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *NoKeywordsParameterNode) Location() *Location {
 	return node.Loc
 }
@@ -5844,8 +13683,8 @@ func NewNumberedParametersNode(maximum uint8, loc *Location) *NumberedParameters
 	}
 }
 
-func (node *NumberedParametersNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *NumberedParametersNode) Accept(visitor Visitor) {
+	visitor.VisitNumberedParametersNode(node)
 }
 
 func (node *NumberedParametersNode) Children() []Node {
@@ -5854,6 +13693,12 @@ func (node *NumberedParametersNode) Children() []Node {
 	return children
 }
 
+func (node *NumberedParametersNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *NumberedParametersNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "NumberedParametersNode",
@@ -5862,6 +13707,45 @@ func (node *NumberedParametersNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *NumberedParametersNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("maximum", true, fmt.Sprint(node.Maximum))
+
+	return in.String()
+}
+
+func (node *NumberedParametersNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "maximum", Value: fmt.Sprint(node.Maximum)})
+
+	return fields
+}
+
+func (node *NumberedParametersNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Maximum  uint8     `json:"maximum"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "NumberedParametersNode" {
+		return fmt.Errorf("expected a NumberedParametersNode, got %q", fields.NodeName)
+	}
+
+	*node = NumberedParametersNode{
+		Maximum: fields.Maximum,
+		Loc:     fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *NumberedParametersNode) Location() *Location {
 	return node.Loc
 }
@@ -5882,8 +13766,8 @@ func NewNumberedReferenceReadNode(number uint32, loc *Location) *NumberedReferen
 	}
 }
 
-func (node *NumberedReferenceReadNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *NumberedReferenceReadNode) Accept(visitor Visitor) {
+	visitor.VisitNumberedReferenceReadNode(node)
 }
 
 func (node *NumberedReferenceReadNode) Children() []Node {
@@ -5892,12 +13776,57 @@ func (node *NumberedReferenceReadNode) Children() []Node {
 	return children
 }
 
-func (node *NumberedReferenceReadNode) MarshalJSON() ([]byte, error) {
-	return json.Marshal(map[string]interface{}{
-		"nodeName": "NumberedReferenceReadNode",
-		"number":   node.Number,
-		"loc":      node.Loc,
-	})
+func (node *NumberedReferenceReadNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
+func (node *NumberedReferenceReadNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"nodeName": "NumberedReferenceReadNode",
+		"number":   node.Number,
+		"loc":      node.Loc,
+	})
+}
+
+func (node *NumberedReferenceReadNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("number", true, fmt.Sprint(node.Number))
+
+	return in.String()
+}
+
+func (node *NumberedReferenceReadNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "number", Value: fmt.Sprint(node.Number)})
+
+	return fields
+}
+
+func (node *NumberedReferenceReadNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Number   uint32    `json:"number"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "NumberedReferenceReadNode" {
+		return fmt.Errorf("expected a NumberedReferenceReadNode, got %q", fields.NodeName)
+	}
+
+	*node = NumberedReferenceReadNode{
+		Number: fields.Number,
+		Loc:    fields.Loc,
+	}
+
+	return nil
 }
 
 func (node *NumberedReferenceReadNode) Location() *Location {
@@ -5927,8 +13856,8 @@ func NewOptionalKeywordParameterNode(flags ParameterFlags, name string, nameLoc
 	}
 }
 
-func (node *OptionalKeywordParameterNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *OptionalKeywordParameterNode) Accept(visitor Visitor) {
+	visitor.VisitOptionalKeywordParameterNode(node)
 }
 
 func (node *OptionalKeywordParameterNode) IsRepeatedParameter() bool {
@@ -5943,6 +13872,14 @@ func (node *OptionalKeywordParameterNode) Children() []Node {
 	return children
 }
 
+func (node *OptionalKeywordParameterNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *OptionalKeywordParameterNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "OptionalKeywordParameterNode",
@@ -5954,6 +13891,62 @@ func (node *OptionalKeywordParameterNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *OptionalKeywordParameterNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *OptionalKeywordParameterNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *OptionalKeywordParameterNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string          `json:"nodeName"`
+		Flags    ParameterFlags  `json:"flags"`
+		Name     string          `json:"name"`
+		Nameloc  *Location       `json:"nameLoc"`
+		Value    json.RawMessage `json:"value"`
+		Loc      *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "OptionalKeywordParameterNode" {
+		return fmt.Errorf("expected a OptionalKeywordParameterNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of OptionalKeywordParameterNode: %w", err)
+	}
+
+	*node = OptionalKeywordParameterNode{
+		Flags:   fields.Flags,
+		Name:    fields.Name,
+		Nameloc: fields.Nameloc,
+		Value:   value,
+		Loc:     fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *OptionalKeywordParameterNode) Location() *Location {
 	return node.Loc
 }
@@ -5983,8 +13976,8 @@ func NewOptionalParameterNode(flags ParameterFlags, name string, nameLoc *Locati
 	}
 }
 
-func (node *OptionalParameterNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *OptionalParameterNode) Accept(visitor Visitor) {
+	visitor.VisitOptionalParameterNode(node)
 }
 
 func (node *OptionalParameterNode) IsRepeatedParameter() bool {
@@ -5999,6 +13992,14 @@ func (node *OptionalParameterNode) Children() []Node {
 	return children
 }
 
+func (node *OptionalParameterNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "value", Index: -1, Node: node.Value})
+
+	return children
+}
+
 func (node *OptionalParameterNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "OptionalParameterNode",
@@ -6011,6 +14012,65 @@ func (node *OptionalParameterNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *OptionalParameterNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("value", true, node.Value)
+
+	return in.String()
+}
+
+func (node *OptionalParameterNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *OptionalParameterNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Flags       ParameterFlags  `json:"flags"`
+		Name        string          `json:"name"`
+		Nameloc     *Location       `json:"nameLoc"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Value       json.RawMessage `json:"value"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "OptionalParameterNode" {
+		return fmt.Errorf("expected a OptionalParameterNode, got %q", fields.NodeName)
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value of OptionalParameterNode: %w", err)
+	}
+
+	*node = OptionalParameterNode{
+		Flags:       fields.Flags,
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Value:       value,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *OptionalParameterNode) Location() *Location {
 	return node.Loc
 }
@@ -6035,8 +14095,8 @@ func NewOrNode(left Node, right Node, operatorLoc *Location, loc *Location) *OrN
 	}
 }
 
-func (node *OrNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *OrNode) Accept(visitor Visitor) {
+	visitor.VisitOrNode(node)
 }
 
 func (node *OrNode) Children() []Node {
@@ -6049,6 +14109,16 @@ func (node *OrNode) Children() []Node {
 	return children
 }
 
+func (node *OrNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "left", Index: -1, Node: node.Left})
+
+	children = append(children, NamedChild{Name: "right", Index: -1, Node: node.Right})
+
+	return children
+}
+
 func (node *OrNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "OrNode",
@@ -6059,6 +14129,53 @@ func (node *OrNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *OrNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("left", false, node.Left)
+	in.node("right", false, node.Right)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *OrNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Left        json.RawMessage `json:"left"`
+		Right       json.RawMessage `json:"right"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "OrNode" {
+		return fmt.Errorf("expected a OrNode, got %q", fields.NodeName)
+	}
+
+	left, err := UnmarshalNode(fields.Left)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal left of OrNode: %w", err)
+	}
+
+	right, err := UnmarshalNode(fields.Right)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal right of OrNode: %w", err)
+	}
+
+	*node = OrNode{
+		Left:        left,
+		Right:       right,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *OrNode) Location() *Location {
 	return node.Loc
 }
@@ -6092,8 +14209,8 @@ func NewParametersNode(requireds []Node, optionals []Node, rest Node, posts []No
 	}
 }
 
-func (node *ParametersNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ParametersNode) Accept(visitor Visitor) {
+	visitor.VisitParametersNode(node)
 }
 
 func (node *ParametersNode) Children() []Node {
@@ -6122,6 +14239,40 @@ func (node *ParametersNode) Children() []Node {
 	return children
 }
 
+func (node *ParametersNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	for i, child := range node.Requireds {
+		children = append(children, NamedChild{Name: "requireds", Index: i, Node: child})
+	}
+
+	for i, child := range node.Optionals {
+		children = append(children, NamedChild{Name: "optionals", Index: i, Node: child})
+	}
+
+	if node.Rest != nil {
+		children = append(children, NamedChild{Name: "rest", Index: -1, Node: node.Rest})
+	}
+
+	for i, child := range node.Posts {
+		children = append(children, NamedChild{Name: "posts", Index: i, Node: child})
+	}
+
+	for i, child := range node.Keywords {
+		children = append(children, NamedChild{Name: "keywords", Index: i, Node: child})
+	}
+
+	if node.Keywordrest != nil {
+		children = append(children, NamedChild{Name: "keywordRest", Index: -1, Node: node.Keywordrest})
+	}
+
+	if node.Block != nil {
+		children = append(children, NamedChild{Name: "block", Index: -1, Node: node.Block})
+	}
+
+	return children
+}
+
 func (node *ParametersNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "ParametersNode",
@@ -6136,6 +14287,85 @@ func (node *ParametersNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ParametersNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.nodes("requireds", false, node.Requireds)
+	in.nodes("optionals", false, node.Optionals)
+	in.node("rest", false, node.Rest)
+	in.nodes("posts", false, node.Posts)
+	in.nodes("keywords", false, node.Keywords)
+	in.node("keyword_rest", false, node.Keywordrest)
+	in.node("block", true, node.Block)
+
+	return in.String()
+}
+
+func (node *ParametersNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string              `json:"nodeName"`
+		Requireds   json.RawMessage     `json:"requireds"`
+		Optionals   json.RawMessage     `json:"optionals"`
+		Rest        json.RawMessage     `json:"rest"`
+		Posts       json.RawMessage     `json:"posts"`
+		Keywords    json.RawMessage     `json:"keywords"`
+		Keywordrest json.RawMessage     `json:"keywordRest"`
+		Block       *BlockParameterNode `json:"block"`
+		Loc         *Location           `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ParametersNode" {
+		return fmt.Errorf("expected a ParametersNode, got %q", fields.NodeName)
+	}
+
+	requireds, err := unmarshalNodes(fields.Requireds)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal requireds of ParametersNode: %w", err)
+	}
+
+	optionals, err := unmarshalNodes(fields.Optionals)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal optionals of ParametersNode: %w", err)
+	}
+
+	rest, err := UnmarshalNode(fields.Rest)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal rest of ParametersNode: %w", err)
+	}
+
+	posts, err := unmarshalNodes(fields.Posts)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal posts of ParametersNode: %w", err)
+	}
+
+	keywords, err := unmarshalNodes(fields.Keywords)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal keywords of ParametersNode: %w", err)
+	}
+
+	keywordRest, err := UnmarshalNode(fields.Keywordrest)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal keywordRest of ParametersNode: %w", err)
+	}
+
+	*node = ParametersNode{
+		Requireds:   requireds,
+		Optionals:   optionals,
+		Rest:        rest,
+		Posts:       posts,
+		Keywords:    keywords,
+		Keywordrest: keywordRest,
+		Block:       fields.Block,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ParametersNode) Location() *Location {
 	return node.Loc
 }
@@ -6160,8 +14390,8 @@ func NewParenthesesNode(body Node, openingLoc *Location, closingLoc *Location, l
 	}
 }
 
-func (node *ParenthesesNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ParenthesesNode) Accept(visitor Visitor) {
+	visitor.VisitParenthesesNode(node)
 }
 
 func (node *ParenthesesNode) Children() []Node {
@@ -6174,6 +14404,16 @@ func (node *ParenthesesNode) Children() []Node {
 	return children
 }
 
+func (node *ParenthesesNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Body != nil {
+		children = append(children, NamedChild{Name: "body", Index: -1, Node: node.Body})
+	}
+
+	return children
+}
+
 func (node *ParenthesesNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "ParenthesesNode",
@@ -6184,6 +14424,48 @@ func (node *ParenthesesNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ParenthesesNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("body", false, node.Body)
+	in.location("opening_loc", false, node.Openingloc)
+	in.location("closing_loc", true, node.Closingloc)
+
+	return in.String()
+}
+
+func (node *ParenthesesNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Body       json.RawMessage `json:"body"`
+		Openingloc *Location       `json:"openingLoc"`
+		Closingloc *Location       `json:"closingLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ParenthesesNode" {
+		return fmt.Errorf("expected a ParenthesesNode, got %q", fields.NodeName)
+	}
+
+	body, err := UnmarshalNode(fields.Body)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal body of ParenthesesNode: %w", err)
+	}
+
+	*node = ParenthesesNode{
+		Body:       body,
+		Openingloc: fields.Openingloc,
+		Closingloc: fields.Closingloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ParenthesesNode) Location() *Location {
 	return node.Loc
 }
@@ -6210,8 +14492,8 @@ func NewPinnedExpressionNode(expression Node, operatorLoc *Location, lparenLoc *
 	}
 }
 
-func (node *PinnedExpressionNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *PinnedExpressionNode) Accept(visitor Visitor) {
+	visitor.VisitPinnedExpressionNode(node)
 }
 
 func (node *PinnedExpressionNode) Children() []Node {
@@ -6222,6 +14504,14 @@ func (node *PinnedExpressionNode) Children() []Node {
 	return children
 }
 
+func (node *PinnedExpressionNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "expression", Index: -1, Node: node.Expression})
+
+	return children
+}
+
 func (node *PinnedExpressionNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "PinnedExpressionNode",
@@ -6233,6 +14523,51 @@ func (node *PinnedExpressionNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *PinnedExpressionNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("expression", false, node.Expression)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.location("lparen_loc", false, node.Lparenloc)
+	in.location("rparen_loc", true, node.Rparenloc)
+
+	return in.String()
+}
+
+func (node *PinnedExpressionNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Expression  json.RawMessage `json:"expression"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Lparenloc   *Location       `json:"lparenLoc"`
+		Rparenloc   *Location       `json:"rparenLoc"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "PinnedExpressionNode" {
+		return fmt.Errorf("expected a PinnedExpressionNode, got %q", fields.NodeName)
+	}
+
+	expression, err := UnmarshalNode(fields.Expression)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal expression of PinnedExpressionNode: %w", err)
+	}
+
+	*node = PinnedExpressionNode{
+		Expression:  expression,
+		Operatorloc: fields.Operatorloc,
+		Lparenloc:   fields.Lparenloc,
+		Rparenloc:   fields.Rparenloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *PinnedExpressionNode) Location() *Location {
 	return node.Loc
 }
@@ -6255,8 +14590,8 @@ func NewPinnedVariableNode(variable Node, operatorLoc *Location, loc *Location)
 	}
 }
 
-func (node *PinnedVariableNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *PinnedVariableNode) Accept(visitor Visitor) {
+	visitor.VisitPinnedVariableNode(node)
 }
 
 func (node *PinnedVariableNode) Children() []Node {
@@ -6267,6 +14602,14 @@ func (node *PinnedVariableNode) Children() []Node {
 	return children
 }
 
+func (node *PinnedVariableNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "variable", Index: -1, Node: node.Variable})
+
+	return children
+}
+
 func (node *PinnedVariableNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "PinnedVariableNode",
@@ -6276,6 +14619,45 @@ func (node *PinnedVariableNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *PinnedVariableNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("variable", false, node.Variable)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *PinnedVariableNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Variable    json.RawMessage `json:"variable"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "PinnedVariableNode" {
+		return fmt.Errorf("expected a PinnedVariableNode, got %q", fields.NodeName)
+	}
+
+	variable, err := UnmarshalNode(fields.Variable)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal variable of PinnedVariableNode: %w", err)
+	}
+
+	*node = PinnedVariableNode{
+		Variable:    variable,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *PinnedVariableNode) Location() *Location {
 	return node.Loc
 }
@@ -6302,8 +14684,8 @@ func NewPostExecutionNode(statements *StatementsNode, keywordLoc *Location, open
 	}
 }
 
-func (node *PostExecutionNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *PostExecutionNode) Accept(visitor Visitor) {
+	visitor.VisitPostExecutionNode(node)
 }
 
 func (node *PostExecutionNode) Children() []Node {
@@ -6316,6 +14698,16 @@ func (node *PostExecutionNode) Children() []Node {
 	return children
 }
 
+func (node *PostExecutionNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Statements != nil {
+		children = append(children, NamedChild{Name: "statements", Index: -1, Node: node.Statements})
+	}
+
+	return children
+}
+
 func (node *PostExecutionNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "PostExecutionNode",
@@ -6327,6 +14719,46 @@ func (node *PostExecutionNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *PostExecutionNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("statements", false, node.Statements)
+	in.location("keyword_loc", false, node.Keywordloc)
+	in.location("opening_loc", false, node.Openingloc)
+	in.location("closing_loc", true, node.Closingloc)
+
+	return in.String()
+}
+
+func (node *PostExecutionNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Statements *StatementsNode `json:"statements"`
+		Keywordloc *Location       `json:"keywordLoc"`
+		Openingloc *Location       `json:"openingLoc"`
+		Closingloc *Location       `json:"closingLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "PostExecutionNode" {
+		return fmt.Errorf("expected a PostExecutionNode, got %q", fields.NodeName)
+	}
+
+	*node = PostExecutionNode{
+		Statements: fields.Statements,
+		Keywordloc: fields.Keywordloc,
+		Openingloc: fields.Openingloc,
+		Closingloc: fields.Closingloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *PostExecutionNode) Location() *Location {
 	return node.Loc
 }
@@ -6353,8 +14785,8 @@ func NewPreExecutionNode(statements *StatementsNode, keywordLoc *Location, openi
 	}
 }
 
-func (node *PreExecutionNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *PreExecutionNode) Accept(visitor Visitor) {
+	visitor.VisitPreExecutionNode(node)
 }
 
 func (node *PreExecutionNode) Children() []Node {
@@ -6367,6 +14799,16 @@ func (node *PreExecutionNode) Children() []Node {
 	return children
 }
 
+func (node *PreExecutionNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Statements != nil {
+		children = append(children, NamedChild{Name: "statements", Index: -1, Node: node.Statements})
+	}
+
+	return children
+}
+
 func (node *PreExecutionNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "PreExecutionNode",
@@ -6378,6 +14820,46 @@ func (node *PreExecutionNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *PreExecutionNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("statements", false, node.Statements)
+	in.location("keyword_loc", false, node.Keywordloc)
+	in.location("opening_loc", false, node.Openingloc)
+	in.location("closing_loc", true, node.Closingloc)
+
+	return in.String()
+}
+
+func (node *PreExecutionNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Statements *StatementsNode `json:"statements"`
+		Keywordloc *Location       `json:"keywordLoc"`
+		Openingloc *Location       `json:"openingLoc"`
+		Closingloc *Location       `json:"closingLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "PreExecutionNode" {
+		return fmt.Errorf("expected a PreExecutionNode, got %q", fields.NodeName)
+	}
+
+	*node = PreExecutionNode{
+		Statements: fields.Statements,
+		Keywordloc: fields.Keywordloc,
+		Openingloc: fields.Openingloc,
+		Closingloc: fields.Closingloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *PreExecutionNode) Location() *Location {
 	return node.Loc
 }
@@ -6397,14 +14879,22 @@ func NewProgramNode(locals []string, statements *StatementsNode, loc *Location)
 	}
 }
 
-func (node *ProgramNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ProgramNode) Accept(visitor Visitor) {
+	visitor.VisitProgramNode(node)
+}
+
+func (node *ProgramNode) Children() []Node {
+	children := make([]Node, 0)
+
+	children = append(children, node.Statements)
+
+	return children
 }
 
-func (node *ProgramNode) Children() []Node {
-	children := make([]Node, 0)
+func (node *ProgramNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
 
-	children = append(children, node.Statements)
+	children = append(children, NamedChild{Name: "statements", Index: -1, Node: node.Statements})
 
 	return children
 }
@@ -6418,6 +14908,40 @@ func (node *ProgramNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ProgramNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.constants("locals", false, node.Locals)
+	in.node("statements", true, node.Statements)
+
+	return in.String()
+}
+
+func (node *ProgramNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Locals     []string        `json:"locals"`
+		Statements *StatementsNode `json:"statements"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ProgramNode" {
+		return fmt.Errorf("expected a ProgramNode, got %q", fields.NodeName)
+	}
+
+	*node = ProgramNode{
+		Locals:     fields.Locals,
+		Statements: fields.Statements,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ProgramNode) Location() *Location {
 	return node.Loc
 }
@@ -6447,8 +14971,8 @@ func NewRangeNode(flags RangeFlags, left Node, right Node, operatorLoc *Location
 	}
 }
 
-func (node *RangeNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *RangeNode) Accept(visitor Visitor) {
+	visitor.VisitRangeNode(node)
 }
 
 func (node *RangeNode) IsExcludeEnd() bool {
@@ -6469,6 +14993,20 @@ func (node *RangeNode) Children() []Node {
 	return children
 }
 
+func (node *RangeNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Left != nil {
+		children = append(children, NamedChild{Name: "left", Index: -1, Node: node.Left})
+	}
+
+	if node.Right != nil {
+		children = append(children, NamedChild{Name: "right", Index: -1, Node: node.Right})
+	}
+
+	return children
+}
+
 func (node *RangeNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "RangeNode",
@@ -6480,6 +15018,66 @@ func (node *RangeNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *RangeNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.node("left", false, node.Left)
+	in.node("right", false, node.Right)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *RangeNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+
+	return fields
+}
+
+func (node *RangeNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Flags       RangeFlags      `json:"flags"`
+		Left        json.RawMessage `json:"left"`
+		Right       json.RawMessage `json:"right"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "RangeNode" {
+		return fmt.Errorf("expected a RangeNode, got %q", fields.NodeName)
+	}
+
+	left, err := UnmarshalNode(fields.Left)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal left of RangeNode: %w", err)
+	}
+
+	right, err := UnmarshalNode(fields.Right)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal right of RangeNode: %w", err)
+	}
+
+	*node = RangeNode{
+		Flags:       fields.Flags,
+		Left:        left,
+		Right:       right,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *RangeNode) Location() *Location {
 	return node.Loc
 }
@@ -6500,8 +15098,8 @@ func NewRationalNode(numeric Node, loc *Location) *RationalNode {
 	}
 }
 
-func (node *RationalNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *RationalNode) Accept(visitor Visitor) {
+	visitor.VisitRationalNode(node)
 }
 
 func (node *RationalNode) Children() []Node {
@@ -6512,6 +15110,14 @@ func (node *RationalNode) Children() []Node {
 	return children
 }
 
+func (node *RationalNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "numeric", Index: -1, Node: node.Numeric})
+
+	return children
+}
+
 func (node *RationalNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "RationalNode",
@@ -6520,6 +15126,42 @@ func (node *RationalNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *RationalNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("numeric", true, node.Numeric)
+
+	return in.String()
+}
+
+func (node *RationalNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string          `json:"nodeName"`
+		Numeric  json.RawMessage `json:"numeric"`
+		Loc      *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "RationalNode" {
+		return fmt.Errorf("expected a RationalNode, got %q", fields.NodeName)
+	}
+
+	numeric, err := UnmarshalNode(fields.Numeric)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal numeric of RationalNode: %w", err)
+	}
+
+	*node = RationalNode{
+		Numeric: numeric,
+		Loc:     fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *RationalNode) Location() *Location {
 	return node.Loc
 }
@@ -6538,8 +15180,8 @@ func NewRedoNode(loc *Location) *RedoNode {
 	}
 }
 
-func (node *RedoNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *RedoNode) Accept(visitor Visitor) {
+	visitor.VisitRedoNode(node)
 }
 
 func (node *RedoNode) Children() []Node {
@@ -6548,6 +15190,12 @@ func (node *RedoNode) Children() []Node {
 	return children
 }
 
+func (node *RedoNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *RedoNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "RedoNode",
@@ -6555,6 +15203,33 @@ func (node *RedoNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *RedoNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	return in.String()
+}
+
+func (node *RedoNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "RedoNode" {
+		return fmt.Errorf("expected a RedoNode, got %q", fields.NodeName)
+	}
+
+	*node = RedoNode{
+		Loc: fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *RedoNode) Location() *Location {
 	return node.Loc
 }
@@ -6583,8 +15258,8 @@ func NewRegularExpressionNode(flags RegularExpressionFlags, openingLoc *Location
 	}
 }
 
-func (node *RegularExpressionNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *RegularExpressionNode) Accept(visitor Visitor) {
+	visitor.VisitRegularExpressionNode(node)
 }
 
 func (node *RegularExpressionNode) IsIgnoreCase() bool {
@@ -6637,6 +15312,12 @@ func (node *RegularExpressionNode) Children() []Node {
 	return children
 }
 
+func (node *RegularExpressionNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *RegularExpressionNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "RegularExpressionNode",
@@ -6649,6 +15330,60 @@ func (node *RegularExpressionNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *RegularExpressionNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.location("opening_loc", false, node.Openingloc)
+	in.location("content_loc", false, node.Contentloc)
+	in.location("closing_loc", false, node.Closingloc)
+	in.value("unescaped", true, inspectString([]byte(node.Unescaped)))
+
+	return in.String()
+}
+
+func (node *RegularExpressionNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	fields = append(fields, scalarField{Name: "unescaped", Value: inspectString([]byte(node.Unescaped))})
+
+	return fields
+}
+
+func (node *RegularExpressionNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string                 `json:"nodeName"`
+		Flags      RegularExpressionFlags `json:"flags"`
+		Openingloc *Location              `json:"openingLoc"`
+		Contentloc *Location              `json:"contentLoc"`
+		Closingloc *Location              `json:"closingLoc"`
+		Unescaped  string                 `json:"unescaped"`
+		Loc        *Location              `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "RegularExpressionNode" {
+		return fmt.Errorf("expected a RegularExpressionNode, got %q", fields.NodeName)
+	}
+
+	*node = RegularExpressionNode{
+		Flags:      fields.Flags,
+		Openingloc: fields.Openingloc,
+		Contentloc: fields.Contentloc,
+		Closingloc: fields.Closingloc,
+		Unescaped:  fields.Unescaped,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *RegularExpressionNode) Location() *Location {
 	return node.Loc
 }
@@ -6674,8 +15409,8 @@ func NewRequiredKeywordParameterNode(flags ParameterFlags, name string, nameLoc
 	}
 }
 
-func (node *RequiredKeywordParameterNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *RequiredKeywordParameterNode) Accept(visitor Visitor) {
+	visitor.VisitRequiredKeywordParameterNode(node)
 }
 
 func (node *RequiredKeywordParameterNode) IsRepeatedParameter() bool {
@@ -6688,6 +15423,12 @@ func (node *RequiredKeywordParameterNode) Children() []Node {
 	return children
 }
 
+func (node *RequiredKeywordParameterNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *RequiredKeywordParameterNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "RequiredKeywordParameterNode",
@@ -6698,6 +15439,54 @@ func (node *RequiredKeywordParameterNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *RequiredKeywordParameterNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.value("name", false, inspectSymbol(node.Name))
+	in.location("name_loc", true, node.Nameloc)
+
+	return in.String()
+}
+
+func (node *RequiredKeywordParameterNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *RequiredKeywordParameterNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string         `json:"nodeName"`
+		Flags    ParameterFlags `json:"flags"`
+		Name     string         `json:"name"`
+		Nameloc  *Location      `json:"nameLoc"`
+		Loc      *Location      `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "RequiredKeywordParameterNode" {
+		return fmt.Errorf("expected a RequiredKeywordParameterNode, got %q", fields.NodeName)
+	}
+
+	*node = RequiredKeywordParameterNode{
+		Flags:   fields.Flags,
+		Name:    fields.Name,
+		Nameloc: fields.Nameloc,
+		Loc:     fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *RequiredKeywordParameterNode) Location() *Location {
 	return node.Loc
 }
@@ -6721,8 +15510,8 @@ func NewRequiredParameterNode(flags ParameterFlags, name string, loc *Location)
 	}
 }
 
-func (node *RequiredParameterNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *RequiredParameterNode) Accept(visitor Visitor) {
+	visitor.VisitRequiredParameterNode(node)
 }
 
 func (node *RequiredParameterNode) IsRepeatedParameter() bool {
@@ -6735,6 +15524,12 @@ func (node *RequiredParameterNode) Children() []Node {
 	return children
 }
 
+func (node *RequiredParameterNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *RequiredParameterNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "RequiredParameterNode",
@@ -6744,6 +15539,51 @@ func (node *RequiredParameterNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *RequiredParameterNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.value("name", true, inspectSymbol(node.Name))
+
+	return in.String()
+}
+
+func (node *RequiredParameterNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(node.Name)})
+
+	return fields
+}
+
+func (node *RequiredParameterNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string         `json:"nodeName"`
+		Flags    ParameterFlags `json:"flags"`
+		Name     string         `json:"name"`
+		Loc      *Location      `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "RequiredParameterNode" {
+		return fmt.Errorf("expected a RequiredParameterNode, got %q", fields.NodeName)
+	}
+
+	*node = RequiredParameterNode{
+		Flags: fields.Flags,
+		Name:  fields.Name,
+		Loc:   fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *RequiredParameterNode) Location() *Location {
 	return node.Loc
 }
@@ -6768,8 +15608,8 @@ func NewRescueModifierNode(expression Node, keywordLoc *Location, rescueExpressi
 	}
 }
 
-func (node *RescueModifierNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *RescueModifierNode) Accept(visitor Visitor) {
+	visitor.VisitRescueModifierNode(node)
 }
 
 func (node *RescueModifierNode) Children() []Node {
@@ -6782,6 +15622,16 @@ func (node *RescueModifierNode) Children() []Node {
 	return children
 }
 
+func (node *RescueModifierNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "expression", Index: -1, Node: node.Expression})
+
+	children = append(children, NamedChild{Name: "rescueExpression", Index: -1, Node: node.Rescueexpression})
+
+	return children
+}
+
 func (node *RescueModifierNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":         "RescueModifierNode",
@@ -6792,6 +15642,53 @@ func (node *RescueModifierNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *RescueModifierNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.node("expression", false, node.Expression)
+	in.location("keyword_loc", false, node.Keywordloc)
+	in.node("rescue_expression", true, node.Rescueexpression)
+
+	return in.String()
+}
+
+func (node *RescueModifierNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName         string          `json:"nodeName"`
+		Expression       json.RawMessage `json:"expression"`
+		Keywordloc       *Location       `json:"keywordLoc"`
+		Rescueexpression json.RawMessage `json:"rescueExpression"`
+		Loc              *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "RescueModifierNode" {
+		return fmt.Errorf("expected a RescueModifierNode, got %q", fields.NodeName)
+	}
+
+	expression, err := UnmarshalNode(fields.Expression)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal expression of RescueModifierNode: %w", err)
+	}
+
+	rescueExpression, err := UnmarshalNode(fields.Rescueexpression)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal rescueExpression of RescueModifierNode: %w", err)
+	}
+
+	*node = RescueModifierNode{
+		Expression:       expression,
+		Keywordloc:       fields.Keywordloc,
+		Rescueexpression: rescueExpression,
+		Loc:              fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *RescueModifierNode) Location() *Location {
 	return node.Loc
 }
@@ -6827,8 +15724,8 @@ func NewRescueNode(keywordLoc *Location, exceptions []Node, operatorLoc *Locatio
 	}
 }
 
-func (node *RescueNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *RescueNode) Accept(visitor Visitor) {
+	visitor.VisitRescueNode(node)
 }
 
 func (node *RescueNode) Children() []Node {
@@ -6851,6 +15748,28 @@ func (node *RescueNode) Children() []Node {
 	return children
 }
 
+func (node *RescueNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	for i, child := range node.Exceptions {
+		children = append(children, NamedChild{Name: "exceptions", Index: i, Node: child})
+	}
+
+	if node.Reference != nil {
+		children = append(children, NamedChild{Name: "reference", Index: -1, Node: node.Reference})
+	}
+
+	if node.Statements != nil {
+		children = append(children, NamedChild{Name: "statements", Index: -1, Node: node.Statements})
+	}
+
+	if node.Consequent != nil {
+		children = append(children, NamedChild{Name: "consequent", Index: -1, Node: node.Consequent})
+	}
+
+	return children
+}
+
 func (node *RescueNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "RescueNode",
@@ -6864,6 +15783,62 @@ func (node *RescueNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *RescueNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("keyword_loc", false, node.Keywordloc)
+	in.nodes("exceptions", false, node.Exceptions)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("reference", false, node.Reference)
+	in.node("statements", false, node.Statements)
+	in.node("consequent", true, node.Consequent)
+
+	return in.String()
+}
+
+func (node *RescueNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Keywordloc  *Location       `json:"keywordLoc"`
+		Exceptions  json.RawMessage `json:"exceptions"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Reference   json.RawMessage `json:"reference"`
+		Statements  *StatementsNode `json:"statements"`
+		Consequent  *RescueNode     `json:"consequent"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "RescueNode" {
+		return fmt.Errorf("expected a RescueNode, got %q", fields.NodeName)
+	}
+
+	exceptions, err := unmarshalNodes(fields.Exceptions)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal exceptions of RescueNode: %w", err)
+	}
+
+	reference, err := UnmarshalNode(fields.Reference)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal reference of RescueNode: %w", err)
+	}
+
+	*node = RescueNode{
+		Keywordloc:  fields.Keywordloc,
+		Exceptions:  exceptions,
+		Operatorloc: fields.Operatorloc,
+		Reference:   reference,
+		Statements:  fields.Statements,
+		Consequent:  fields.Consequent,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *RescueNode) Location() *Location {
 	return node.Loc
 }
@@ -6891,8 +15866,8 @@ func NewRestParameterNode(flags ParameterFlags, name *string, nameLoc *Location,
 	}
 }
 
-func (node *RestParameterNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *RestParameterNode) Accept(visitor Visitor) {
+	visitor.VisitRestParameterNode(node)
 }
 
 func (node *RestParameterNode) IsRepeatedParameter() bool {
@@ -6902,18 +15877,77 @@ func (node *RestParameterNode) IsRepeatedParameter() bool {
 func (node *RestParameterNode) Children() []Node {
 	children := make([]Node, 0)
 
-	return children
-}
+	return children
+}
+
+func (node *RestParameterNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
+func (node *RestParameterNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"nodeName":    "RestParameterNode",
+		"flags":       node.Flags,
+		"name":        node.Name,
+		"nameLoc":     node.Nameloc,
+		"operatorLoc": node.Operatorloc,
+		"loc":         node.Loc,
+	})
+}
+
+func (node *RestParameterNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.optionalConstant("name", false, node.Name)
+	in.location("name_loc", false, node.Nameloc)
+	in.location("operator_loc", true, node.Operatorloc)
+
+	return in.String()
+}
+
+func (node *RestParameterNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	if node.Name != nil {
+		fields = append(fields, scalarField{Name: "name", Value: inspectSymbol(*node.Name)})
+	}
+
+	return fields
+}
+
+func (node *RestParameterNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string         `json:"nodeName"`
+		Flags       ParameterFlags `json:"flags"`
+		Name        *string        `json:"name"`
+		Nameloc     *Location      `json:"nameLoc"`
+		Operatorloc *Location      `json:"operatorLoc"`
+		Loc         *Location      `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "RestParameterNode" {
+		return fmt.Errorf("expected a RestParameterNode, got %q", fields.NodeName)
+	}
+
+	*node = RestParameterNode{
+		Flags:       fields.Flags,
+		Name:        fields.Name,
+		Nameloc:     fields.Nameloc,
+		Operatorloc: fields.Operatorloc,
+		Loc:         fields.Loc,
+	}
 
-func (node *RestParameterNode) MarshalJSON() ([]byte, error) {
-	return json.Marshal(map[string]interface{}{
-		"nodeName":    "RestParameterNode",
-		"flags":       node.Flags,
-		"name":        node.Name,
-		"nameLoc":     node.Nameloc,
-		"operatorLoc": node.Operatorloc,
-		"loc":         node.Loc,
-	})
+	return nil
 }
 
 func (node *RestParameterNode) Location() *Location {
@@ -6934,8 +15968,8 @@ func NewRetryNode(loc *Location) *RetryNode {
 	}
 }
 
-func (node *RetryNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *RetryNode) Accept(visitor Visitor) {
+	visitor.VisitRetryNode(node)
 }
 
 func (node *RetryNode) Children() []Node {
@@ -6944,6 +15978,12 @@ func (node *RetryNode) Children() []Node {
 	return children
 }
 
+func (node *RetryNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *RetryNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "RetryNode",
@@ -6951,6 +15991,33 @@ func (node *RetryNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *RetryNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	return in.String()
+}
+
+func (node *RetryNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "RetryNode" {
+		return fmt.Errorf("expected a RetryNode, got %q", fields.NodeName)
+	}
+
+	*node = RetryNode{
+		Loc: fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *RetryNode) Location() *Location {
 	return node.Loc
 }
@@ -6973,8 +16040,8 @@ func NewReturnNode(keywordLoc *Location, arguments *ArgumentsNode, loc *Location
 	}
 }
 
-func (node *ReturnNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *ReturnNode) Accept(visitor Visitor) {
+	visitor.VisitReturnNode(node)
 }
 
 func (node *ReturnNode) Children() []Node {
@@ -6987,6 +16054,16 @@ func (node *ReturnNode) Children() []Node {
 	return children
 }
 
+func (node *ReturnNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Arguments != nil {
+		children = append(children, NamedChild{Name: "arguments", Index: -1, Node: node.Arguments})
+	}
+
+	return children
+}
+
 func (node *ReturnNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "ReturnNode",
@@ -6996,6 +16073,40 @@ func (node *ReturnNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *ReturnNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("keyword_loc", false, node.Keywordloc)
+	in.node("arguments", true, node.Arguments)
+
+	return in.String()
+}
+
+func (node *ReturnNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string         `json:"nodeName"`
+		Keywordloc *Location      `json:"keywordLoc"`
+		Arguments  *ArgumentsNode `json:"arguments"`
+		Loc        *Location      `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "ReturnNode" {
+		return fmt.Errorf("expected a ReturnNode, got %q", fields.NodeName)
+	}
+
+	*node = ReturnNode{
+		Keywordloc: fields.Keywordloc,
+		Arguments:  fields.Arguments,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *ReturnNode) Location() *Location {
 	return node.Loc
 }
@@ -7014,8 +16125,8 @@ func NewSelfNode(loc *Location) *SelfNode {
 	}
 }
 
-func (node *SelfNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *SelfNode) Accept(visitor Visitor) {
+	visitor.VisitSelfNode(node)
 }
 
 func (node *SelfNode) Children() []Node {
@@ -7024,6 +16135,12 @@ func (node *SelfNode) Children() []Node {
 	return children
 }
 
+func (node *SelfNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *SelfNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "SelfNode",
@@ -7031,6 +16148,33 @@ func (node *SelfNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *SelfNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	return in.String()
+}
+
+func (node *SelfNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "SelfNode" {
+		return fmt.Errorf("expected a SelfNode, got %q", fields.NodeName)
+	}
+
+	*node = SelfNode{
+		Loc: fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *SelfNode) Location() *Location {
 	return node.Loc
 }
@@ -7061,8 +16205,8 @@ func NewSingletonClassNode(locals []string, classKeywordLoc *Location, operatorL
 	}
 }
 
-func (node *SingletonClassNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *SingletonClassNode) Accept(visitor Visitor) {
+	visitor.VisitSingletonClassNode(node)
 }
 
 func (node *SingletonClassNode) Children() []Node {
@@ -7077,6 +16221,18 @@ func (node *SingletonClassNode) Children() []Node {
 	return children
 }
 
+func (node *SingletonClassNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "expression", Index: -1, Node: node.Expression})
+
+	if node.Body != nil {
+		children = append(children, NamedChild{Name: "body", Index: -1, Node: node.Body})
+	}
+
+	return children
+}
+
 func (node *SingletonClassNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":        "SingletonClassNode",
@@ -7090,6 +16246,62 @@ func (node *SingletonClassNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *SingletonClassNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.constants("locals", false, node.Locals)
+	in.location("class_keyword_loc", false, node.Classkeywordloc)
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("expression", false, node.Expression)
+	in.node("body", false, node.Body)
+	in.location("end_keyword_loc", true, node.Endkeywordloc)
+
+	return in.String()
+}
+
+func (node *SingletonClassNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName        string          `json:"nodeName"`
+		Locals          []string        `json:"locals"`
+		Classkeywordloc *Location       `json:"classKeywordLoc"`
+		Operatorloc     *Location       `json:"operatorLoc"`
+		Expression      json.RawMessage `json:"expression"`
+		Body            json.RawMessage `json:"body"`
+		Endkeywordloc   *Location       `json:"endKeywordLoc"`
+		Loc             *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "SingletonClassNode" {
+		return fmt.Errorf("expected a SingletonClassNode, got %q", fields.NodeName)
+	}
+
+	expression, err := UnmarshalNode(fields.Expression)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal expression of SingletonClassNode: %w", err)
+	}
+
+	body, err := UnmarshalNode(fields.Body)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal body of SingletonClassNode: %w", err)
+	}
+
+	*node = SingletonClassNode{
+		Locals:          fields.Locals,
+		Classkeywordloc: fields.Classkeywordloc,
+		Operatorloc:     fields.Operatorloc,
+		Expression:      expression,
+		Body:            body,
+		Endkeywordloc:   fields.Endkeywordloc,
+		Loc:             fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *SingletonClassNode) Location() *Location {
 	return node.Loc
 }
@@ -7108,8 +16320,8 @@ func NewSourceEncodingNode(loc *Location) *SourceEncodingNode {
 	}
 }
 
-func (node *SourceEncodingNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *SourceEncodingNode) Accept(visitor Visitor) {
+	visitor.VisitSourceEncodingNode(node)
 }
 
 func (node *SourceEncodingNode) Children() []Node {
@@ -7118,6 +16330,12 @@ func (node *SourceEncodingNode) Children() []Node {
 	return children
 }
 
+func (node *SourceEncodingNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *SourceEncodingNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "SourceEncodingNode",
@@ -7125,6 +16343,33 @@ func (node *SourceEncodingNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *SourceEncodingNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	return in.String()
+}
+
+func (node *SourceEncodingNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "SourceEncodingNode" {
+		return fmt.Errorf("expected a SourceEncodingNode, got %q", fields.NodeName)
+	}
+
+	*node = SourceEncodingNode{
+		Loc: fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *SourceEncodingNode) Location() *Location {
 	return node.Loc
 }
@@ -7145,8 +16390,8 @@ func NewSourceFileNode(filepath string, loc *Location) *SourceFileNode {
 	}
 }
 
-func (node *SourceFileNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *SourceFileNode) Accept(visitor Visitor) {
+	visitor.VisitSourceFileNode(node)
 }
 
 func (node *SourceFileNode) Children() []Node {
@@ -7155,6 +16400,12 @@ func (node *SourceFileNode) Children() []Node {
 	return children
 }
 
+func (node *SourceFileNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *SourceFileNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "SourceFileNode",
@@ -7163,6 +16414,45 @@ func (node *SourceFileNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *SourceFileNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("filepath", true, inspectString([]byte(node.Filepath)))
+
+	return in.String()
+}
+
+func (node *SourceFileNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	fields = append(fields, scalarField{Name: "filepath", Value: inspectString([]byte(node.Filepath))})
+
+	return fields
+}
+
+func (node *SourceFileNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Filepath string    `json:"filepath"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "SourceFileNode" {
+		return fmt.Errorf("expected a SourceFileNode, got %q", fields.NodeName)
+	}
+
+	*node = SourceFileNode{
+		Filepath: fields.Filepath,
+		Loc:      fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *SourceFileNode) Location() *Location {
 	return node.Loc
 }
@@ -7181,8 +16471,8 @@ func NewSourceLineNode(loc *Location) *SourceLineNode {
 	}
 }
 
-func (node *SourceLineNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *SourceLineNode) Accept(visitor Visitor) {
+	visitor.VisitSourceLineNode(node)
 }
 
 func (node *SourceLineNode) Children() []Node {
@@ -7191,6 +16481,12 @@ func (node *SourceLineNode) Children() []Node {
 	return children
 }
 
+func (node *SourceLineNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *SourceLineNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "SourceLineNode",
@@ -7198,6 +16494,33 @@ func (node *SourceLineNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *SourceLineNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	return in.String()
+}
+
+func (node *SourceLineNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "SourceLineNode" {
+		return fmt.Errorf("expected a SourceLineNode, got %q", fields.NodeName)
+	}
+
+	*node = SourceLineNode{
+		Loc: fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *SourceLineNode) Location() *Location {
 	return node.Loc
 }
@@ -7220,8 +16543,8 @@ func NewSplatNode(operatorLoc *Location, expression Node, loc *Location) *SplatN
 	}
 }
 
-func (node *SplatNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *SplatNode) Accept(visitor Visitor) {
+	visitor.VisitSplatNode(node)
 }
 
 func (node *SplatNode) Children() []Node {
@@ -7234,6 +16557,16 @@ func (node *SplatNode) Children() []Node {
 	return children
 }
 
+func (node *SplatNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Expression != nil {
+		children = append(children, NamedChild{Name: "expression", Index: -1, Node: node.Expression})
+	}
+
+	return children
+}
+
 func (node *SplatNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":    "SplatNode",
@@ -7243,6 +16576,45 @@ func (node *SplatNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *SplatNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("operator_loc", false, node.Operatorloc)
+	in.node("expression", true, node.Expression)
+
+	return in.String()
+}
+
+func (node *SplatNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName    string          `json:"nodeName"`
+		Operatorloc *Location       `json:"operatorLoc"`
+		Expression  json.RawMessage `json:"expression"`
+		Loc         *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "SplatNode" {
+		return fmt.Errorf("expected a SplatNode, got %q", fields.NodeName)
+	}
+
+	expression, err := UnmarshalNode(fields.Expression)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal expression of SplatNode: %w", err)
+	}
+
+	*node = SplatNode{
+		Operatorloc: fields.Operatorloc,
+		Expression:  expression,
+		Loc:         fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *SplatNode) Location() *Location {
 	return node.Loc
 }
@@ -7263,8 +16635,8 @@ func NewStatementsNode(body []Node, loc *Location) *StatementsNode {
 	}
 }
 
-func (node *StatementsNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *StatementsNode) Accept(visitor Visitor) {
+	visitor.VisitStatementsNode(node)
 }
 
 func (node *StatementsNode) Children() []Node {
@@ -7275,6 +16647,16 @@ func (node *StatementsNode) Children() []Node {
 	return children
 }
 
+func (node *StatementsNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	for i, child := range node.Body {
+		children = append(children, NamedChild{Name: "body", Index: i, Node: child})
+	}
+
+	return children
+}
+
 func (node *StatementsNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "StatementsNode",
@@ -7283,6 +16665,42 @@ func (node *StatementsNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *StatementsNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.nodes("body", true, node.Body)
+
+	return in.String()
+}
+
+func (node *StatementsNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string          `json:"nodeName"`
+		Body     json.RawMessage `json:"body"`
+		Loc      *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "StatementsNode" {
+		return fmt.Errorf("expected a StatementsNode, got %q", fields.NodeName)
+	}
+
+	body, err := unmarshalNodes(fields.Body)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal body of StatementsNode: %w", err)
+	}
+
+	*node = StatementsNode{
+		Body: body,
+		Loc:  fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *StatementsNode) Location() *Location {
 	return node.Loc
 }
@@ -7317,8 +16735,8 @@ func NewStringNode(flags StringFlags, openingLoc *Location, contentLoc *Location
 	}
 }
 
-func (node *StringNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *StringNode) Accept(visitor Visitor) {
+	visitor.VisitStringNode(node)
 }
 
 func (node *StringNode) IsForcedUtf8Encoding() bool {
@@ -7339,6 +16757,12 @@ func (node *StringNode) Children() []Node {
 	return children
 }
 
+func (node *StringNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *StringNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "StringNode",
@@ -7351,6 +16775,60 @@ func (node *StringNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *StringNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.location("opening_loc", false, node.Openingloc)
+	in.location("content_loc", false, node.Contentloc)
+	in.location("closing_loc", false, node.Closingloc)
+	in.value("unescaped", true, inspectString([]byte(node.Unescaped)))
+
+	return in.String()
+}
+
+func (node *StringNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	fields = append(fields, scalarField{Name: "unescaped", Value: inspectString([]byte(node.Unescaped))})
+
+	return fields
+}
+
+func (node *StringNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string      `json:"nodeName"`
+		Flags      StringFlags `json:"flags"`
+		Openingloc *Location   `json:"openingLoc"`
+		Contentloc *Location   `json:"contentLoc"`
+		Closingloc *Location   `json:"closingLoc"`
+		Unescaped  string      `json:"unescaped"`
+		Loc        *Location   `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "StringNode" {
+		return fmt.Errorf("expected a StringNode, got %q", fields.NodeName)
+	}
+
+	*node = StringNode{
+		Flags:      fields.Flags,
+		Openingloc: fields.Openingloc,
+		Contentloc: fields.Contentloc,
+		Closingloc: fields.Closingloc,
+		Unescaped:  fields.Unescaped,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *StringNode) Location() *Location {
 	return node.Loc
 }
@@ -7382,8 +16860,8 @@ func NewSuperNode(keywordLoc *Location, lparenLoc *Location, arguments *Argument
 	}
 }
 
-func (node *SuperNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *SuperNode) Accept(visitor Visitor) {
+	visitor.VisitSuperNode(node)
 }
 
 func (node *SuperNode) Children() []Node {
@@ -7400,16 +16878,78 @@ func (node *SuperNode) Children() []Node {
 	return children
 }
 
-func (node *SuperNode) MarshalJSON() ([]byte, error) {
-	return json.Marshal(map[string]interface{}{
-		"nodeName":   "SuperNode",
-		"keywordLoc": node.Keywordloc,
-		"lparenLoc":  node.Lparenloc,
-		"arguments":  node.Arguments,
-		"rparenLoc":  node.Rparenloc,
-		"block":      node.Block,
-		"loc":        node.Loc,
-	})
+func (node *SuperNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Arguments != nil {
+		children = append(children, NamedChild{Name: "arguments", Index: -1, Node: node.Arguments})
+	}
+
+	if node.Block != nil {
+		children = append(children, NamedChild{Name: "block", Index: -1, Node: node.Block})
+	}
+
+	return children
+}
+
+func (node *SuperNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"nodeName":   "SuperNode",
+		"keywordLoc": node.Keywordloc,
+		"lparenLoc":  node.Lparenloc,
+		"arguments":  node.Arguments,
+		"rparenLoc":  node.Rparenloc,
+		"block":      node.Block,
+		"loc":        node.Loc,
+	})
+}
+
+func (node *SuperNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("keyword_loc", false, node.Keywordloc)
+	in.location("lparen_loc", false, node.Lparenloc)
+	in.node("arguments", false, node.Arguments)
+	in.location("rparen_loc", false, node.Rparenloc)
+	in.node("block", true, node.Block)
+
+	return in.String()
+}
+
+func (node *SuperNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Keywordloc *Location       `json:"keywordLoc"`
+		Lparenloc  *Location       `json:"lparenLoc"`
+		Arguments  *ArgumentsNode  `json:"arguments"`
+		Rparenloc  *Location       `json:"rparenLoc"`
+		Block      json.RawMessage `json:"block"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "SuperNode" {
+		return fmt.Errorf("expected a SuperNode, got %q", fields.NodeName)
+	}
+
+	block, err := UnmarshalNode(fields.Block)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal block of SuperNode: %w", err)
+	}
+
+	*node = SuperNode{
+		Keywordloc: fields.Keywordloc,
+		Lparenloc:  fields.Lparenloc,
+		Arguments:  fields.Arguments,
+		Rparenloc:  fields.Rparenloc,
+		Block:      block,
+		Loc:        fields.Loc,
+	}
+
+	return nil
 }
 
 func (node *SuperNode) Location() *Location {
@@ -7443,8 +16983,8 @@ func NewSymbolNode(flags SymbolFlags, openingLoc *Location, valueLoc *Location,
 	}
 }
 
-func (node *SymbolNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *SymbolNode) Accept(visitor Visitor) {
+	visitor.VisitSymbolNode(node)
 }
 
 func (node *SymbolNode) IsForcedUtf8Encoding() bool {
@@ -7465,6 +17005,12 @@ func (node *SymbolNode) Children() []Node {
 	return children
 }
 
+func (node *SymbolNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *SymbolNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "SymbolNode",
@@ -7477,6 +17023,60 @@ func (node *SymbolNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *SymbolNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.location("opening_loc", false, node.Openingloc)
+	in.location("value_loc", false, node.Valueloc)
+	in.location("closing_loc", false, node.Closingloc)
+	in.value("unescaped", true, inspectString([]byte(node.Unescaped)))
+
+	return in.String()
+}
+
+func (node *SymbolNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	fields = append(fields, scalarField{Name: "unescaped", Value: inspectString([]byte(node.Unescaped))})
+
+	return fields
+}
+
+func (node *SymbolNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string      `json:"nodeName"`
+		Flags      SymbolFlags `json:"flags"`
+		Openingloc *Location   `json:"openingLoc"`
+		Valueloc   *Location   `json:"valueLoc"`
+		Closingloc *Location   `json:"closingLoc"`
+		Unescaped  string      `json:"unescaped"`
+		Loc        *Location   `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "SymbolNode" {
+		return fmt.Errorf("expected a SymbolNode, got %q", fields.NodeName)
+	}
+
+	*node = SymbolNode{
+		Flags:      fields.Flags,
+		Openingloc: fields.Openingloc,
+		Valueloc:   fields.Valueloc,
+		Closingloc: fields.Closingloc,
+		Unescaped:  fields.Unescaped,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *SymbolNode) Location() *Location {
 	return node.Loc
 }
@@ -7495,8 +17095,8 @@ func NewTrueNode(loc *Location) *TrueNode {
 	}
 }
 
-func (node *TrueNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *TrueNode) Accept(visitor Visitor) {
+	visitor.VisitTrueNode(node)
 }
 
 func (node *TrueNode) Children() []Node {
@@ -7505,6 +17105,12 @@ func (node *TrueNode) Children() []Node {
 	return children
 }
 
+func (node *TrueNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *TrueNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName": "TrueNode",
@@ -7512,6 +17118,33 @@ func (node *TrueNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *TrueNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	return in.String()
+}
+
+func (node *TrueNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName string    `json:"nodeName"`
+		Loc      *Location `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "TrueNode" {
+		return fmt.Errorf("expected a TrueNode, got %q", fields.NodeName)
+	}
+
+	*node = TrueNode{
+		Loc: fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *TrueNode) Location() *Location {
 	return node.Loc
 }
@@ -7534,8 +17167,8 @@ func NewUndefNode(names []Node, keywordLoc *Location, loc *Location) *UndefNode
 	}
 }
 
-func (node *UndefNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *UndefNode) Accept(visitor Visitor) {
+	visitor.VisitUndefNode(node)
 }
 
 func (node *UndefNode) Children() []Node {
@@ -7546,6 +17179,16 @@ func (node *UndefNode) Children() []Node {
 	return children
 }
 
+func (node *UndefNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	for i, child := range node.Names {
+		children = append(children, NamedChild{Name: "names", Index: i, Node: child})
+	}
+
+	return children
+}
+
 func (node *UndefNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "UndefNode",
@@ -7555,6 +17198,45 @@ func (node *UndefNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *UndefNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.nodes("names", false, node.Names)
+	in.location("keyword_loc", true, node.Keywordloc)
+
+	return in.String()
+}
+
+func (node *UndefNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Names      json.RawMessage `json:"names"`
+		Keywordloc *Location       `json:"keywordLoc"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "UndefNode" {
+		return fmt.Errorf("expected a UndefNode, got %q", fields.NodeName)
+	}
+
+	names, err := unmarshalNodes(fields.Names)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal names of UndefNode: %w", err)
+	}
+
+	*node = UndefNode{
+		Names:      names,
+		Keywordloc: fields.Keywordloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *UndefNode) Location() *Location {
 	return node.Loc
 }
@@ -7588,8 +17270,8 @@ func NewUnlessNode(keywordLoc *Location, predicate Node, thenKeywordLoc *Locatio
 	}
 }
 
-func (node *UnlessNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *UnlessNode) Accept(visitor Visitor) {
+	visitor.VisitUnlessNode(node)
 }
 
 func (node *UnlessNode) Children() []Node {
@@ -7608,6 +17290,22 @@ func (node *UnlessNode) Children() []Node {
 	return children
 }
 
+func (node *UnlessNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "predicate", Index: -1, Node: node.Predicate})
+
+	if node.Statements != nil {
+		children = append(children, NamedChild{Name: "statements", Index: -1, Node: node.Statements})
+	}
+
+	if node.Consequent != nil {
+		children = append(children, NamedChild{Name: "consequent", Index: -1, Node: node.Consequent})
+	}
+
+	return children
+}
+
 func (node *UnlessNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":       "UnlessNode",
@@ -7621,6 +17319,57 @@ func (node *UnlessNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *UnlessNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("keyword_loc", false, node.Keywordloc)
+	in.node("predicate", false, node.Predicate)
+	in.location("then_keyword_loc", false, node.Thenkeywordloc)
+	in.node("statements", false, node.Statements)
+	in.node("consequent", false, node.Consequent)
+	in.location("end_keyword_loc", true, node.Endkeywordloc)
+
+	return in.String()
+}
+
+func (node *UnlessNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName       string          `json:"nodeName"`
+		Keywordloc     *Location       `json:"keywordLoc"`
+		Predicate      json.RawMessage `json:"predicate"`
+		Thenkeywordloc *Location       `json:"thenKeywordLoc"`
+		Statements     *StatementsNode `json:"statements"`
+		Consequent     *ElseNode       `json:"consequent"`
+		Endkeywordloc  *Location       `json:"endKeywordLoc"`
+		Loc            *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "UnlessNode" {
+		return fmt.Errorf("expected a UnlessNode, got %q", fields.NodeName)
+	}
+
+	predicate, err := UnmarshalNode(fields.Predicate)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal predicate of UnlessNode: %w", err)
+	}
+
+	*node = UnlessNode{
+		Keywordloc:     fields.Keywordloc,
+		Predicate:      predicate,
+		Thenkeywordloc: fields.Thenkeywordloc,
+		Statements:     fields.Statements,
+		Consequent:     fields.Consequent,
+		Endkeywordloc:  fields.Endkeywordloc,
+		Loc:            fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *UnlessNode) Location() *Location {
 	return node.Loc
 }
@@ -7652,8 +17401,8 @@ func NewUntilNode(flags LoopFlags, keywordLoc *Location, closingLoc *Location, p
 	}
 }
 
-func (node *UntilNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *UntilNode) Accept(visitor Visitor) {
+	visitor.VisitUntilNode(node)
 }
 
 func (node *UntilNode) IsBeginModifier() bool {
@@ -7672,6 +17421,18 @@ func (node *UntilNode) Children() []Node {
 	return children
 }
 
+func (node *UntilNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "predicate", Index: -1, Node: node.Predicate})
+
+	if node.Statements != nil {
+		children = append(children, NamedChild{Name: "statements", Index: -1, Node: node.Statements})
+	}
+
+	return children
+}
+
 func (node *UntilNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "UntilNode",
@@ -7684,6 +17445,64 @@ func (node *UntilNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *UntilNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.location("keyword_loc", false, node.Keywordloc)
+	in.location("closing_loc", false, node.Closingloc)
+	in.node("predicate", false, node.Predicate)
+	in.node("statements", true, node.Statements)
+
+	return in.String()
+}
+
+func (node *UntilNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+
+	return fields
+}
+
+func (node *UntilNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Flags      LoopFlags       `json:"flags"`
+		Keywordloc *Location       `json:"keywordLoc"`
+		Closingloc *Location       `json:"closingLoc"`
+		Predicate  json.RawMessage `json:"predicate"`
+		Statements *StatementsNode `json:"statements"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "UntilNode" {
+		return fmt.Errorf("expected a UntilNode, got %q", fields.NodeName)
+	}
+
+	predicate, err := UnmarshalNode(fields.Predicate)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal predicate of UntilNode: %w", err)
+	}
+
+	*node = UntilNode{
+		Flags:      fields.Flags,
+		Keywordloc: fields.Keywordloc,
+		Closingloc: fields.Closingloc,
+		Predicate:  predicate,
+		Statements: fields.Statements,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *UntilNode) Location() *Location {
 	return node.Loc
 }
@@ -7712,8 +17531,8 @@ func NewWhenNode(keywordLoc *Location, conditions []Node, thenKeywordLoc *Locati
 	}
 }
 
-func (node *WhenNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *WhenNode) Accept(visitor Visitor) {
+	visitor.VisitWhenNode(node)
 }
 
 func (node *WhenNode) Children() []Node {
@@ -7728,6 +17547,20 @@ func (node *WhenNode) Children() []Node {
 	return children
 }
 
+func (node *WhenNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	for i, child := range node.Conditions {
+		children = append(children, NamedChild{Name: "conditions", Index: i, Node: child})
+	}
+
+	if node.Statements != nil {
+		children = append(children, NamedChild{Name: "statements", Index: -1, Node: node.Statements})
+	}
+
+	return children
+}
+
 func (node *WhenNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":       "WhenNode",
@@ -7739,6 +17572,51 @@ func (node *WhenNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *WhenNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("keyword_loc", false, node.Keywordloc)
+	in.nodes("conditions", false, node.Conditions)
+	in.location("then_keyword_loc", false, node.Thenkeywordloc)
+	in.node("statements", true, node.Statements)
+
+	return in.String()
+}
+
+func (node *WhenNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName       string          `json:"nodeName"`
+		Keywordloc     *Location       `json:"keywordLoc"`
+		Conditions     json.RawMessage `json:"conditions"`
+		Thenkeywordloc *Location       `json:"thenKeywordLoc"`
+		Statements     *StatementsNode `json:"statements"`
+		Loc            *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "WhenNode" {
+		return fmt.Errorf("expected a WhenNode, got %q", fields.NodeName)
+	}
+
+	conditions, err := unmarshalNodes(fields.Conditions)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal conditions of WhenNode: %w", err)
+	}
+
+	*node = WhenNode{
+		Keywordloc:     fields.Keywordloc,
+		Conditions:     conditions,
+		Thenkeywordloc: fields.Thenkeywordloc,
+		Statements:     fields.Statements,
+		Loc:            fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *WhenNode) Location() *Location {
 	return node.Loc
 }
@@ -7770,8 +17648,8 @@ func NewWhileNode(flags LoopFlags, keywordLoc *Location, closingLoc *Location, p
 	}
 }
 
-func (node *WhileNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *WhileNode) Accept(visitor Visitor) {
+	visitor.VisitWhileNode(node)
 }
 
 func (node *WhileNode) IsBeginModifier() bool {
@@ -7790,6 +17668,18 @@ func (node *WhileNode) Children() []Node {
 	return children
 }
 
+func (node *WhileNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	children = append(children, NamedChild{Name: "predicate", Index: -1, Node: node.Predicate})
+
+	if node.Statements != nil {
+		children = append(children, NamedChild{Name: "statements", Index: -1, Node: node.Statements})
+	}
+
+	return children
+}
+
 func (node *WhileNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "WhileNode",
@@ -7802,6 +17692,64 @@ func (node *WhileNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *WhileNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.location("keyword_loc", false, node.Keywordloc)
+	in.location("closing_loc", false, node.Closingloc)
+	in.node("predicate", false, node.Predicate)
+	in.node("statements", true, node.Statements)
+
+	return in.String()
+}
+
+func (node *WhileNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+
+	return fields
+}
+
+func (node *WhileNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string          `json:"nodeName"`
+		Flags      LoopFlags       `json:"flags"`
+		Keywordloc *Location       `json:"keywordLoc"`
+		Closingloc *Location       `json:"closingLoc"`
+		Predicate  json.RawMessage `json:"predicate"`
+		Statements *StatementsNode `json:"statements"`
+		Loc        *Location       `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "WhileNode" {
+		return fmt.Errorf("expected a WhileNode, got %q", fields.NodeName)
+	}
+
+	predicate, err := UnmarshalNode(fields.Predicate)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal predicate of WhileNode: %w", err)
+	}
+
+	*node = WhileNode{
+		Flags:      fields.Flags,
+		Keywordloc: fields.Keywordloc,
+		Closingloc: fields.Closingloc,
+		Predicate:  predicate,
+		Statements: fields.Statements,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *WhileNode) Location() *Location {
 	return node.Loc
 }
@@ -7830,8 +17778,8 @@ func NewXStringNode(flags EncodingFlags, openingLoc *Location, contentLoc *Locat
 	}
 }
 
-func (node *XStringNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *XStringNode) Accept(visitor Visitor) {
+	visitor.VisitXStringNode(node)
 }
 
 func (node *XStringNode) IsForcedUtf8Encoding() bool {
@@ -7848,6 +17796,12 @@ func (node *XStringNode) Children() []Node {
 	return children
 }
 
+func (node *XStringNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	return children
+}
+
 func (node *XStringNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "XStringNode",
@@ -7860,6 +17814,60 @@ func (node *XStringNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *XStringNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.value("flags", false, inspectFlags(node.Flags.names()))
+	in.location("opening_loc", false, node.Openingloc)
+	in.location("content_loc", false, node.Contentloc)
+	in.location("closing_loc", false, node.Closingloc)
+	in.value("unescaped", true, inspectString([]byte(node.Unescaped)))
+
+	return in.String()
+}
+
+func (node *XStringNode) scalarFields() []scalarField {
+	fields := make([]scalarField, 0)
+
+	if node.Flags != 0 {
+		fields = append(fields, scalarField{Name: "flags", Value: inspectFlags(node.Flags.names())})
+	}
+	fields = append(fields, scalarField{Name: "unescaped", Value: inspectString([]byte(node.Unescaped))})
+
+	return fields
+}
+
+func (node *XStringNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string        `json:"nodeName"`
+		Flags      EncodingFlags `json:"flags"`
+		Openingloc *Location     `json:"openingLoc"`
+		Contentloc *Location     `json:"contentLoc"`
+		Closingloc *Location     `json:"closingLoc"`
+		Unescaped  string        `json:"unescaped"`
+		Loc        *Location     `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "XStringNode" {
+		return fmt.Errorf("expected a XStringNode, got %q", fields.NodeName)
+	}
+
+	*node = XStringNode{
+		Flags:      fields.Flags,
+		Openingloc: fields.Openingloc,
+		Contentloc: fields.Contentloc,
+		Closingloc: fields.Closingloc,
+		Unescaped:  fields.Unescaped,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *XStringNode) Location() *Location {
 	return node.Loc
 }
@@ -7886,8 +17894,8 @@ func NewYieldNode(keywordLoc *Location, lparenLoc *Location, arguments *Argument
 	}
 }
 
-func (node *YieldNode) Accept(visitor NodeVisitor) {
-	visitor.Visit(node)
+func (node *YieldNode) Accept(visitor Visitor) {
+	visitor.VisitYieldNode(node)
 }
 
 func (node *YieldNode) Children() []Node {
@@ -7900,6 +17908,16 @@ func (node *YieldNode) Children() []Node {
 	return children
 }
 
+func (node *YieldNode) NamedChildren() []NamedChild {
+	children := make([]NamedChild, 0)
+
+	if node.Arguments != nil {
+		children = append(children, NamedChild{Name: "arguments", Index: -1, Node: node.Arguments})
+	}
+
+	return children
+}
+
 func (node *YieldNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"nodeName":   "YieldNode",
@@ -7911,6 +17929,399 @@ func (node *YieldNode) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (node *YieldNode) Inspect(source *Source) string {
+	in := newNodeInspector(source, node)
+
+	in.location("keyword_loc", false, node.Keywordloc)
+	in.location("lparen_loc", false, node.Lparenloc)
+	in.node("arguments", false, node.Arguments)
+	in.location("rparen_loc", true, node.Rparenloc)
+
+	return in.String()
+}
+
+func (node *YieldNode) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		NodeName   string         `json:"nodeName"`
+		Keywordloc *Location      `json:"keywordLoc"`
+		Lparenloc  *Location      `json:"lparenLoc"`
+		Arguments  *ArgumentsNode `json:"arguments"`
+		Rparenloc  *Location      `json:"rparenLoc"`
+		Loc        *Location      `json:"loc"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if fields.NodeName != "YieldNode" {
+		return fmt.Errorf("expected a YieldNode, got %q", fields.NodeName)
+	}
+
+	*node = YieldNode{
+		Keywordloc: fields.Keywordloc,
+		Lparenloc:  fields.Lparenloc,
+		Arguments:  fields.Arguments,
+		Rparenloc:  fields.Rparenloc,
+		Loc:        fields.Loc,
+	}
+
+	return nil
+}
+
 func (node *YieldNode) Location() *Location {
 	return node.Loc
 }
+
+// UnmarshalNode decodes a node encoded by its MarshalJSON method into the type
+// named by its nodeName. JSON null decodes to a nil node.
+func UnmarshalNode(data []byte) (Node, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+
+	var header struct {
+		NodeName string `json:"nodeName"`
+	}
+
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+
+	var node Node
+	switch header.NodeName {
+	case "AliasGlobalVariableNode":
+		node = &AliasGlobalVariableNode{}
+	case "AliasMethodNode":
+		node = &AliasMethodNode{}
+	case "AlternationPatternNode":
+		node = &AlternationPatternNode{}
+	case "AndNode":
+		node = &AndNode{}
+	case "ArgumentsNode":
+		node = &ArgumentsNode{}
+	case "ArrayNode":
+		node = &ArrayNode{}
+	case "ArrayPatternNode":
+		node = &ArrayPatternNode{}
+	case "AssocNode":
+		node = &AssocNode{}
+	case "AssocSplatNode":
+		node = &AssocSplatNode{}
+	case "BackReferenceReadNode":
+		node = &BackReferenceReadNode{}
+	case "BeginNode":
+		node = &BeginNode{}
+	case "BlockArgumentNode":
+		node = &BlockArgumentNode{}
+	case "BlockLocalVariableNode":
+		node = &BlockLocalVariableNode{}
+	case "BlockNode":
+		node = &BlockNode{}
+	case "BlockParameterNode":
+		node = &BlockParameterNode{}
+	case "BlockParametersNode":
+		node = &BlockParametersNode{}
+	case "BreakNode":
+		node = &BreakNode{}
+	case "CallAndWriteNode":
+		node = &CallAndWriteNode{}
+	case "CallNode":
+		node = &CallNode{}
+	case "CallOperatorWriteNode":
+		node = &CallOperatorWriteNode{}
+	case "CallOrWriteNode":
+		node = &CallOrWriteNode{}
+	case "CallTargetNode":
+		node = &CallTargetNode{}
+	case "CapturePatternNode":
+		node = &CapturePatternNode{}
+	case "CaseMatchNode":
+		node = &CaseMatchNode{}
+	case "CaseNode":
+		node = &CaseNode{}
+	case "ClassNode":
+		node = &ClassNode{}
+	case "ClassVariableAndWriteNode":
+		node = &ClassVariableAndWriteNode{}
+	case "ClassVariableOperatorWriteNode":
+		node = &ClassVariableOperatorWriteNode{}
+	case "ClassVariableOrWriteNode":
+		node = &ClassVariableOrWriteNode{}
+	case "ClassVariableReadNode":
+		node = &ClassVariableReadNode{}
+	case "ClassVariableTargetNode":
+		node = &ClassVariableTargetNode{}
+	case "ClassVariableWriteNode":
+		node = &ClassVariableWriteNode{}
+	case "ConstantAndWriteNode":
+		node = &ConstantAndWriteNode{}
+	case "ConstantOperatorWriteNode":
+		node = &ConstantOperatorWriteNode{}
+	case "ConstantOrWriteNode":
+		node = &ConstantOrWriteNode{}
+	case "ConstantPathAndWriteNode":
+		node = &ConstantPathAndWriteNode{}
+	case "ConstantPathNode":
+		node = &ConstantPathNode{}
+	case "ConstantPathOperatorWriteNode":
+		node = &ConstantPathOperatorWriteNode{}
+	case "ConstantPathOrWriteNode":
+		node = &ConstantPathOrWriteNode{}
+	case "ConstantPathTargetNode":
+		node = &ConstantPathTargetNode{}
+	case "ConstantPathWriteNode":
+		node = &ConstantPathWriteNode{}
+	case "ConstantReadNode":
+		node = &ConstantReadNode{}
+	case "ConstantTargetNode":
+		node = &ConstantTargetNode{}
+	case "ConstantWriteNode":
+		node = &ConstantWriteNode{}
+	case "DefNode":
+		node = &DefNode{}
+	case "DefinedNode":
+		node = &DefinedNode{}
+	case "ElseNode":
+		node = &ElseNode{}
+	case "EmbeddedStatementsNode":
+		node = &EmbeddedStatementsNode{}
+	case "EmbeddedVariableNode":
+		node = &EmbeddedVariableNode{}
+	case "EnsureNode":
+		node = &EnsureNode{}
+	case "FalseNode":
+		node = &FalseNode{}
+	case "FindPatternNode":
+		node = &FindPatternNode{}
+	case "FlipFlopNode":
+		node = &FlipFlopNode{}
+	case "FloatNode":
+		node = &FloatNode{}
+	case "ForNode":
+		node = &ForNode{}
+	case "ForwardingArgumentsNode":
+		node = &ForwardingArgumentsNode{}
+	case "ForwardingParameterNode":
+		node = &ForwardingParameterNode{}
+	case "ForwardingSuperNode":
+		node = &ForwardingSuperNode{}
+	case "GlobalVariableAndWriteNode":
+		node = &GlobalVariableAndWriteNode{}
+	case "GlobalVariableOperatorWriteNode":
+		node = &GlobalVariableOperatorWriteNode{}
+	case "GlobalVariableOrWriteNode":
+		node = &GlobalVariableOrWriteNode{}
+	case "GlobalVariableReadNode":
+		node = &GlobalVariableReadNode{}
+	case "GlobalVariableTargetNode":
+		node = &GlobalVariableTargetNode{}
+	case "GlobalVariableWriteNode":
+		node = &GlobalVariableWriteNode{}
+	case "HashNode":
+		node = &HashNode{}
+	case "HashPatternNode":
+		node = &HashPatternNode{}
+	case "IfNode":
+		node = &IfNode{}
+	case "ImaginaryNode":
+		node = &ImaginaryNode{}
+	case "ImplicitNode":
+		node = &ImplicitNode{}
+	case "ImplicitRestNode":
+		node = &ImplicitRestNode{}
+	case "InNode":
+		node = &InNode{}
+	case "IndexAndWriteNode":
+		node = &IndexAndWriteNode{}
+	case "IndexOperatorWriteNode":
+		node = &IndexOperatorWriteNode{}
+	case "IndexOrWriteNode":
+		node = &IndexOrWriteNode{}
+	case "IndexTargetNode":
+		node = &IndexTargetNode{}
+	case "InstanceVariableAndWriteNode":
+		node = &InstanceVariableAndWriteNode{}
+	case "InstanceVariableOperatorWriteNode":
+		node = &InstanceVariableOperatorWriteNode{}
+	case "InstanceVariableOrWriteNode":
+		node = &InstanceVariableOrWriteNode{}
+	case "InstanceVariableReadNode":
+		node = &InstanceVariableReadNode{}
+	case "InstanceVariableTargetNode":
+		node = &InstanceVariableTargetNode{}
+	case "InstanceVariableWriteNode":
+		node = &InstanceVariableWriteNode{}
+	case "IntegerNode":
+		node = &IntegerNode{}
+	case "InterpolatedMatchLastLineNode":
+		node = &InterpolatedMatchLastLineNode{}
+	case "InterpolatedRegularExpressionNode":
+		node = &InterpolatedRegularExpressionNode{}
+	case "InterpolatedStringNode":
+		node = &InterpolatedStringNode{}
+	case "InterpolatedSymbolNode":
+		node = &InterpolatedSymbolNode{}
+	case "InterpolatedXStringNode":
+		node = &InterpolatedXStringNode{}
+	case "ItParametersNode":
+		node = &ItParametersNode{}
+	case "KeywordHashNode":
+		node = &KeywordHashNode{}
+	case "KeywordRestParameterNode":
+		node = &KeywordRestParameterNode{}
+	case "LambdaNode":
+		node = &LambdaNode{}
+	case "LocalVariableAndWriteNode":
+		node = &LocalVariableAndWriteNode{}
+	case "LocalVariableOperatorWriteNode":
+		node = &LocalVariableOperatorWriteNode{}
+	case "LocalVariableOrWriteNode":
+		node = &LocalVariableOrWriteNode{}
+	case "LocalVariableReadNode":
+		node = &LocalVariableReadNode{}
+	case "LocalVariableTargetNode":
+		node = &LocalVariableTargetNode{}
+	case "LocalVariableWriteNode":
+		node = &LocalVariableWriteNode{}
+	case "MatchLastLineNode":
+		node = &MatchLastLineNode{}
+	case "MatchPredicateNode":
+		node = &MatchPredicateNode{}
+	case "MatchRequiredNode":
+		node = &MatchRequiredNode{}
+	case "MatchWriteNode":
+		node = &MatchWriteNode{}
+	case "MissingNode":
+		node = &MissingNode{}
+	case "ModuleNode":
+		node = &ModuleNode{}
+	case "MultiTargetNode":
+		node = &MultiTargetNode{}
+	case "MultiWriteNode":
+		node = &MultiWriteNode{}
+	case "NextNode":
+		node = &NextNode{}
+	case "NilNode":
+		node = &NilNode{}
+	case "NoKeywordsParameterNode":
+		node = &NoKeywordsParameterNode{}
+	case "NumberedParametersNode":
+		node = &NumberedParametersNode{}
+	case "NumberedReferenceReadNode":
+		node = &NumberedReferenceReadNode{}
+	case "OptionalKeywordParameterNode":
+		node = &OptionalKeywordParameterNode{}
+	case "OptionalParameterNode":
+		node = &OptionalParameterNode{}
+	case "OrNode":
+		node = &OrNode{}
+	case "ParametersNode":
+		node = &ParametersNode{}
+	case "ParenthesesNode":
+		node = &ParenthesesNode{}
+	case "PinnedExpressionNode":
+		node = &PinnedExpressionNode{}
+	case "PinnedVariableNode":
+		node = &PinnedVariableNode{}
+	case "PostExecutionNode":
+		node = &PostExecutionNode{}
+	case "PreExecutionNode":
+		node = &PreExecutionNode{}
+	case "ProgramNode":
+		node = &ProgramNode{}
+	case "RangeNode":
+		node = &RangeNode{}
+	case "RationalNode":
+		node = &RationalNode{}
+	case "RedoNode":
+		node = &RedoNode{}
+	case "RegularExpressionNode":
+		node = &RegularExpressionNode{}
+	case "RequiredKeywordParameterNode":
+		node = &RequiredKeywordParameterNode{}
+	case "RequiredParameterNode":
+		node = &RequiredParameterNode{}
+	case "RescueModifierNode":
+		node = &RescueModifierNode{}
+	case "RescueNode":
+		node = &RescueNode{}
+	case "RestParameterNode":
+		node = &RestParameterNode{}
+	case "RetryNode":
+		node = &RetryNode{}
+	case "ReturnNode":
+		node = &ReturnNode{}
+	case "SelfNode":
+		node = &SelfNode{}
+	case "SingletonClassNode":
+		node = &SingletonClassNode{}
+	case "SourceEncodingNode":
+		node = &SourceEncodingNode{}
+	case "SourceFileNode":
+		node = &SourceFileNode{}
+	case "SourceLineNode":
+		node = &SourceLineNode{}
+	case "SplatNode":
+		node = &SplatNode{}
+	case "StatementsNode":
+		node = &StatementsNode{}
+	case "StringNode":
+		node = &StringNode{}
+	case "SuperNode":
+		node = &SuperNode{}
+	case "SymbolNode":
+		node = &SymbolNode{}
+	case "TrueNode":
+		node = &TrueNode{}
+	case "UndefNode":
+		node = &UndefNode{}
+	case "UnlessNode":
+		node = &UnlessNode{}
+	case "UntilNode":
+		node = &UntilNode{}
+	case "WhenNode":
+		node = &WhenNode{}
+	case "WhileNode":
+		node = &WhileNode{}
+	case "XStringNode":
+		node = &XStringNode{}
+	case "YieldNode":
+		node = &YieldNode{}
+	default:
+		return nil, fmt.Errorf("unknown node %q", header.NodeName)
+	}
+
+	if err := json.Unmarshal(data, node); err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+func unmarshalNodes(data []byte) ([]Node, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var elements []json.RawMessage
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return nil, err
+	}
+
+	if elements == nil {
+		return nil, nil
+	}
+
+	nodes := make([]Node, 0, len(elements))
+	for _, element := range elements {
+		node, err := UnmarshalNode(element)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	return nodes, nil
+}
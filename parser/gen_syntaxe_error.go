@@ -2,6 +2,8 @@
 
 package parser
 
+import "fmt"
+
 type SyntaxErrorLevel int
 type SyntaxErrorType int
 
@@ -479,6 +481,334 @@ var SyntaxErrorTypes = []SyntaxErrorType{
 	XSTRING_TERM,
 }
 
+var syntaxErrorLevelNames = map[SyntaxErrorLevel]string{
+	SyntaxErrorFatal:    "fatal",
+	SyntaxErrorArgument: "argument",
+}
+
+// syntaxErrorTypeNames holds the names prism's config gives its diagnostic
+// ids. The config has no descriptions, the message prism reports along with a
+// error describes it, see SyntaxError.Message.
+var syntaxErrorTypeNames = map[SyntaxErrorType]string{
+	ALIAS_ARGUMENT:                     "ALIAS_ARGUMENT",
+	AMPAMPEQ_MULTI_ASSIGN:              "AMPAMPEQ_MULTI_ASSIGN",
+	ARGUMENT_AFTER_BLOCK:               "ARGUMENT_AFTER_BLOCK",
+	ARGUMENT_AFTER_FORWARDING_ELLIPSES: "ARGUMENT_AFTER_FORWARDING_ELLIPSES",
+	ARGUMENT_BARE_HASH:                 "ARGUMENT_BARE_HASH",
+	ARGUMENT_BLOCK_FORWARDING:          "ARGUMENT_BLOCK_FORWARDING",
+	ARGUMENT_BLOCK_MULTI:               "ARGUMENT_BLOCK_MULTI",
+	ARGUMENT_FORMAL_CLASS:              "ARGUMENT_FORMAL_CLASS",
+	ARGUMENT_FORMAL_CONSTANT:           "ARGUMENT_FORMAL_CONSTANT",
+	ARGUMENT_FORMAL_GLOBAL:             "ARGUMENT_FORMAL_GLOBAL",
+	ARGUMENT_FORMAL_IVAR:               "ARGUMENT_FORMAL_IVAR",
+	ARGUMENT_FORWARDING_UNBOUND:        "ARGUMENT_FORWARDING_UNBOUND",
+	ARGUMENT_IN:                        "ARGUMENT_IN",
+	ARGUMENT_NO_FORWARDING_AMP:         "ARGUMENT_NO_FORWARDING_AMP",
+	ARGUMENT_NO_FORWARDING_ELLIPSES:    "ARGUMENT_NO_FORWARDING_ELLIPSES",
+	ARGUMENT_NO_FORWARDING_STAR:        "ARGUMENT_NO_FORWARDING_STAR",
+	ARGUMENT_SPLAT_AFTER_ASSOC_SPLAT:   "ARGUMENT_SPLAT_AFTER_ASSOC_SPLAT",
+	ARGUMENT_SPLAT_AFTER_SPLAT:         "ARGUMENT_SPLAT_AFTER_SPLAT",
+	ARGUMENT_TERM_PAREN:                "ARGUMENT_TERM_PAREN",
+	ARGUMENT_UNEXPECTED_BLOCK:          "ARGUMENT_UNEXPECTED_BLOCK",
+	ARRAY_ELEMENT:                      "ARRAY_ELEMENT",
+	ARRAY_EXPRESSION:                   "ARRAY_EXPRESSION",
+	ARRAY_EXPRESSION_AFTER_STAR:        "ARRAY_EXPRESSION_AFTER_STAR",
+	ARRAY_SEPARATOR:                    "ARRAY_SEPARATOR",
+	ARRAY_TERM:                         "ARRAY_TERM",
+	BEGIN_LONELY_ELSE:                  "BEGIN_LONELY_ELSE",
+	BEGIN_TERM:                         "BEGIN_TERM",
+	BEGIN_UPCASE_BRACE:                 "BEGIN_UPCASE_BRACE",
+	BEGIN_UPCASE_TERM:                  "BEGIN_UPCASE_TERM",
+	BEGIN_UPCASE_TOPLEVEL:              "BEGIN_UPCASE_TOPLEVEL",
+	BLOCK_PARAM_LOCAL_VARIABLE:         "BLOCK_PARAM_LOCAL_VARIABLE",
+	BLOCK_PARAM_PIPE_TERM:              "BLOCK_PARAM_PIPE_TERM",
+	BLOCK_TERM_BRACE:                   "BLOCK_TERM_BRACE",
+	BLOCK_TERM_END:                     "BLOCK_TERM_END",
+	CANNOT_PARSE_EXPRESSION:            "CANNOT_PARSE_EXPRESSION",
+	CANNOT_PARSE_STRING_PART:           "CANNOT_PARSE_STRING_PART",
+	CASE_EXPRESSION_AFTER_CASE:         "CASE_EXPRESSION_AFTER_CASE",
+	CASE_EXPRESSION_AFTER_WHEN:         "CASE_EXPRESSION_AFTER_WHEN",
+	CASE_MATCH_MISSING_PREDICATE:       "CASE_MATCH_MISSING_PREDICATE",
+	CASE_MISSING_CONDITIONS:            "CASE_MISSING_CONDITIONS",
+	CASE_TERM:                          "CASE_TERM",
+	CLASS_IN_METHOD:                    "CLASS_IN_METHOD",
+	CLASS_NAME:                         "CLASS_NAME",
+	CLASS_SUPERCLASS:                   "CLASS_SUPERCLASS",
+	CLASS_TERM:                         "CLASS_TERM",
+	CLASS_UNEXPECTED_END:               "CLASS_UNEXPECTED_END",
+	CONDITIONAL_ELSIF_PREDICATE:        "CONDITIONAL_ELSIF_PREDICATE",
+	CONDITIONAL_IF_PREDICATE:           "CONDITIONAL_IF_PREDICATE",
+	CONDITIONAL_PREDICATE_TERM:         "CONDITIONAL_PREDICATE_TERM",
+	CONDITIONAL_TERM:                   "CONDITIONAL_TERM",
+	CONDITIONAL_TERM_ELSE:              "CONDITIONAL_TERM_ELSE",
+	CONDITIONAL_UNLESS_PREDICATE:       "CONDITIONAL_UNLESS_PREDICATE",
+	CONDITIONAL_UNTIL_PREDICATE:        "CONDITIONAL_UNTIL_PREDICATE",
+	CONDITIONAL_WHILE_PREDICATE:        "CONDITIONAL_WHILE_PREDICATE",
+	CONSTANT_PATH_COLON_COLON_CONSTANT: "CONSTANT_PATH_COLON_COLON_CONSTANT",
+	DEF_ENDLESS:                        "DEF_ENDLESS",
+	DEF_ENDLESS_SETTER:                 "DEF_ENDLESS_SETTER",
+	DEF_NAME:                           "DEF_NAME",
+	DEF_NAME_AFTER_RECEIVER:            "DEF_NAME_AFTER_RECEIVER",
+	DEF_PARAMS_TERM:                    "DEF_PARAMS_TERM",
+	DEF_PARAMS_TERM_PAREN:              "DEF_PARAMS_TERM_PAREN",
+	DEF_RECEIVER:                       "DEF_RECEIVER",
+	DEF_RECEIVER_TERM:                  "DEF_RECEIVER_TERM",
+	DEF_TERM:                           "DEF_TERM",
+	DEFINED_EXPRESSION:                 "DEFINED_EXPRESSION",
+	EMBDOC_TERM:                        "EMBDOC_TERM",
+	EMBEXPR_END:                        "EMBEXPR_END",
+	EMBVAR_INVALID:                     "EMBVAR_INVALID",
+	END_UPCASE_BRACE:                   "END_UPCASE_BRACE",
+	END_UPCASE_TERM:                    "END_UPCASE_TERM",
+	ESCAPE_INVALID_CONTROL:             "ESCAPE_INVALID_CONTROL",
+	ESCAPE_INVALID_CONTROL_REPEAT:      "ESCAPE_INVALID_CONTROL_REPEAT",
+	ESCAPE_INVALID_HEXADECIMAL:         "ESCAPE_INVALID_HEXADECIMAL",
+	ESCAPE_INVALID_META:                "ESCAPE_INVALID_META",
+	ESCAPE_INVALID_META_REPEAT:         "ESCAPE_INVALID_META_REPEAT",
+	ESCAPE_INVALID_UNICODE:             "ESCAPE_INVALID_UNICODE",
+	ESCAPE_INVALID_UNICODE_CM_FLAGS:    "ESCAPE_INVALID_UNICODE_CM_FLAGS",
+	ESCAPE_INVALID_UNICODE_LITERAL:     "ESCAPE_INVALID_UNICODE_LITERAL",
+	ESCAPE_INVALID_UNICODE_LONG:        "ESCAPE_INVALID_UNICODE_LONG",
+	ESCAPE_INVALID_UNICODE_TERM:        "ESCAPE_INVALID_UNICODE_TERM",
+	EXPECT_ARGUMENT:                    "EXPECT_ARGUMENT",
+	EXPECT_EOL_AFTER_STATEMENT:         "EXPECT_EOL_AFTER_STATEMENT",
+	EXPECT_EXPRESSION_AFTER_AMPAMPEQ:   "EXPECT_EXPRESSION_AFTER_AMPAMPEQ",
+	EXPECT_EXPRESSION_AFTER_COMMA:      "EXPECT_EXPRESSION_AFTER_COMMA",
+	EXPECT_EXPRESSION_AFTER_EQUAL:      "EXPECT_EXPRESSION_AFTER_EQUAL",
+	EXPECT_EXPRESSION_AFTER_LESS_LESS:  "EXPECT_EXPRESSION_AFTER_LESS_LESS",
+	EXPECT_EXPRESSION_AFTER_LPAREN:     "EXPECT_EXPRESSION_AFTER_LPAREN",
+	EXPECT_EXPRESSION_AFTER_OPERATOR:   "EXPECT_EXPRESSION_AFTER_OPERATOR",
+	EXPECT_EXPRESSION_AFTER_PIPEPIPEEQ: "EXPECT_EXPRESSION_AFTER_PIPEPIPEEQ",
+	EXPECT_EXPRESSION_AFTER_QUESTION:   "EXPECT_EXPRESSION_AFTER_QUESTION",
+	EXPECT_EXPRESSION_AFTER_SPLAT:      "EXPECT_EXPRESSION_AFTER_SPLAT",
+	EXPECT_EXPRESSION_AFTER_SPLAT_HASH: "EXPECT_EXPRESSION_AFTER_SPLAT_HASH",
+	EXPECT_EXPRESSION_AFTER_STAR:       "EXPECT_EXPRESSION_AFTER_STAR",
+	EXPECT_IDENT_REQ_PARAMETER:         "EXPECT_IDENT_REQ_PARAMETER",
+	EXPECT_LPAREN_REQ_PARAMETER:        "EXPECT_LPAREN_REQ_PARAMETER",
+	EXPECT_RBRACKET:                    "EXPECT_RBRACKET",
+	EXPECT_RPAREN:                      "EXPECT_RPAREN",
+	EXPECT_RPAREN_AFTER_MULTI:          "EXPECT_RPAREN_AFTER_MULTI",
+	EXPECT_RPAREN_REQ_PARAMETER:        "EXPECT_RPAREN_REQ_PARAMETER",
+	EXPECT_STRING_CONTENT:              "EXPECT_STRING_CONTENT",
+	EXPECT_WHEN_DELIMITER:              "EXPECT_WHEN_DELIMITER",
+	EXPRESSION_BARE_HASH:               "EXPRESSION_BARE_HASH",
+	FLOAT_PARSE:                        "FLOAT_PARSE",
+	FOR_COLLECTION:                     "FOR_COLLECTION",
+	FOR_IN:                             "FOR_IN",
+	FOR_INDEX:                          "FOR_INDEX",
+	FOR_TERM:                           "FOR_TERM",
+	HASH_EXPRESSION_AFTER_LABEL:        "HASH_EXPRESSION_AFTER_LABEL",
+	HASH_KEY:                           "HASH_KEY",
+	HASH_ROCKET:                        "HASH_ROCKET",
+	HASH_TERM:                          "HASH_TERM",
+	HASH_VALUE:                         "HASH_VALUE",
+	HEREDOC_TERM:                       "HEREDOC_TERM",
+	INCOMPLETE_QUESTION_MARK:           "INCOMPLETE_QUESTION_MARK",
+	INCOMPLETE_VARIABLE_CLASS:          "INCOMPLETE_VARIABLE_CLASS",
+	INCOMPLETE_VARIABLE_CLASS_3_3_0:    "INCOMPLETE_VARIABLE_CLASS_3_3_0",
+	INCOMPLETE_VARIABLE_INSTANCE:       "INCOMPLETE_VARIABLE_INSTANCE",
+	INCOMPLETE_VARIABLE_INSTANCE_3_3_0: "INCOMPLETE_VARIABLE_INSTANCE_3_3_0",
+	INVALID_CHARACTER:                  "INVALID_CHARACTER",
+	INVALID_ENCODING_MAGIC_COMMENT:     "INVALID_ENCODING_MAGIC_COMMENT",
+	INVALID_FLOAT_EXPONENT:             "INVALID_FLOAT_EXPONENT",
+	INVALID_MULTIBYTE_CHAR:             "INVALID_MULTIBYTE_CHAR",
+	INVALID_MULTIBYTE_CHARACTER:        "INVALID_MULTIBYTE_CHARACTER",
+	INVALID_MULTIBYTE_ESCAPE:           "INVALID_MULTIBYTE_ESCAPE",
+	INVALID_NUMBER_BINARY:              "INVALID_NUMBER_BINARY",
+	INVALID_NUMBER_DECIMAL:             "INVALID_NUMBER_DECIMAL",
+	INVALID_NUMBER_HEXADECIMAL:         "INVALID_NUMBER_HEXADECIMAL",
+	INVALID_NUMBER_OCTAL:               "INVALID_NUMBER_OCTAL",
+	INVALID_NUMBER_UNDERSCORE:          "INVALID_NUMBER_UNDERSCORE",
+	INVALID_PERCENT:                    "INVALID_PERCENT",
+	INVALID_PRINTABLE_CHARACTER:        "INVALID_PRINTABLE_CHARACTER",
+	INVALID_VARIABLE_GLOBAL:            "INVALID_VARIABLE_GLOBAL",
+	INVALID_VARIABLE_GLOBAL_3_3_0:      "INVALID_VARIABLE_GLOBAL_3_3_0",
+	IT_NOT_ALLOWED_NUMBERED:            "IT_NOT_ALLOWED_NUMBERED",
+	IT_NOT_ALLOWED_ORDINARY:            "IT_NOT_ALLOWED_ORDINARY",
+	LAMBDA_OPEN:                        "LAMBDA_OPEN",
+	LAMBDA_TERM_BRACE:                  "LAMBDA_TERM_BRACE",
+	LAMBDA_TERM_END:                    "LAMBDA_TERM_END",
+	LIST_I_LOWER_ELEMENT:               "LIST_I_LOWER_ELEMENT",
+	LIST_I_LOWER_TERM:                  "LIST_I_LOWER_TERM",
+	LIST_I_UPPER_ELEMENT:               "LIST_I_UPPER_ELEMENT",
+	LIST_I_UPPER_TERM:                  "LIST_I_UPPER_TERM",
+	LIST_W_LOWER_ELEMENT:               "LIST_W_LOWER_ELEMENT",
+	LIST_W_LOWER_TERM:                  "LIST_W_LOWER_TERM",
+	LIST_W_UPPER_ELEMENT:               "LIST_W_UPPER_ELEMENT",
+	LIST_W_UPPER_TERM:                  "LIST_W_UPPER_TERM",
+	MALLOC_FAILED:                      "MALLOC_FAILED",
+	MIXED_ENCODING:                     "MIXED_ENCODING",
+	MODULE_IN_METHOD:                   "MODULE_IN_METHOD",
+	MODULE_NAME:                        "MODULE_NAME",
+	MODULE_TERM:                        "MODULE_TERM",
+	MULTI_ASSIGN_MULTI_SPLATS:          "MULTI_ASSIGN_MULTI_SPLATS",
+	MULTI_ASSIGN_UNEXPECTED_REST:       "MULTI_ASSIGN_UNEXPECTED_REST",
+	NO_LOCAL_VARIABLE:                  "NO_LOCAL_VARIABLE",
+	NOT_EXPRESSION:                     "NOT_EXPRESSION",
+	NUMBER_LITERAL_UNDERSCORE:          "NUMBER_LITERAL_UNDERSCORE",
+	NUMBERED_PARAMETER_IT:              "NUMBERED_PARAMETER_IT",
+	NUMBERED_PARAMETER_ORDINARY:        "NUMBERED_PARAMETER_ORDINARY",
+	NUMBERED_PARAMETER_OUTER_SCOPE:     "NUMBERED_PARAMETER_OUTER_SCOPE",
+	OPERATOR_MULTI_ASSIGN:              "OPERATOR_MULTI_ASSIGN",
+	OPERATOR_WRITE_ARGUMENTS:           "OPERATOR_WRITE_ARGUMENTS",
+	OPERATOR_WRITE_BLOCK:               "OPERATOR_WRITE_BLOCK",
+	PARAMETER_ASSOC_SPLAT_MULTI:        "PARAMETER_ASSOC_SPLAT_MULTI",
+	PARAMETER_BLOCK_MULTI:              "PARAMETER_BLOCK_MULTI",
+	PARAMETER_CIRCULAR:                 "PARAMETER_CIRCULAR",
+	PARAMETER_METHOD_NAME:              "PARAMETER_METHOD_NAME",
+	PARAMETER_NAME_REPEAT:              "PARAMETER_NAME_REPEAT",
+	PARAMETER_NO_DEFAULT:               "PARAMETER_NO_DEFAULT",
+	PARAMETER_NO_DEFAULT_KW:            "PARAMETER_NO_DEFAULT_KW",
+	PARAMETER_NUMBERED_RESERVED:        "PARAMETER_NUMBERED_RESERVED",
+	PARAMETER_ORDER:                    "PARAMETER_ORDER",
+	PARAMETER_SPLAT_MULTI:              "PARAMETER_SPLAT_MULTI",
+	PARAMETER_STAR:                     "PARAMETER_STAR",
+	PARAMETER_UNEXPECTED_FWD:           "PARAMETER_UNEXPECTED_FWD",
+	PARAMETER_WILD_LOOSE_COMMA:         "PARAMETER_WILD_LOOSE_COMMA",
+	PATTERN_EXPRESSION_AFTER_BRACKET:   "PATTERN_EXPRESSION_AFTER_BRACKET",
+	PATTERN_EXPRESSION_AFTER_COMMA:     "PATTERN_EXPRESSION_AFTER_COMMA",
+	PATTERN_EXPRESSION_AFTER_HROCKET:   "PATTERN_EXPRESSION_AFTER_HROCKET",
+	PATTERN_EXPRESSION_AFTER_IN:        "PATTERN_EXPRESSION_AFTER_IN",
+	PATTERN_EXPRESSION_AFTER_KEY:       "PATTERN_EXPRESSION_AFTER_KEY",
+	PATTERN_EXPRESSION_AFTER_PAREN:     "PATTERN_EXPRESSION_AFTER_PAREN",
+	PATTERN_EXPRESSION_AFTER_PIN:       "PATTERN_EXPRESSION_AFTER_PIN",
+	PATTERN_EXPRESSION_AFTER_PIPE:      "PATTERN_EXPRESSION_AFTER_PIPE",
+	PATTERN_EXPRESSION_AFTER_RANGE:     "PATTERN_EXPRESSION_AFTER_RANGE",
+	PATTERN_EXPRESSION_AFTER_REST:      "PATTERN_EXPRESSION_AFTER_REST",
+	PATTERN_HASH_KEY:                   "PATTERN_HASH_KEY",
+	PATTERN_HASH_KEY_LABEL:             "PATTERN_HASH_KEY_LABEL",
+	PATTERN_IDENT_AFTER_HROCKET:        "PATTERN_IDENT_AFTER_HROCKET",
+	PATTERN_LABEL_AFTER_COMMA:          "PATTERN_LABEL_AFTER_COMMA",
+	PATTERN_REST:                       "PATTERN_REST",
+	PATTERN_TERM_BRACE:                 "PATTERN_TERM_BRACE",
+	PATTERN_TERM_BRACKET:               "PATTERN_TERM_BRACKET",
+	PATTERN_TERM_PAREN:                 "PATTERN_TERM_PAREN",
+	PIPEPIPEEQ_MULTI_ASSIGN:            "PIPEPIPEEQ_MULTI_ASSIGN",
+	REGEXP_ENCODING_OPTION_MISMATCH:    "REGEXP_ENCODING_OPTION_MISMATCH",
+	REGEXP_INCOMPAT_CHAR_ENCODING:      "REGEXP_INCOMPAT_CHAR_ENCODING",
+	REGEXP_INVALID_UNICODE_RANGE:       "REGEXP_INVALID_UNICODE_RANGE",
+	REGEXP_NON_ESCAPED_MBC:             "REGEXP_NON_ESCAPED_MBC",
+	REGEXP_TERM:                        "REGEXP_TERM",
+	REGEXP_UTF8_CHAR_NON_UTF8_REGEXP:   "REGEXP_UTF8_CHAR_NON_UTF8_REGEXP",
+	RESCUE_EXPRESSION:                  "RESCUE_EXPRESSION",
+	RESCUE_MODIFIER_VALUE:              "RESCUE_MODIFIER_VALUE",
+	RESCUE_TERM:                        "RESCUE_TERM",
+	RESCUE_VARIABLE:                    "RESCUE_VARIABLE",
+	RETURN_INVALID:                     "RETURN_INVALID",
+	SINGLETON_FOR_LITERALS:             "SINGLETON_FOR_LITERALS",
+	STATEMENT_ALIAS:                    "STATEMENT_ALIAS",
+	STATEMENT_POSTEXE_END:              "STATEMENT_POSTEXE_END",
+	STATEMENT_PREEXE_BEGIN:             "STATEMENT_PREEXE_BEGIN",
+	STATEMENT_UNDEF:                    "STATEMENT_UNDEF",
+	STRING_CONCATENATION:               "STRING_CONCATENATION",
+	STRING_INTERPOLATED_TERM:           "STRING_INTERPOLATED_TERM",
+	STRING_LITERAL_EOF:                 "STRING_LITERAL_EOF",
+	STRING_LITERAL_TERM:                "STRING_LITERAL_TERM",
+	SYMBOL_INVALID:                     "SYMBOL_INVALID",
+	SYMBOL_TERM_DYNAMIC:                "SYMBOL_TERM_DYNAMIC",
+	SYMBOL_TERM_INTERPOLATED:           "SYMBOL_TERM_INTERPOLATED",
+	TERNARY_COLON:                      "TERNARY_COLON",
+	TERNARY_EXPRESSION_FALSE:           "TERNARY_EXPRESSION_FALSE",
+	TERNARY_EXPRESSION_TRUE:            "TERNARY_EXPRESSION_TRUE",
+	UNARY_RECEIVER:                     "UNARY_RECEIVER",
+	UNDEF_ARGUMENT:                     "UNDEF_ARGUMENT",
+	UNEXPECTED_TOKEN_CLOSE_CONTEXT:     "UNEXPECTED_TOKEN_CLOSE_CONTEXT",
+	UNEXPECTED_TOKEN_IGNORE:            "UNEXPECTED_TOKEN_IGNORE",
+	UNTIL_TERM:                         "UNTIL_TERM",
+	VOID_EXPRESSION:                    "VOID_EXPRESSION",
+	WHILE_TERM:                         "WHILE_TERM",
+	WRITE_TARGET_IN_METHOD:             "WRITE_TARGET_IN_METHOD",
+	WRITE_TARGET_READONLY:              "WRITE_TARGET_READONLY",
+	WRITE_TARGET_UNEXPECTED:            "WRITE_TARGET_UNEXPECTED",
+	XSTRING_TERM:                       "XSTRING_TERM",
+}
+
+func (t SyntaxErrorLevel) String() string {
+	if name, ok := syntaxErrorLevelNames[t]; ok {
+		return name
+	}
+
+	// numbered so that unknown values stay apart, in SARIF rules for instance
+	return fmt.Sprintf("UNKNOWN_ERROR_LEVEL_%d", int(t))
+}
+
+func (t SyntaxErrorLevel) MarshalText() ([]byte, error) {
+	name, ok := syntaxErrorLevelNames[t]
+	if !ok {
+		return nil, fmt.Errorf("unknown syntax error level: %d", int(t))
+	}
+
+	return []byte(name), nil
+}
+
+func (t *SyntaxErrorLevel) UnmarshalText(text []byte) error {
+	value, ok := SyntaxErrorLevelByName(string(text))
+	if !ok {
+		return fmt.Errorf("unknown syntax error level: %q", text)
+	}
+
+	*t = value
+	return nil
+}
+
+var syntaxErrorLevelsByName = func() map[string]SyntaxErrorLevel {
+	values := make(map[string]SyntaxErrorLevel, len(syntaxErrorLevelNames))
+	for value, name := range syntaxErrorLevelNames {
+		values[name] = value
+	}
+
+	return values
+}()
+
+// SyntaxErrorLevelByName returns the value named name, as returned by String.
+func SyntaxErrorLevelByName(name string) (SyntaxErrorLevel, bool) {
+	value, ok := syntaxErrorLevelsByName[name]
+	return value, ok
+}
+
+func (t SyntaxErrorType) String() string {
+	if name, ok := syntaxErrorTypeNames[t]; ok {
+		return name
+	}
+
+	// numbered so that unknown values stay apart, in SARIF rules for instance
+	return fmt.Sprintf("UNKNOWN_ERROR_%d", int(t))
+}
+
+func (t SyntaxErrorType) MarshalText() ([]byte, error) {
+	name, ok := syntaxErrorTypeNames[t]
+	if !ok {
+		return nil, fmt.Errorf("unknown syntax error type: %d", int(t))
+	}
+
+	return []byte(name), nil
+}
+
+func (t *SyntaxErrorType) UnmarshalText(text []byte) error {
+	value, ok := SyntaxErrorTypeByName(string(text))
+	if !ok {
+		return fmt.Errorf("unknown syntax error type: %q", text)
+	}
+
+	*t = value
+	return nil
+}
+
+var syntaxErrorTypesByName = func() map[string]SyntaxErrorType {
+	values := make(map[string]SyntaxErrorType, len(syntaxErrorTypeNames))
+	for value, name := range syntaxErrorTypeNames {
+		values[name] = value
+	}
+
+	return values
+}()
+
+// SyntaxErrorTypeByName returns the value named name, as returned by String.
+func SyntaxErrorTypeByName(name string) (SyntaxErrorType, bool) {
+	value, ok := syntaxErrorTypesByName[name]
+	return value, ok
+}
+
 type SyntaxError struct {
 	Message  string
 	Location *Location
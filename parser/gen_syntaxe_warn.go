@@ -2,6 +2,8 @@
 
 package parser
 
+import "fmt"
+
 type SyntaxWarningLevel int
 type SyntaxWarningType int
 
@@ -41,6 +43,115 @@ var SyntaxWarningTypes = []SyntaxWarningType{
 	KEYWORD_EOL,
 }
 
+var syntaxWarningLevelNames = map[SyntaxWarningLevel]string{
+	SyntaxWarningDefault: "default",
+	SyntaxWarningVerbose: "verbose",
+}
+
+// syntaxWarningTypeNames holds the names prism's config gives its diagnostic
+// ids. The config has no descriptions, the message prism reports along with a
+// warning describes it, see SyntaxWarning.Message.
+var syntaxWarningTypeNames = map[SyntaxWarningType]string{
+	AMBIGUOUS_FIRST_ARGUMENT_MINUS: "AMBIGUOUS_FIRST_ARGUMENT_MINUS",
+	AMBIGUOUS_FIRST_ARGUMENT_PLUS:  "AMBIGUOUS_FIRST_ARGUMENT_PLUS",
+	AMBIGUOUS_PREFIX_STAR:          "AMBIGUOUS_PREFIX_STAR",
+	AMBIGUOUS_SLASH:                "AMBIGUOUS_SLASH",
+	DOT_DOT_DOT_EOL:                "DOT_DOT_DOT_EOL",
+	EQUAL_IN_CONDITIONAL:           "EQUAL_IN_CONDITIONAL",
+	END_IN_METHOD:                  "END_IN_METHOD",
+	DUPLICATED_HASH_KEY:            "DUPLICATED_HASH_KEY",
+	DUPLICATED_WHEN_CLAUSE:         "DUPLICATED_WHEN_CLAUSE",
+	FLOAT_OUT_OF_RANGE:             "FLOAT_OUT_OF_RANGE",
+	INTEGER_IN_FLIP_FLOP:           "INTEGER_IN_FLIP_FLOP",
+	KEYWORD_EOL:                    "KEYWORD_EOL",
+}
+
+func (t SyntaxWarningLevel) String() string {
+	if name, ok := syntaxWarningLevelNames[t]; ok {
+		return name
+	}
+
+	// numbered so that unknown values stay apart, in SARIF rules for instance
+	return fmt.Sprintf("UNKNOWN_WARNING_LEVEL_%d", int(t))
+}
+
+func (t SyntaxWarningLevel) MarshalText() ([]byte, error) {
+	name, ok := syntaxWarningLevelNames[t]
+	if !ok {
+		return nil, fmt.Errorf("unknown syntax warning level: %d", int(t))
+	}
+
+	return []byte(name), nil
+}
+
+func (t *SyntaxWarningLevel) UnmarshalText(text []byte) error {
+	value, ok := SyntaxWarningLevelByName(string(text))
+	if !ok {
+		return fmt.Errorf("unknown syntax warning level: %q", text)
+	}
+
+	*t = value
+	return nil
+}
+
+var syntaxWarningLevelsByName = func() map[string]SyntaxWarningLevel {
+	values := make(map[string]SyntaxWarningLevel, len(syntaxWarningLevelNames))
+	for value, name := range syntaxWarningLevelNames {
+		values[name] = value
+	}
+
+	return values
+}()
+
+// SyntaxWarningLevelByName returns the value named name, as returned by String.
+func SyntaxWarningLevelByName(name string) (SyntaxWarningLevel, bool) {
+	value, ok := syntaxWarningLevelsByName[name]
+	return value, ok
+}
+
+func (t SyntaxWarningType) String() string {
+	if name, ok := syntaxWarningTypeNames[t]; ok {
+		return name
+	}
+
+	// numbered so that unknown values stay apart, in SARIF rules for instance
+	return fmt.Sprintf("UNKNOWN_WARNING_%d", int(t))
+}
+
+func (t SyntaxWarningType) MarshalText() ([]byte, error) {
+	name, ok := syntaxWarningTypeNames[t]
+	if !ok {
+		return nil, fmt.Errorf("unknown syntax warning type: %d", int(t))
+	}
+
+	return []byte(name), nil
+}
+
+func (t *SyntaxWarningType) UnmarshalText(text []byte) error {
+	value, ok := SyntaxWarningTypeByName(string(text))
+	if !ok {
+		return fmt.Errorf("unknown syntax warning type: %q", text)
+	}
+
+	*t = value
+	return nil
+}
+
+var syntaxWarningTypesByName = func() map[string]SyntaxWarningType {
+	values := make(map[string]SyntaxWarningType, len(syntaxWarningTypeNames))
+	for value, name := range syntaxWarningTypeNames {
+		values[name] = value
+	}
+
+	return values
+}()
+
+// SyntaxWarningTypeByName returns the value named name, as returned by String.
+func SyntaxWarningTypeByName(name string) (SyntaxWarningType, bool) {
+	value, ok := syntaxWarningTypesByName[name]
+	return value, ok
+}
+
 type SyntaxWarning struct {
 	Message  string
 	Location *Location
@@ -0,0 +1,196 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// scalarField is a field of a node that doesn't hold other nodes or
+// locations, formatted like Node.Inspect does.
+type scalarField struct {
+	Name  string
+	Value string
+}
+
+type GraphOption func(*graphOptions) error
+
+type graphOptions struct {
+	collapseWrappers bool
+}
+
+// WithCollapsedWrappers leaves StatementsNode and ArgumentsNode out of the
+// graph and links their children straight to the node holding them, the edges
+// being labelled with the field of that node and the index of the child.
+func WithCollapsedWrappers(collapse bool) GraphOption {
+	return func(o *graphOptions) error {
+		o.collapseWrappers = collapse
+		return nil
+	}
+}
+
+type graphNode struct {
+	id    string
+	label []string
+}
+
+type graphEdge struct {
+	from  string
+	to    string
+	label string
+}
+
+type graph struct {
+	options *graphOptions
+	nodes   []graphNode
+	edges   []graphEdge
+}
+
+func newGraph(root Node, opts []GraphOption) (*graph, error) {
+	options := &graphOptions{}
+	for _, opt := range opts {
+		if err := opt(options); err != nil {
+			return nil, fmt.Errorf("invalid graph option: %w", err)
+		}
+	}
+
+	g := &graph{options: options}
+	if !isNilNode(root) {
+		g.add(root)
+	}
+
+	return g, nil
+}
+
+func (g *graph) nextID() string {
+	return fmt.Sprintf("n%d", len(g.nodes))
+}
+
+// add adds node and the nodes below it.
+func (g *graph) add(node Node) {
+	id := g.nextID()
+
+	label := []string{reflect.TypeOf(node).Elem().Name()}
+	if scalars, ok := node.(interface{ scalarFields() []scalarField }); ok {
+		for _, field := range scalars.scalarFields() {
+			label = append(label, field.Name+": "+field.Value)
+		}
+	}
+
+	g.nodes = append(g.nodes, graphNode{id: id, label: label})
+	g.addChildren(id, node, "")
+}
+
+// addChildren links the children of node to the graph node from. prefix is
+// the field holding node if node is a collapsed wrapper.
+func (g *graph) addChildren(from string, node Node, prefix string) {
+	for _, child := range node.NamedChildren() {
+		if isNilNode(child.Node) {
+			continue
+		}
+
+		label := child.Name
+		if prefix != "" {
+			label = prefix
+		}
+		if child.Index >= 0 {
+			label = fmt.Sprintf("%s[%d]", label, child.Index)
+		}
+
+		if g.options.collapseWrappers && isWrapper(child.Node) {
+			g.addChildren(from, child.Node, label)
+			continue
+		}
+
+		// the edge goes first so that edges are listed in the order of the
+		// nodes they lead to
+		g.edges = append(g.edges, graphEdge{from: from, to: g.nextID(), label: label})
+		g.add(child.Node)
+	}
+}
+
+func isWrapper(node Node) bool {
+	switch node.(type) {
+	case *StatementsNode, *ArgumentsNode:
+		return true
+	}
+
+	return false
+}
+
+// ExportDOT writes the tree rooted at node as a Graphviz digraph. Nodes are
+// labelled with their type and scalar fields, edges with the field holding
+// the child.
+func ExportDOT(w io.Writer, node Node, opts ...GraphOption) error {
+	g, err := newGraph(node, opts)
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	b.WriteString("digraph AST {\n")
+	b.WriteString("  node [shape=box, fontname=\"monospace\"];\n")
+
+	for _, n := range g.nodes {
+		lines := make([]string, 0, len(n.label))
+		for _, line := range n.label {
+			lines = append(lines, escapeDOT(line))
+		}
+
+		fmt.Fprintf(&b, "  %s [label=\"%s\"];\n", n.id, strings.Join(lines, "\\n"))
+	}
+
+	for _, e := range g.edges {
+		fmt.Fprintf(&b, "  %s -> %s [label=\"%s\"];\n", e.from, e.to, escapeDOT(e.label))
+	}
+
+	b.WriteString("}\n")
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write dot graph: %w", err)
+	}
+
+	return nil
+}
+
+func escapeDOT(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
+
+// ExportMermaid writes the tree rooted at node as a Mermaid flowchart, labelled
+// like ExportDOT.
+func ExportMermaid(w io.Writer, node Node, opts ...GraphOption) error {
+	g, err := newGraph(node, opts)
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	b.WriteString("flowchart TD\n")
+
+	for _, n := range g.nodes {
+		lines := make([]string, 0, len(n.label))
+		for _, line := range n.label {
+			lines = append(lines, escapeMermaid(line))
+		}
+
+		fmt.Fprintf(&b, "  %s[\"%s\"]\n", n.id, strings.Join(lines, "<br/>"))
+	}
+
+	for _, e := range g.edges {
+		fmt.Fprintf(&b, "  %s -->|\"%s\"| %s\n", e.from, escapeMermaid(e.label), e.to)
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write mermaid flowchart: %w", err)
+	}
+
+	return nil
+}
+
+// escapeMermaid replaces the characters that end or break a quoted label with
+// entity codes.
+func escapeMermaid(s string) string {
+	return strings.NewReplacer(`#`, "#35;", `"`, "#quot;", "<", "#lt;", ">", "#gt;", "\n", "#10;").Replace(s)
+}
@@ -0,0 +1,53 @@
+package parser_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+const graphSource = "foo.bar(1, \"a\\\"b\") { |x| x }"
+
+func TestExportDOT(t *testing.T) {
+	result := parse(t, graphSource)
+
+	var b strings.Builder
+	if err := parser.ExportDOT(&b, result.Value); err != nil {
+		t.Fatalf("failed to export: %s", err)
+	}
+
+	golden(t, "graph.dot", []byte(b.String()))
+}
+
+func TestExportMermaid(t *testing.T) {
+	result := parse(t, graphSource)
+
+	var b strings.Builder
+	if err := parser.ExportMermaid(&b, result.Value, parser.WithCollapsedWrappers(true)); err != nil {
+		t.Fatalf("failed to export: %s", err)
+	}
+
+	golden(t, "graph.mmd", []byte(b.String()))
+}
+
+func TestExportGraphCollapsedWrappers(t *testing.T) {
+	result := parse(t, "foo(1, 2)")
+
+	var b strings.Builder
+	if err := parser.ExportDOT(&b, result.Value, parser.WithCollapsedWrappers(true)); err != nil {
+		t.Fatalf("failed to export: %s", err)
+	}
+
+	for _, wrapper := range []string{"StatementsNode", "ArgumentsNode"} {
+		if strings.Contains(b.String(), wrapper) {
+			t.Errorf("expected %s to be collapsed, got:\n%s", wrapper, b.String())
+		}
+	}
+
+	for _, edge := range []string{`n0 -> n1 [label="statements[0]"]`, `n1 -> n3 [label="arguments[1]"]`} {
+		if !strings.Contains(b.String(), edge) {
+			t.Errorf("expected %s, got:\n%s", edge, b.String())
+		}
+	}
+}
@@ -0,0 +1,346 @@
+package parser
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Inspect returns the tree the way Prism.parse(source).value.inspect prints
+// it in ruby. Without a source, like in a result decoded from JSON without it,
+// locations print as ∅.
+func (p *ParseResult) Inspect() string {
+	return p.Value.Inspect(p.Source)
+}
+
+// nodeInspector builds the output of Node.Inspect, a header line for the node
+// followed by one line per field. Child nodes are inspected on their own and
+// indented below the field holding them.
+type nodeInspector struct {
+	source *Source
+	output strings.Builder
+}
+
+func newNodeInspector(source *Source, node Node) *nodeInspector {
+	in := &nodeInspector{source: source}
+
+	fmt.Fprintf(&in.output, "@ %s (location: %s)\n", reflect.TypeOf(node).Elem().Name(), in.position(node.Location()))
+
+	return in
+}
+
+// position returns the lines and columns loc spans, or ∅ without a location,
+// like nodes built with their constructor, or without a source to compute them
+// from.
+func (in *nodeInspector) position(loc *Location) string {
+	if loc == nil || in.source == nil {
+		return "∅"
+	}
+
+	return fmt.Sprintf("(%d,%d)-(%d,%d)",
+		in.source.Line(loc.StartOffset), in.source.Column(loc.StartOffset),
+		in.source.Line(loc.EndOffset()), in.source.Column(loc.EndOffset()),
+	)
+}
+
+func (in *nodeInspector) String() string {
+	return in.output.String()
+}
+
+func inspectPointer(last bool) (string, string) {
+	if last {
+		return "└── ", "    "
+	}
+
+	return "├── ", "│   "
+}
+
+func (in *nodeInspector) value(name string, last bool, value string) {
+	pointer, _ := inspectPointer(last)
+	fmt.Fprintf(&in.output, "%s%s: %s\n", pointer, name, value)
+}
+
+func (in *nodeInspector) node(name string, last bool, node Node) {
+	if isNilNode(node) {
+		in.value(name, last, "∅")
+		return
+	}
+
+	pointer, preadd := inspectPointer(last)
+	fmt.Fprintf(&in.output, "%s%s:\n", pointer, name)
+	in.indent(node.Inspect(in.source), preadd, preadd)
+}
+
+func (in *nodeInspector) nodes(name string, last bool, nodes []Node) {
+	pointer, preadd := inspectPointer(last)
+	fmt.Fprintf(&in.output, "%s%s: (length: %d)\n", pointer, name, len(nodes))
+
+	for i, node := range nodes {
+		nodePointer, nodePreadd := inspectPointer(i == len(nodes)-1)
+
+		if isNilNode(node) {
+			fmt.Fprintf(&in.output, "%s%s∅\n", preadd, nodePointer)
+			continue
+		}
+
+		in.indent(node.Inspect(in.source), preadd+nodePointer, preadd+nodePreadd)
+	}
+}
+
+// indent writes the lines of text, the first one prefixed with first and the
+// others with rest.
+func (in *nodeInspector) indent(text string, first string, rest string) {
+	prefix := first
+	for _, line := range strings.SplitAfter(text, "\n") {
+		if line == "" {
+			continue
+		}
+
+		in.output.WriteString(prefix)
+		in.output.WriteString(line)
+		prefix = rest
+	}
+}
+
+func (in *nodeInspector) constants(name string, last bool, constants []string) {
+	symbols := make([]string, 0, len(constants))
+	for _, constant := range constants {
+		symbols = append(symbols, inspectSymbol(constant))
+	}
+
+	in.value(name, last, "["+strings.Join(symbols, ", ")+"]")
+}
+
+func (in *nodeInspector) optionalConstant(name string, last bool, constant *string) {
+	if constant == nil {
+		in.value(name, last, "∅")
+		return
+	}
+
+	in.value(name, last, inspectSymbol(*constant))
+}
+
+func (in *nodeInspector) location(name string, last bool, loc *Location) {
+	if loc == nil || in.source == nil {
+		in.value(name, last, "∅")
+		return
+	}
+
+	in.value(name, last, fmt.Sprintf("%s = %s", in.position(loc), inspectString(in.source.slice(loc.StartOffset, loc.EndOffset()))))
+}
+
+func isNilNode(node Node) bool {
+	if node == nil {
+		return true
+	}
+
+	// a nil pointer of a concrete node type
+	value := reflect.ValueOf(node)
+	return value.Kind() == reflect.Pointer && value.IsNil()
+}
+
+func inspectFlags(names []string) string {
+	if len(names) == 0 {
+		return "∅"
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// flagNames returns the names of the bits set in flags, names[i] being the
+// name of bit i.
+func flagNames(flags int16, names []string) []string {
+	set := make([]string, 0)
+	for i, name := range names {
+		if flags&(1<<i) != 0 {
+			set = append(set, name)
+		}
+	}
+
+	return set
+}
+
+func inspectInteger(value *big.Int) string {
+	if value == nil {
+		return "∅"
+	}
+
+	return value.String()
+}
+
+// inspectFloat formats value like Float#inspect: fixed notation for decimal
+// exponents from -4 to 16 and scientific notation otherwise, both with the
+// shortest digits that read back as value.
+func inspectFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "Infinity"
+	case math.IsInf(value, -1):
+		return "-Infinity"
+	case math.IsNaN(value):
+		return "NaN"
+	case value == 0 && math.Signbit(value):
+		return "-0.0"
+	case value == 0:
+		return "0.0"
+	}
+
+	sign := ""
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+
+	// d.ddde±xx
+	scientific := strconv.FormatFloat(value, 'e', -1, 64)
+	mantissa, exponent, _ := strings.Cut(scientific, "e")
+	digits := strings.Replace(mantissa, ".", "", 1)
+	exp, _ := strconv.Atoi(exponent)
+	decpt := exp + 1
+
+	switch {
+	case decpt < -3 || decpt > 16:
+		fraction := digits[1:]
+		if fraction == "" {
+			fraction = "0"
+		}
+
+		return fmt.Sprintf("%s%s.%se%+03d", sign, digits[:1], fraction, exp)
+	case decpt <= 0:
+		return sign + "0." + strings.Repeat("0", -decpt) + digits
+	case decpt >= len(digits):
+		return sign + digits + strings.Repeat("0", decpt-len(digits)) + ".0"
+	}
+
+	return sign + digits[:decpt] + "." + digits[decpt:]
+}
+
+// inspectString quotes s like String#inspect does for UTF-8 strings.
+func inspectString(s []byte) string {
+	var b strings.Builder
+	b.WriteByte('"')
+
+	for len(s) > 0 {
+		c, size := utf8.DecodeRune(s)
+		if c == utf8.RuneError && size == 1 {
+			fmt.Fprintf(&b, "\\x%02X", s[0])
+			s = s[1:]
+			continue
+		}
+		s = s[size:]
+
+		switch {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(c)
+		case c == '#' && len(s) > 0 && (s[0] == '{' || s[0] == '$' || s[0] == '@'):
+			b.WriteString("\\#")
+		case c == '\n':
+			b.WriteString("\\n")
+		case c == '\r':
+			b.WriteString("\\r")
+		case c == '\t':
+			b.WriteString("\\t")
+		case c == '\f':
+			b.WriteString("\\f")
+		case c == '\v':
+			b.WriteString("\\v")
+		case c == '\b':
+			b.WriteString("\\b")
+		case c == '\a':
+			b.WriteString("\\a")
+		case c == 033:
+			b.WriteString("\\e")
+		case unicode.IsGraphic(c) || unicode.Is(unicode.Cf, c):
+			b.WriteRune(c)
+		case c < 0x10000:
+			fmt.Fprintf(&b, "\\u%04X", c)
+		default:
+			fmt.Fprintf(&b, "\\u{%X}", c)
+		}
+	}
+
+	b.WriteByte('"')
+	return b.String()
+}
+
+var operatorSymbols = map[string]bool{
+	"[]": true, "[]=": true, "**": true, "!": true, "!=": true, "!~": true,
+	"+": true, "-": true, "+@": true, "-@": true, "*": true, "/": true,
+	"%": true, "<=>": true, "<": true, "<=": true, "<<": true, ">": true,
+	">=": true, ">>": true, "==": true, "===": true, "=~": true, "&": true,
+	"|": true, "^": true, "~": true, "`": true,
+}
+
+// inspectSymbol formats name like Symbol#inspect, quoting it unless it can be
+// written as a symbol literal as is.
+func inspectSymbol(name string) string {
+	if isSimpleSymbol(name) {
+		return ":" + name
+	}
+
+	return ":" + inspectString([]byte(name))
+}
+
+func isSimpleSymbol(name string) bool {
+	if !utf8.ValidString(name) || name == "" {
+		return false
+	}
+
+	if operatorSymbols[name] {
+		return true
+	}
+
+	switch {
+	case strings.HasPrefix(name, "@@"):
+		return isIdentifier(name[2:])
+	case strings.HasPrefix(name, "@"):
+		return isIdentifier(name[1:])
+	case strings.HasPrefix(name, "$"):
+		return isGlobalName(name[1:])
+	}
+
+	// method names may end with ?, ! or =
+	switch name[len(name)-1] {
+	case '?', '!', '=':
+		name = name[:len(name)-1]
+	}
+
+	return isIdentifier(name)
+}
+
+func isGlobalName(name string) bool {
+	switch {
+	case len(name) == 1 && strings.Contains("~*$?!@/\\;,.=:<>\"&`'+0_", name):
+		return true
+	case len(name) == 2 && name[0] == '-':
+		return isIdentifierChar(rune(name[1]))
+	case name != "" && strings.Trim(name, "0123456789") == "":
+		return true
+	}
+
+	return isIdentifier(name)
+}
+
+func isIdentifier(name string) bool {
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		return false
+	}
+
+	for _, c := range name {
+		if !isIdentifierChar(c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isIdentifierChar(c rune) bool {
+	return c == '_' || c >= utf8.RuneSelf || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
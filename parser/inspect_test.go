@@ -0,0 +1,153 @@
+package parser
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestInspect(t *testing.T) {
+	ctx := context.Background()
+	p, err := NewParser(ctx)
+	if err != nil {
+		t.Fatalf("failed to create parser: %s", err)
+	}
+	defer p.Close(ctx)
+
+	result, err := p.Parse(ctx, []byte("foo(:bar)"))
+	if err != nil {
+		t.Fatalf("failed to parse: %s", err)
+	}
+
+	expected := `@ ProgramNode (location: (1,0)-(1,9))
+├── locals: []
+└── statements:
+    @ StatementsNode (location: (1,0)-(1,9))
+    └── body: (length: 1)
+        └── @ CallNode (location: (1,0)-(1,9))
+            ├── flags: ignore_visibility
+            ├── receiver: ∅
+            ├── call_operator_loc: ∅
+            ├── name: :foo
+            ├── message_loc: (1,0)-(1,3) = "foo"
+            ├── opening_loc: (1,3)-(1,4) = "("
+            ├── arguments:
+            │   @ ArgumentsNode (location: (1,4)-(1,8))
+            │   ├── flags: ∅
+            │   └── arguments: (length: 1)
+            │       └── @ SymbolNode (location: (1,4)-(1,8))
+            │           ├── flags: forced_us_ascii_encoding
+            │           ├── opening_loc: (1,4)-(1,5) = ":"
+            │           ├── value_loc: (1,5)-(1,8) = "bar"
+            │           ├── closing_loc: ∅
+            │           └── unescaped: "bar"
+            ├── closing_loc: (1,8)-(1,9) = ")"
+            └── block: ∅
+`
+	if got := result.Inspect(); got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+
+	call := result.Value.(*ProgramNode).Statements.Body[0]
+	if got := call.Inspect(result.Source); !strings.HasPrefix(got, "@ CallNode (location: (1,0)-(1,9))\n├── flags: ignore_visibility\n") {
+		t.Errorf("expected a subtree to be inspected on its own, got:\n%s", got)
+	}
+}
+
+func TestInspectConstructedNode(t *testing.T) {
+	arguments := NewArgumentsNode(0, []Node{NewIntegerNode(INTEGER_BASE_DECIMAL, big.NewInt(1), nil)}, nil)
+	call := NewCallNode(0, nil, nil, "foo", nil, nil, arguments, nil, nil, nil)
+
+	expected := `@ CallNode (location: ∅)
+├── flags: ∅
+├── receiver: ∅
+├── call_operator_loc: ∅
+├── name: :foo
+├── message_loc: ∅
+├── opening_loc: ∅
+├── arguments:
+│   @ ArgumentsNode (location: ∅)
+│   ├── flags: ∅
+│   └── arguments: (length: 1)
+│       └── @ IntegerNode (location: ∅)
+│           ├── flags: decimal
+│           └── value: 1
+├── closing_loc: ∅
+└── block: ∅
+`
+	if got := call.Inspect(nil); got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+
+	// the same with a source, which the nodes have no location in
+	if got := call.Inspect(NewSource([]byte("foo(1)"), 1, nil)); got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestInspectFloat(t *testing.T) {
+	table := map[float64]string{
+		1.5:                 "1.5",
+		-2.25:               "-2.25",
+		100:                 "100.0",
+		1e16:                "1.0e+16",
+		1e15:                "1000000000000000.0",
+		1.5e100:             "1.5e+100",
+		0.0001:              "0.0001",
+		0.00001:             "1.0e-05",
+		0.30000000000000004: "0.30000000000000004",
+	}
+
+	for value, expected := range table {
+		if got := inspectFloat(value); got != expected {
+			t.Errorf("expected %v to inspect as %s, got %s", value, expected, got)
+		}
+	}
+}
+
+func TestInspectSymbol(t *testing.T) {
+	table := map[string]string{
+		"foo":   ":foo",
+		"Foo":   ":Foo",
+		"foo?":  ":foo?",
+		"foo=":  ":foo=",
+		"[]=":   ":[]=",
+		"<=>":   ":<=>",
+		"@a":    ":@a",
+		"@@a":   ":@@a",
+		"$a":    ":$a",
+		"$~":    ":$~",
+		"$1":    ":$1",
+		"$-w":   ":$-w",
+		"café":  ":café",
+		"0it":   `:"0it"`,
+		"a b":   `:"a b"`,
+		"foo?=": `:"foo?="`,
+		"a\"b":  `:"a\"b"`,
+		"":      `:""`,
+	}
+
+	for name, expected := range table {
+		if got := inspectSymbol(name); got != expected {
+			t.Errorf("expected %q to inspect as %s, got %s", name, expected, got)
+		}
+	}
+}
+
+func TestInspectString(t *testing.T) {
+	table := map[string]string{
+		"foo":              `"foo"`,
+		"a\"b\\c":          `"a\"b\\c"`,
+		"\n\t\x1b\x00":     `"\n\t\e\u0000"`,
+		"#{a} #$b #@c #d":  `"\#{a} \#$b \#@c #d"`,
+		"café \xff":        `"café \xFF"`,
+		"\u0085\U0001F600": `"\u0085` + "\U0001F600" + `"`,
+	}
+
+	for s, expected := range table {
+		if got := inspectString([]byte(s)); got != expected {
+			t.Errorf("expected %q to inspect as %s, got %s", s, expected, got)
+		}
+	}
+}
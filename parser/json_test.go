@@ -0,0 +1,174 @@
+package parser_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+func TestParseResultJSONRoundTrip(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("testdata", "corpus", "*.rb"))
+	if err != nil || len(paths) == 0 {
+		t.Fatalf("failed to find the corpus: %v", err)
+	}
+
+	for _, path := range paths {
+		source, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("failed to read %s: %s", path, err)
+		}
+
+		result := parse(t, string(source))
+
+		data, err := json.Marshal(parser.ParseResultWithSource{ParseResult: result})
+		if err != nil {
+			t.Fatalf("%s: failed to marshal: %s", path, err)
+		}
+
+		var decoded parser.ParseResult
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("%s: failed to unmarshal: %s", path, err)
+		}
+
+		again, err := json.Marshal(parser.ParseResultWithSource{ParseResult: &decoded})
+		if err != nil {
+			t.Fatalf("%s: failed to marshal the decoded result: %s", path, err)
+		}
+
+		if !bytes.Equal(data, again) {
+			t.Errorf("%s: expected the JSON to be stable, got\n%s\nthen\n%s", path, data, again)
+		}
+
+		if !reflect.DeepEqual(result, &decoded) {
+			t.Errorf("%s: expected the decoded result to equal the parsed one", path)
+		}
+	}
+}
+
+func TestParseResultJSONWithoutSource(t *testing.T) {
+	result := parse(t, "foo(1)")
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("failed to marshal: %s", err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("failed to unmarshal the fields: %s", err)
+	}
+
+	if _, ok := fields["source"]; ok {
+		t.Errorf("expected the source to be left out, got %s", data)
+	}
+
+	var decoded parser.ParseResult
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("failed to unmarshal: %s", err)
+	}
+
+	if decoded.Source != nil {
+		t.Errorf("expected no source, got %+v", decoded.Source)
+	}
+
+	decoded.Source = result.Source
+	if !reflect.DeepEqual(result, &decoded) {
+		t.Errorf("expected the decoded result to equal the parsed one but for its source")
+	}
+
+	var withSource parser.ParseResultWithSource
+	if err := json.Unmarshal(data, &withSource); err != nil || withSource.ParseResult == nil {
+		t.Errorf("expected ParseResultWithSource to decode JSON without a source, got %v", err)
+	}
+}
+
+func TestParseResultJSONWithoutSourceUsable(t *testing.T) {
+	data, err := json.Marshal(parse(t, "foo(1"))
+	if err != nil {
+		t.Fatalf("failed to marshal: %s", err)
+	}
+
+	var decoded parser.ParseResult
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("failed to unmarshal: %s", err)
+	}
+
+	err = decoded.Err()
+	if err == nil {
+		t.Fatalf("expected the syntax errors to be kept")
+	}
+
+	// the messages are still there, only the positions are missing
+	if message := err.Error(); message != decoded.SynError[0].Message {
+		t.Errorf("unexpected error message %q", message)
+	}
+
+	if inspected := decoded.Inspect(); !strings.HasPrefix(inspected, "@ ProgramNode (location: ∅)\n") {
+		t.Errorf("unexpected inspection %q", inspected)
+	}
+
+	if node := decoded.NodeAtPosition(1, 0); node != nil {
+		t.Errorf("expected no node without a source, got %T", node)
+	}
+
+	var b bytes.Buffer
+	renderer := parser.DiagnosticRenderer{Filename: "app.rb"}
+	if err := renderer.Render(&b, &decoded); err != nil {
+		t.Fatalf("failed to render: %s", err)
+	}
+
+	if !strings.HasPrefix(b.String(), "app.rb: error: ") {
+		t.Errorf("unexpected diagnostics %q", b.String())
+	}
+
+	rewriter := parser.NewRewriter(&decoded)
+	if err := rewriter.Remove(decoded.Value.Location()); err == nil {
+		t.Errorf("expected edits to be rejected without a source")
+	}
+}
+
+func TestUnmarshalNode(t *testing.T) {
+	node, err := parser.UnmarshalNode([]byte(`{"nodeName":"IntegerNode","flags":2,"value":18446744073709551616,"loc":{"startOffset":0,"length":4}}`))
+	if err != nil {
+		t.Fatalf("failed to unmarshal: %s", err)
+	}
+
+	integer, ok := node.(*parser.IntegerNode)
+	if !ok {
+		t.Fatalf("expected an IntegerNode, got %T", node)
+	}
+
+	if integer.Value.String() != "18446744073709551616" || integer.Flags != 2 || integer.Loc.Length != 4 {
+		t.Errorf("unexpected node %+v", integer)
+	}
+
+	if node, err := parser.UnmarshalNode([]byte("null")); node != nil || err != nil {
+		t.Errorf("expected null to decode to a nil node, got %v, %v", node, err)
+	}
+
+	if _, err := parser.UnmarshalNode([]byte(`{"nodeName":"FooNode"}`)); err == nil {
+		t.Errorf("expected an unknown node to be rejected")
+	}
+
+	node, err = parser.UnmarshalNode([]byte(`{"nodeName":"FloatNode","value":"-Infinity","loc":{"startOffset":0,"length":6}}`))
+	if float, ok := node.(*parser.FloatNode); err != nil || !ok || !math.IsInf(float.Value, -1) {
+		t.Errorf("expected -Infinity to decode to an infinite float, got %v, %v", node, err)
+	}
+
+	if _, err := parser.UnmarshalNode([]byte(`{"nodeName":"FloatNode","value":"Inf"}`)); err == nil {
+		t.Errorf("expected an invalid float to be rejected")
+	}
+
+	// typed fields check the name of the node they hold
+	var call parser.CallNode
+	if err := json.Unmarshal([]byte(`{"nodeName":"CallNode","arguments":{"nodeName":"NilNode"}}`), &call); err == nil {
+		t.Errorf("expected a NilNode to be rejected as arguments")
+	}
+}
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 )
 
@@ -35,8 +36,24 @@ func loadVarSInt(buff *buffer) (int32, error) {
 	return int32((x >> 1) ^ (-(x & 1))), nil
 }
 
-func loadLineOffsets(buff *buffer) ([]uint32, error) {
+// loadCount reads the length of a list. Every element takes at least one
+// byte, so a count larger than what is left of the buffer is corrupt and
+// rejected before anything is allocated for it.
+func loadCount(buff *buffer) (uint32, error) {
 	count, err := loadVarUInt(buff)
+	if err != nil {
+		return 0, err
+	}
+
+	if int64(count) > int64(buff.remaining()) {
+		return 0, fmt.Errorf("count %d at offset %d exceeds the %d remaining bytes: %w", count, buff.position(), buff.remaining(), io.ErrUnexpectedEOF)
+	}
+
+	return count, nil
+}
+
+func loadLineOffsets(buff *buffer) ([]uint32, error) {
+	count, err := loadCount(buff)
 	if err != nil {
 		return nil, fmt.Errorf("error reading line offsets count: %w", err)
 	}
@@ -66,8 +83,26 @@ func loadLocation(buff *buffer) (*Location, error) {
 	return NewLocation(startOffset, length), nil
 }
 
+// loadLocationField reads a location field of a node, which blobs serialized
+// with only semantic fields leave out.
+func loadLocationField(buff *buffer) (*Location, error) {
+	if buff.onlySemanticFields {
+		return nil, nil
+	}
+
+	return loadLocation(buff)
+}
+
+func loadOptionalLocationField(buff *buffer) (*Location, error) {
+	if buff.onlySemanticFields {
+		return nil, nil
+	}
+
+	return loadOptionalLocation(buff)
+}
+
 func loadComments(buff *buffer) ([]*Comment, error) {
-	count, err := loadVarUInt(buff)
+	count, err := loadCount(buff)
 	if err != nil {
 		return nil, fmt.Errorf("error reading comments count: %w", err)
 	}
@@ -93,7 +128,7 @@ func loadComments(buff *buffer) ([]*Comment, error) {
 }
 
 func loadMagicComments(buff *buffer) ([]*MagicComment, error) {
-	count, err := loadVarUInt(buff)
+	count, err := loadCount(buff)
 	if err != nil {
 		return nil, fmt.Errorf("error reading magic comments count: %w", err)
 	}
@@ -118,6 +153,34 @@ func loadMagicComments(buff *buffer) ([]*MagicComment, error) {
 	return comments, nil
 }
 
+func loadTokens(buff *buffer) ([]*Token, error) {
+	tokens := make([]*Token, 0)
+
+	for {
+		tokenType, err := loadVarUInt(buff)
+		if err != nil {
+			return nil, fmt.Errorf("error reading token type: %w", err)
+		}
+
+		// a zero type marks the end of the tokens
+		if tokenType == 0 {
+			return tokens, nil
+		}
+
+		loc, err := loadLocation(buff)
+		if err != nil {
+			return nil, fmt.Errorf("error reading token location: %w", err)
+		}
+
+		lexState, err := loadVarUInt(buff)
+		if err != nil {
+			return nil, fmt.Errorf("error reading token lex state: %w", err)
+		}
+
+		tokens = append(tokens, NewToken(TokenType(tokenType), loc, LexState(lexState)))
+	}
+}
+
 func loadOptionalLocation(buff *buffer) (*Location, error) {
 	nextByte, err := buff.readByte()
 	if err != nil {
@@ -137,7 +200,7 @@ func loadOptionalLocation(buff *buffer) (*Location, error) {
 }
 
 func loadSynErrors(buff *buffer) ([]*SyntaxError, error) {
-	count, err := loadVarUInt(buff)
+	count, err := loadCount(buff)
 	if err != nil {
 		return nil, fmt.Errorf("error reading errors count: %w", err)
 	}
@@ -164,6 +227,14 @@ func loadSynErrors(buff *buffer) ([]*SyntaxError, error) {
 			return nil, fmt.Errorf("error reading error level: %w", err)
 		}
 
+		if int(errorType) >= len(SyntaxErrorTypes) {
+			return nil, fmt.Errorf("invalid error type: %d", errorType)
+		}
+
+		if int(errorLevel) >= len(SyntaxErrorLevels) {
+			return nil, fmt.Errorf("invalid error level: %d", errorLevel)
+		}
+
 		synErrs[i] = NewSyntaxError(
 			string(messageBytes),
 			location,
@@ -176,7 +247,7 @@ func loadSynErrors(buff *buffer) ([]*SyntaxError, error) {
 }
 
 func loadSynWarnings(buff *buffer) ([]*SyntaxWarning, error) {
-	count, err := loadVarUInt(buff)
+	count, err := loadCount(buff)
 	if err != nil {
 		return nil, fmt.Errorf("error reading warnings count: %w", err)
 	}
@@ -203,6 +274,15 @@ func loadSynWarnings(buff *buffer) ([]*SyntaxWarning, error) {
 			return nil, fmt.Errorf("error reading warning level: %w", err)
 		}
 
+		// warning types follow the error types in prism's diagnostic ids
+		if int(warningType) < 224 || int(warningType)-224 >= len(SyntaxWarningTypes) {
+			return nil, fmt.Errorf("invalid warning type: %d", warningType)
+		}
+
+		if int(warningLevel) >= len(SyntaxWarningLevels) {
+			return nil, fmt.Errorf("invalid warning level: %d", warningLevel)
+		}
+
 		synWarnings[i] = NewSyntaxWarning(
 			string(messageBytes),
 			location,
@@ -215,7 +295,7 @@ func loadSynWarnings(buff *buffer) ([]*SyntaxWarning, error) {
 }
 
 func loadEmbeddedStr(buff *buffer) ([]byte, error) {
-	length, err := loadVarUInt(buff)
+	length, err := loadCount(buff)
 	if err != nil {
 		return nil, fmt.Errorf("error reading embedded string length: %w", err)
 	}
@@ -247,6 +327,10 @@ func loadStr(buff *buffer, src []byte) ([]byte, error) {
 			return nil, fmt.Errorf("error reading string length: %w", err)
 		}
 
+		if uint64(start)+uint64(length) > uint64(len(src)) {
+			return nil, fmt.Errorf("string %d...%d is outside of the %d byte source: %w", start, uint64(start)+uint64(length), len(src), io.ErrUnexpectedEOF)
+		}
+
 		bytes := make([]byte, length)
 		copy(bytes, src[start:start+length])
 		return bytes, nil
@@ -258,7 +342,7 @@ func loadStr(buff *buffer, src []byte) ([]byte, error) {
 
 		return strBytes, nil
 	default:
-		return nil, fmt.Errorf("invalid string type: %d: %w", b, err)
+		return nil, fmt.Errorf("invalid string type: %d", b)
 	}
 }
 
@@ -272,7 +356,9 @@ func loadOptionalNode(buff *buffer, src []byte, pool *constantPool) (Node, error
 		return nil, nil
 	}
 
-	buff.setPosition(buff.position() - 1)
+	if err := buff.setPosition(buff.position() - 1); err != nil {
+		return nil, err
+	}
 	node, err := loadNode(buff, src, pool)
 	if err != nil {
 		return nil, fmt.Errorf("error reading node: %w", err)
@@ -354,7 +440,9 @@ func loadOptionalConstant(buff *buffer, pool *constantPool) (*string, error) {
 		return nil, nil
 	}
 
-	buff.setPosition(buff.position() - 1)
+	if err := buff.setPosition(buff.position() - 1); err != nil {
+		return nil, err
+	}
 	constant, err := loadConstant(buff, pool)
 	if err != nil {
 		return nil, fmt.Errorf("error reading node: %w", err)
@@ -364,7 +452,7 @@ func loadOptionalConstant(buff *buffer, pool *constantPool) (*string, error) {
 }
 
 func loadConstants(buff *buffer, pool *constantPool) ([]string, error) {
-	count, err := loadVarUInt(buff)
+	count, err := loadCount(buff)
 	if err != nil {
 		return nil, fmt.Errorf("error reading constants count: %w", err)
 	}
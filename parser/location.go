@@ -24,3 +24,31 @@ func (l *Location) MarshalJSON() ([]byte, error) {
 		"length":      l.Length,
 	})
 }
+
+func (l *Location) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		StartOffset uint32 `json:"startOffset"`
+		Length      uint32 `json:"length"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	*l = Location{
+		StartOffset: fields.StartOffset,
+		Length:      fields.Length,
+	}
+
+	return nil
+}
+
+// Covers reports whether offset is inside the location. A zero-length location
+// covers only its start offset.
+func (l *Location) Covers(offset uint32) bool {
+	if l.Length == 0 {
+		return offset == l.StartOffset
+	}
+
+	return offset >= l.StartOffset && offset < l.EndOffset()
+}
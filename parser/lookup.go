@@ -0,0 +1,73 @@
+package parser
+
+// NodesCovering returns the chain of nodes whose location covers offset, from
+// the outermost to the innermost one. Nodes without a location never match.
+// Children that reach outside their parent, like heredoc bodies, are still
+// found, and their chain skips the parents that do not cover offset.
+func (p *ParseResult) NodesCovering(offset uint32) []Node {
+	var best []Node
+	var bestLength uint32
+
+	path := make([]Node, 0, 32)
+
+	Walk(p.Value, func(node Node) WalkAction {
+		path = append(path, node)
+
+		loc := node.Location()
+		if loc == nil || !loc.Covers(offset) {
+			return WalkContinue
+		}
+
+		chain := covering(path, offset)
+		// prefer the deepest chain and, among chains of the same depth, the
+		// node with the narrowest location
+		if len(chain) > len(best) || (len(chain) == len(best) && loc.Length < bestLength) {
+			best = chain
+			bestLength = loc.Length
+		}
+
+		return WalkContinue
+	}, func(node Node) WalkAction {
+		path = path[:len(path)-1]
+		return WalkContinue
+	})
+
+	return best
+}
+
+// NodeAt returns the innermost node covering offset, or nil if there is none.
+func (p *ParseResult) NodeAt(offset uint32) Node {
+	chain := p.NodesCovering(offset)
+	if len(chain) == 0 {
+		return nil
+	}
+
+	return chain[len(chain)-1]
+}
+
+// NodeAtPosition returns the innermost node covering the given line and byte
+// column, or nil if there is none or the result has no source to find the
+// position in.
+func (p *ParseResult) NodeAtPosition(line int, column int) Node {
+	if p.Source == nil {
+		return nil
+	}
+
+	offset, ok := p.Source.Offset(line, column)
+	if !ok {
+		return nil
+	}
+
+	return p.NodeAt(offset)
+}
+
+func covering(path []Node, offset uint32) []Node {
+	chain := make([]Node, 0, len(path))
+	for _, node := range path {
+		if loc := node.Location(); loc != nil && loc.Covers(offset) {
+			chain = append(chain, node)
+		}
+	}
+
+	return chain
+}
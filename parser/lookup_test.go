@@ -0,0 +1,69 @@
+package parser_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+func TestNodeAt(t *testing.T) {
+	source := "def foo\n  bar(baz)\nend\n"
+	result := parse(t, source)
+
+	offset := uint32(strings.Index(source, "baz") + 1)
+
+	node, ok := result.NodeAt(offset).(*parser.CallNode)
+	if !ok || node.Name != "baz" {
+		t.Fatalf("expected the innermost node to be the call to baz, got %T", result.NodeAt(offset))
+	}
+
+	var names []string
+	for _, node := range result.NodesCovering(offset) {
+		names = append(names, nodeName(node))
+	}
+
+	expected := "ProgramNode StatementsNode DefNode StatementsNode CallNode ArgumentsNode CallNode"
+	if strings.Join(names, " ") != expected {
+		t.Errorf("expected chain %s, got %s", expected, strings.Join(names, " "))
+	}
+}
+
+func TestNodeAtPosition(t *testing.T) {
+	result := parse(t, "x = 1\ny = x + 22\n")
+
+	node, ok := result.NodeAtPosition(2, 9).(*parser.IntegerNode)
+	if !ok || node.Value.Int64() != 22 {
+		t.Errorf("expected the integer 22, got %T", result.NodeAtPosition(2, 9))
+	}
+
+	if result.NodeAtPosition(7, 0) != nil {
+		t.Errorf("expected no node past the last line")
+	}
+}
+
+func TestNodeAtZeroLengthLocation(t *testing.T) {
+	// foo(x = ) where the missing value is a zero-length node at offset 8
+	missing := parser.NewMissingNode(parser.NewLocation(8, 0))
+	write := parser.NewLocalVariableWriteNode("x", 0, parser.NewLocation(4, 1), missing, parser.NewLocation(6, 1), parser.NewLocation(4, 4))
+	statements := parser.NewStatementsNode([]parser.Node{write}, parser.NewLocation(0, 9))
+	result := parser.NewParseResult(statements, nil, nil, nil, nil, nil, nil)
+
+	if result.NodeAt(8) != missing {
+		t.Errorf("expected the missing node at its start offset, got %T", result.NodeAt(8))
+	}
+
+	if result.NodeAt(7) != write {
+		t.Errorf("expected the zero-length node not to cover the offset before it, got %T", result.NodeAt(7))
+	}
+}
+
+func TestNodeAtHeredoc(t *testing.T) {
+	source := "foo(<<~EOS)\n  #{bar}\nEOS\n"
+	result := parse(t, source)
+
+	node, ok := result.NodeAt(uint32(strings.Index(source, "bar"))).(*parser.CallNode)
+	if !ok || node.Name != "bar" {
+		t.Errorf("expected to find bar inside the heredoc body, got %T", result.NodeAt(uint32(strings.Index(source, "bar"))))
+	}
+}
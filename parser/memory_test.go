@@ -0,0 +1,118 @@
+package parser_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+const pageSize = 64 << 10
+
+func TestParseMemoryStaysBounded(t *testing.T) {
+	ctx := context.Background()
+	p := newParser(t)
+
+	var warm uint64
+	for i := range 3000 {
+		source := fmt.Sprintf("def m%d(a)\n  [a, %d, {b: %q}].map { _1 * 2 }\nend\n", i, i, strings.Repeat("x", i%500))
+		if i%3 == 0 {
+			// syntax errors take other paths through prism
+			source += "foo(("
+		}
+
+		var err error
+		if i%5 == 0 {
+			_, err = p.Lex(ctx, []byte(source))
+		} else {
+			_, err = p.Parse(ctx, []byte(source))
+		}
+		if err != nil {
+			t.Fatalf("failed to parse %q: %s", source, err)
+		}
+
+		if i == 100 {
+			warm = p.Stats().MemoryBytes
+		}
+	}
+
+	stats := p.Stats()
+	if stats.Parses != 3000 {
+		t.Errorf("expected 3000 parses, got %d", stats.Parses)
+	}
+
+	// allow for fragmentation, a leak of even a few bytes per parse would
+	// take more
+	if stats.MemoryBytes > warm+2*pageSize {
+		t.Errorf("expected memory to stay around %d bytes, grew to %d", warm, stats.MemoryBytes)
+	}
+
+	if stats.Recycles != 0 || stats.Aborts != 0 {
+		t.Errorf("expected no instance to be replaced, got %+v", stats)
+	}
+}
+
+func TestRecycleThreshold(t *testing.T) {
+	ctx := context.Background()
+	p, err := parser.NewParser(ctx, parser.WithRecycleThreshold(1<<20))
+	if err != nil {
+		t.Fatalf("failed to create parser: %s", err)
+	}
+	defer p.Close(ctx)
+
+	initial := p.Stats().MemoryBytes
+
+	if _, err := p.Parse(ctx, []byte(strings.Repeat("a = [1, 2, {b: 3}]\n", 10_000))); err != nil {
+		t.Fatalf("failed to parse: %s", err)
+	}
+
+	stats := p.Stats()
+	if stats.Recycles != 1 || stats.PeakMemoryBytes <= 1<<20 {
+		t.Errorf("expected the instance to grow past the threshold and be recycled, got %+v", stats)
+	}
+
+	if stats.MemoryBytes != initial {
+		t.Errorf("expected the memory to be back to %d bytes, got %d", initial, stats.MemoryBytes)
+	}
+
+	assertParses(t, p)
+}
+
+func TestMemoryLimitPages(t *testing.T) {
+	ctx := context.Background()
+	p, err := parser.NewParser(ctx, parser.WithMemoryLimitPages(64))
+	if err != nil {
+		t.Fatalf("failed to create parser: %s", err)
+	}
+	defer p.Close(ctx)
+
+	sources := map[string][]byte{
+		"inside prism":   []byte(strings.Repeat("a = [1, 2, {b: 3}]\n", 100_000)),
+		"before parsing": make([]byte, 8<<20),
+	}
+
+	for name, source := range sources {
+		t.Run(name, func(t *testing.T) {
+			if _, err := p.Parse(ctx, source); !errors.Is(err, parser.ErrOutOfMemory) {
+				t.Errorf("expected ErrOutOfMemory, got %v", err)
+			}
+
+			assertParses(t, p)
+		})
+	}
+
+	if peak := p.Stats().PeakMemoryBytes; peak > 64*pageSize {
+		t.Errorf("expected memory to stay under the limit, peaked at %d bytes", peak)
+	}
+}
+
+func TestInvalidMemoryLimitPages(t *testing.T) {
+	for _, pages := range []uint32{0, 65537} {
+		if _, err := parser.NewParser(context.Background(), parser.WithMemoryLimitPages(pages)); err == nil {
+			t.Errorf("expected %d pages to be rejected", pages)
+		}
+	}
+}
@@ -3,9 +3,150 @@ package parser
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"math"
 )
 
+// CommandLineFlag is a ruby command line switch that changes how prism parses
+// the source.
+type CommandLineFlag int
+
+const (
+	// CommandLineA is the -a switch (split mode, used with -n or -p).
+	CommandLineA CommandLineFlag = 1 << iota
+	// CommandLineE is the -e switch (script passed on the command line).
+	CommandLineE
+	// CommandLineL is the -l switch (chomp every line read with -n or -p).
+	CommandLineL
+	// CommandLineN is the -n switch (wrap the script in a gets loop).
+	CommandLineN
+	// CommandLineP is the -p switch (wrap the script in a gets loop and print $_).
+	CommandLineP
+)
+
+const allCommandLineFlags = CommandLineA | CommandLineE | CommandLineL | CommandLineN | CommandLineP
+
+const (
+	versionLatest     = 0
+	versionCRuby3_3_0 = 1
+)
+
+// ParseOption configures a single call to Parser.Parse.
+type ParseOption func(*parseOptions) error
+
+// WithFilepath sets the name of the file being parsed. It is used as the value
+// of __FILE__ nodes.
+func WithFilepath(filepath string) ParseOption {
+	return func(o *parseOptions) error {
+		o.filepath = filepath
+		return nil
+	}
+}
+
+// WithStartLine sets the line number the source starts at. It defaults to 1.
+func WithStartLine(line int) ParseOption {
+	return func(o *parseOptions) error {
+		if line < math.MinInt32 || line > math.MaxInt32 {
+			return fmt.Errorf("start line out of range: %d", line)
+		}
+
+		o.line = line
+		return nil
+	}
+}
+
+// WithEncoding sets the encoding the source is assumed to be in, for example
+// "UTF-8" or "ASCII-8BIT". A magic comment in the source still takes precedence.
+func WithEncoding(encoding string) ParseOption {
+	return func(o *parseOptions) error {
+		if encoding == "" {
+			return errors.New("encoding must not be empty")
+		}
+
+		o.encoding = encoding
+		return nil
+	}
+}
+
+// WithFrozenStringLiteral marks every string literal in the source as frozen,
+// as if the file started with a frozen_string_literal: true magic comment.
+func WithFrozenStringLiteral(frozen bool) ParseOption {
+	return func(o *parseOptions) error {
+		o.frozenStringLiteral = frozen
+		return nil
+	}
+}
+
+// WithCommandLine sets the ruby command line switches the source is run with.
+func WithCommandLine(flags ...CommandLineFlag) ParseOption {
+	return func(o *parseOptions) error {
+		for _, flag := range flags {
+			if flag&^allCommandLineFlags != 0 {
+				return fmt.Errorf("invalid command line flag: %d", flag)
+			}
+
+			o.commandLine = append(o.commandLine, int(flag))
+		}
+
+		return nil
+	}
+}
+
+// WithVersion sets the ruby version whose syntax the source is parsed with.
+// Supported values are "latest" and "3.3.0".
+func WithVersion(version string) ParseOption {
+	return func(o *parseOptions) error {
+		switch version {
+		case "latest":
+			o.version = versionLatest
+		case "3.3.0":
+			o.version = versionCRuby3_3_0
+		default:
+			return fmt.Errorf("unsupported ruby version: %q", version)
+		}
+
+		return nil
+	}
+}
+
+// WithScopes sets the local variables that are in scope around the source,
+// from the outermost scope to the innermost one. Identifiers that name one of
+// these locals are parsed as local variable reads instead of method calls.
+func WithScopes(scopes ...[]string) ParseOption {
+	return func(o *parseOptions) error {
+		for _, scope := range scopes {
+			locals := make([][]byte, len(scope))
+			for i, local := range scope {
+				if local == "" {
+					return errors.New("scope local names must not be empty")
+				}
+
+				locals[i] = []byte(local)
+			}
+
+			o.scopes = append(o.scopes, locals)
+		}
+
+		return nil
+	}
+}
+
+// CheckParseOptions returns the error Parse and Lex fail with when given
+// opts, without parsing anything.
+func CheckParseOptions(opts ...ParseOption) error {
+	options, err := newParseOptionsFrom(opts)
+	if err != nil {
+		return fmt.Errorf("invalid parse option: %w", err)
+	}
+
+	if _, err := options.bytes(); err != nil {
+		return fmt.Errorf("failed to convert options into bytes: %w", err)
+	}
+
+	return nil
+}
+
 type parseOptions struct {
 	filepath            string
 	line                int
@@ -17,7 +158,21 @@ type parseOptions struct {
 }
 
 func newParseOptions() *parseOptions {
-	return &parseOptions{}
+	return &parseOptions{
+		line: 1,
+	}
+}
+
+func newParseOptionsFrom(opts []ParseOption) (*parseOptions, error) {
+	options := newParseOptions()
+
+	for _, opt := range opts {
+		if err := opt(options); err != nil {
+			return nil, err
+		}
+	}
+
+	return options, nil
 }
 
 func (o *parseOptions) bytes() ([]byte, error) {
@@ -0,0 +1,188 @@
+package parser_test
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+func parse(t *testing.T, source string, opts ...parser.ParseOption) *parser.ParseResult {
+	t.Helper()
+
+	ctx := context.Background()
+	p, err := parser.NewParser(ctx)
+	if err != nil {
+		t.Fatalf("failed to create parser: %s", err)
+	}
+	defer p.Close(ctx)
+
+	result, err := p.Parse(ctx, []byte(source), opts...)
+	if err != nil {
+		t.Fatalf("failed to parse %q: %s", source, err)
+	}
+
+	return result
+}
+
+func firstStatement(t *testing.T, result *parser.ParseResult) parser.Node {
+	t.Helper()
+
+	program, ok := result.Value.(*parser.ProgramNode)
+	if !ok {
+		t.Fatalf("expected a ProgramNode, got %T", result.Value)
+	}
+
+	if len(program.Statements.Body) == 0 {
+		t.Fatalf("expected at least one statement")
+	}
+
+	return program.Statements.Body[0]
+}
+
+func TestWithFilepath(t *testing.T) {
+	node, ok := firstStatement(t, parse(t, "__FILE__", parser.WithFilepath("app/models/user.rb"))).(*parser.SourceFileNode)
+	if !ok {
+		t.Fatalf("expected a SourceFileNode")
+	}
+
+	if node.Filepath != "app/models/user.rb" {
+		t.Errorf("expected filepath to be app/models/user.rb, got %q", node.Filepath)
+	}
+}
+
+func TestWithFrozenStringLiteral(t *testing.T) {
+	table := []struct {
+		frozen bool
+	}{
+		{frozen: false},
+		{frozen: true},
+	}
+
+	for _, v := range table {
+		node, ok := firstStatement(t, parse(t, "'foo'", parser.WithFrozenStringLiteral(v.frozen))).(*parser.StringNode)
+		if !ok {
+			t.Fatalf("expected a StringNode")
+		}
+
+		if node.IsFrozen() != v.frozen {
+			t.Errorf("expected IsFrozen() to be %t", v.frozen)
+		}
+	}
+}
+
+func TestWithScopes(t *testing.T) {
+	if _, ok := firstStatement(t, parse(t, "foo")).(*parser.CallNode); !ok {
+		t.Errorf("expected foo without scopes to be a CallNode")
+	}
+
+	if _, ok := firstStatement(t, parse(t, "foo", parser.WithScopes([]string{"foo"}))).(*parser.LocalVariableReadNode); !ok {
+		t.Errorf("expected foo with scopes to be a LocalVariableReadNode")
+	}
+}
+
+func TestWithEncoding(t *testing.T) {
+	source := "\"\xff\""
+
+	if result := parse(t, source); len(result.SynError) == 0 {
+		t.Errorf("expected an invalid multibyte error for UTF-8 source")
+	}
+
+	if result := parse(t, source, parser.WithEncoding("ASCII-8BIT")); len(result.SynError) != 0 {
+		t.Errorf("expected no errors for ASCII-8BIT source, got %d", len(result.SynError))
+	}
+}
+
+func TestWithVersion(t *testing.T) {
+	source := "foo { it }"
+
+	block := func(result *parser.ParseResult) parser.Node {
+		call := firstStatement(t, result).(*parser.CallNode)
+		return call.Block.(*parser.BlockNode).Body.(*parser.StatementsNode).Body[0]
+	}
+
+	if _, ok := block(parse(t, source, parser.WithVersion("3.3.0"))).(*parser.CallNode); !ok {
+		t.Errorf("expected it to be a CallNode on 3.3.0")
+	}
+
+	if _, ok := block(parse(t, source, parser.WithVersion("latest"))).(*parser.LocalVariableReadNode); !ok {
+		t.Errorf("expected it to be a LocalVariableReadNode on latest")
+	}
+}
+
+func TestWithCommandLine(t *testing.T) {
+	program := parse(t, "foo", parser.WithCommandLine(parser.CommandLineP)).Value.(*parser.ProgramNode)
+
+	if len(program.Statements.Body) != 2 {
+		t.Errorf("expected -p to append a print statement, got %d statements", len(program.Statements.Body))
+	}
+}
+
+func TestWithStartLine(t *testing.T) {
+	result := parse(t, "foo\n__LINE__\n", parser.WithStartLine(10))
+
+	program := result.Value.(*parser.ProgramNode)
+	if len(program.Statements.Body) != 2 {
+		t.Fatalf("expected 2 statements, got %d", len(program.Statements.Body))
+	}
+
+	line, ok := program.Statements.Body[1].(*parser.SourceLineNode)
+	if !ok {
+		t.Fatalf("expected a SourceLineNode, got %T", program.Statements.Body[1])
+	}
+
+	if result.Source.StartLine != 10 {
+		t.Errorf("expected the source to start at line 10, got %d", result.Source.StartLine)
+	}
+
+	if got := result.Source.StartLineOf(line.Location()); got != 11 {
+		t.Errorf("expected __LINE__ to be on line 11, got %d", got)
+	}
+}
+
+func TestInvalidParseOptions(t *testing.T) {
+	table := []struct {
+		name string
+		opt  parser.ParseOption
+	}{
+		{name: "version", opt: parser.WithVersion("1.8.7")},
+		{name: "encoding", opt: parser.WithEncoding("")},
+		{name: "command line", opt: parser.WithCommandLine(parser.CommandLineFlag(1 << 7))},
+		{name: "scopes", opt: parser.WithScopes([]string{""})},
+	}
+
+	// start lines past int32 only exist where int is 64 bits
+	if strconv.IntSize == 64 {
+		tooLarge := math.MaxInt32
+		tooLarge++
+		table = append(table, struct {
+			name string
+			opt  parser.ParseOption
+		}{name: "start line", opt: parser.WithStartLine(tooLarge)})
+	}
+
+	ctx := context.Background()
+	p, err := parser.NewParser(ctx)
+	if err != nil {
+		t.Fatalf("failed to create parser: %s", err)
+	}
+	defer p.Close(ctx)
+
+	for _, v := range table {
+		t.Run(v.name, func(t *testing.T) {
+			if _, err := p.Parse(ctx, []byte("foo"), v.opt); err == nil {
+				t.Errorf("expected an error")
+			}
+
+			if err := parser.CheckParseOptions(v.opt); err == nil {
+				t.Errorf("expected CheckParseOptions to return an error")
+			}
+		})
+	}
+
+	if err := parser.CheckParseOptions(parser.WithVersion("3.3.0"), parser.WithScopes([]string{"a"})); err != nil {
+		t.Errorf("expected valid options to pass, got %s", err)
+	}
+}
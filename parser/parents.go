@@ -0,0 +1,97 @@
+package parser
+
+type parentEntry struct {
+	parent Node
+	name   string
+	index  int
+}
+
+// ParentIndex maps every node of a tree to its parent and to the field of the
+// parent that holds it.
+type ParentIndex struct {
+	root    Node
+	parents map[Node]parentEntry
+}
+
+func NewParentIndex(result *ParseResult) *ParentIndex {
+	return NewParentIndexFromNode(result.Value)
+}
+
+func NewParentIndexFromNode(root Node) *ParentIndex {
+	index := &ParentIndex{
+		root:    root,
+		parents: make(map[Node]parentEntry),
+	}
+
+	if root == nil {
+		return index
+	}
+
+	stack := []Node{root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, child := range node.NamedChildren() {
+			if child.Node == nil {
+				continue
+			}
+
+			index.parents[child.Node] = parentEntry{
+				parent: node,
+				name:   child.Name,
+				index:  child.Index,
+			}
+			stack = append(stack, child.Node)
+		}
+	}
+
+	return index
+}
+
+func (idx *ParentIndex) Root() Node {
+	return idx.root
+}
+
+// Parent returns the parent of node, or nil for the root and for nodes that
+// are not part of the indexed tree.
+func (idx *ParentIndex) Parent(node Node) Node {
+	return idx.parents[node].parent
+}
+
+// Field returns the name of the parent field holding node and, for list
+// fields, the position of node in the list (-1 otherwise). ok is false for the
+// root and for nodes that are not part of the indexed tree.
+func (idx *ParentIndex) Field(node Node) (name string, index int, ok bool) {
+	entry, ok := idx.parents[node]
+	if !ok {
+		return "", -1, false
+	}
+
+	return entry.name, entry.index, true
+}
+
+// Ancestors returns the parents of node, starting with its direct parent and
+// ending with the root.
+func (idx *ParentIndex) Ancestors(node Node) []Node {
+	ancestors := make([]Node, 0)
+
+	for parent := idx.Parent(node); parent != nil; parent = idx.Parent(parent) {
+		ancestors = append(ancestors, parent)
+	}
+
+	return ancestors
+}
+
+// EnclosingOf returns the closest ancestor of node of type T, for example the
+// *DefNode a *CallNode is in.
+func EnclosingOf[T Node](idx *ParentIndex, node Node) (T, bool) {
+	for parent := idx.Parent(node); parent != nil; parent = idx.Parent(parent) {
+		if enclosing, ok := parent.(T); ok {
+			return enclosing, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
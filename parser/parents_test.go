@@ -0,0 +1,96 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+func findCall(t *testing.T, root parser.Node, name string) *parser.CallNode {
+	t.Helper()
+
+	var found *parser.CallNode
+	parser.Inspect(root, func(node parser.Node) bool {
+		if call, ok := node.(*parser.CallNode); ok && call.Name == name {
+			found = call
+		}
+
+		return found == nil
+	})
+
+	if found == nil {
+		t.Fatalf("expected to find a call to %s", name)
+	}
+
+	return found
+}
+
+func TestParentIndex(t *testing.T) {
+	source := `
+module Billing
+  class Invoice
+    def total
+      items.sum(&:price)
+    end
+  end
+end
+`
+	result := parse(t, source)
+	index := parser.NewParentIndex(result)
+
+	sum := findCall(t, result.Value, "sum")
+	items := findCall(t, result.Value, "items")
+
+	if index.Parent(items) != sum {
+		t.Errorf("expected items to be a child of sum")
+	}
+
+	if name, i, ok := index.Field(items); !ok || name != "receiver" || i != -1 {
+		t.Errorf("expected items to be the receiver, got %s %d %t", name, i, ok)
+	}
+
+	if index.Parent(result.Value) != nil {
+		t.Errorf("expected the root to have no parent")
+	}
+
+	def, ok := parser.EnclosingOf[*parser.DefNode](index, sum)
+	if !ok || def.Name != "total" {
+		t.Errorf("expected sum to be enclosed by def total")
+	}
+
+	class, ok := parser.EnclosingOf[*parser.ClassNode](index, sum)
+	if !ok || class.Name != "Invoice" {
+		t.Errorf("expected sum to be enclosed by class Invoice")
+	}
+
+	module, ok := parser.EnclosingOf[*parser.ModuleNode](index, class)
+	if !ok || module.Name != "Billing" {
+		t.Errorf("expected class Invoice to be enclosed by module Billing")
+	}
+
+	if _, ok := parser.EnclosingOf[*parser.BlockNode](index, sum); ok {
+		t.Errorf("expected sum not to be enclosed by a block")
+	}
+
+	ancestors := index.Ancestors(sum)
+	if ancestors[len(ancestors)-1] != result.Value {
+		t.Errorf("expected the last ancestor to be the root")
+	}
+
+	for i, ancestor := range ancestors[:len(ancestors)-1] {
+		if index.Parent(ancestor) != ancestors[i+1] {
+			t.Errorf("expected ancestors to be ordered from the closest parent")
+		}
+	}
+}
+
+func TestParentIndexListField(t *testing.T) {
+	result := parse(t, "a; b; c")
+	index := parser.NewParentIndex(result)
+
+	c := findCall(t, result.Value, "c")
+
+	if name, i, ok := index.Field(c); !ok || name != "body" || i != 2 {
+		t.Errorf("expected c to be body[2], got %s[%d]", name, i)
+	}
+}
@@ -1,25 +1,107 @@
 package parser
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/tjgurwara99/go-ruby-prism/wasm"
 )
 
+// ErrParseCanceled is returned when the context of a parse is done before
+// prism finishes. The error also wraps the context's error.
+var ErrParseCanceled = errors.New("parse canceled")
+
+// ErrOutOfMemory is returned when the source doesn't fit in wasm memory, see
+// WithMemoryLimitPages.
+var ErrOutOfMemory = errors.New("out of wasm memory")
+
+// Parser parses sources on a single prism instance. A parse whose context is
+// done is aborted right away, even in the middle of prism, and the instance
+// is replaced with a fresh one for the next parse. So is an instance whose
+// parse failed or whose memory grew past the recycle threshold.
 type Parser struct {
 	runtime *wasm.Runtime
+	// version of prism in the runtime
+	version [3]byte
+	// aborted is set while the instance needs replacing
+	aborted          bool
+	memoryLimit      uint64
+	recycleThreshold uint64
+	stats            ParserStats
+}
+
+// ParserStats reports the work and the wasm memory of a Parser.
+type ParserStats struct {
+	// Parses counts the calls into prism, Lex included.
+	Parses int
+	// MemoryBytes is the current size of the wasm memory.
+	MemoryBytes uint64
+	// PeakMemoryBytes is the largest size the wasm memory reached, across
+	// replaced instances.
+	PeakMemoryBytes uint64
+	// Recycles counts the instances replaced for growing past the recycle
+	// threshold.
+	Recycles int
+	// Aborts counts the instances replaced after a canceled or failed call.
+	Aborts int
 }
 
-func NewParser(ctx context.Context) (*Parser, error) {
-	runtime, err := wasm.NewRuntime(ctx)
+func NewParser(ctx context.Context, opts ...ParserOption) (*Parser, error) {
+	options, err := newParserOptionsFrom(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	runtime, err := wasm.NewRuntime(ctx, options.engine...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to instantiate wasm runtime: %w", err)
 	}
 
+	found, err := runtime.Version(ctx)
+	if err != nil {
+		_ = runtime.Close(ctx)
+		return nil, fmt.Errorf("failed to get the prism version: %w", err)
+	}
+
+	version, err := checkVersion(found)
+	if err != nil {
+		_ = runtime.Close(ctx)
+		return nil, err
+	}
+
+	return newParser(runtime, version, options), nil
+}
+
+func newParser(runtime *wasm.Runtime, version [3]byte, options *parserOptions) *Parser {
 	return &Parser{
-		runtime: runtime,
-	}, nil
+		runtime:          runtime,
+		version:          version,
+		memoryLimit:      options.memoryLimit,
+		recycleThreshold: options.recycleThreshold,
+	}
+}
+
+// Version returns the version of prism the parser runs.
+func (p *Parser) Version() string {
+	return formatVersion(p.version)
+}
+
+// usable reports whether the parser has a working instance. One that failed
+// to replace an aborted instance tries again on the next parse, which a pool
+// leaves to a fresh parser instead.
+func (p *Parser) usable() bool {
+	return !p.aborted
+}
+
+// Stats returns the counters of the parser and the size of its wasm memory.
+func (p *Parser) Stats() ParserStats {
+	stats := p.stats
+	stats.MemoryBytes = p.runtime.MemorySize()
+	stats.PeakMemoryBytes = max(stats.PeakMemoryBytes, stats.MemoryBytes)
+
+	return stats
 }
 
 func (p *Parser) Close(ctx context.Context) error {
@@ -30,7 +112,7 @@ func (p *Parser) Close(ctx context.Context) error {
 	return nil
 }
 
-func (p *Parser) Parse(ctx context.Context, source []byte) (result *ParseResult, err error) {
+func (p *Parser) Parse(ctx context.Context, source []byte, opts ...ParseOption) (result *ParseResult, err error) {
 	result = nil
 	err = nil
 
@@ -41,7 +123,12 @@ func (p *Parser) Parse(ctx context.Context, source []byte) (result *ParseResult,
 		}
 	}()
 
-	result, err = p.parseWithOptions(ctx, source, newParseOptions())
+	options, err := newParseOptionsFrom(opts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid parse option: %w", err)
+	}
+
+	result, err = p.parseWithOptions(ctx, source, options)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse with options: %w", err)
@@ -50,51 +137,186 @@ func (p *Parser) Parse(ctx context.Context, source []byte) (result *ParseResult,
 	return result, nil
 }
 
+// Lex runs the prism lexer over source and returns the tokens it produced
+// along with the comments and diagnostics found while parsing. Tokens are in
+// the order prism lexed them, so a heredoc body comes straight after the token
+// that opens the heredoc rather than after the rest of that line.
+func (p *Parser) Lex(ctx context.Context, source []byte, opts ...ParseOption) (result *LexResult, err error) {
+	result = nil
+	err = nil
+
+	defer func() {
+		if r := recover(); r != nil {
+			result = nil
+			err = fmt.Errorf("recovered from panic: %v", r)
+		}
+	}()
+
+	options, err := newParseOptionsFrom(opts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid parse option: %w", err)
+	}
+
+	serializedBytes, err := p.serializeWithOptions(ctx, source, options, p.runtime.SerializeLex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lex with options: %w", err)
+	}
+
+	result, err = deserializeTokens(serializedBytes, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize the tokens: %w", err)
+	}
+
+	return result, nil
+}
+
 func (p *Parser) parseWithOptions(ctx context.Context, source []byte, opts *parseOptions) (*ParseResult, error) {
-	sourcePtr, err := p.runtime.Calloc(ctx, 1, uint64(len(source)))
+	serializedBytes, err := p.serializeWithOptions(ctx, source, opts, p.runtime.SerializeParse)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := deserialize(serializedBytes, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize the result: %w", err)
+	}
+
+	return result, nil
+}
+
+type serializeFunc func(ctx context.Context, bufferPtr, sourcePtr, sourceLen, optPtr uint64) (uint64, error)
+
+func (p *Parser) serializeWithOptions(ctx context.Context, source []byte, opts *parseOptions, serialize serializeFunc) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrParseCanceled, err)
+	}
+
+	if p.aborted {
+		if err := p.runtime.Reinstantiate(ctx); err != nil {
+			return nil, fmt.Errorf("failed to replace the aborted instance: %w", err)
+		}
+
+		p.aborted = false
+	}
+
+	serializedBytes, err := p.serialize(ctx, source, opts, serialize)
+
+	p.stats.Parses++
+	p.stats.PeakMemoryBytes = max(p.stats.PeakMemoryBytes, p.runtime.MemorySize())
+
 	if err != nil {
-		return nil, fmt.Errorf("failed to allocate memory for source: %w", err)
+		// prism aborts when an allocation fails, which can only be told
+		// apart from other failures by the memory having reached its cap
+		outOfMemory := p.memoryLimit > 0 && p.runtime.MemorySize()+wasm.PageSize > p.memoryLimit
+
+		// an aborted call closes the instance, and one that failed inside
+		// prism may have left its heap inconsistent
+		p.stats.Aborts++
+		p.replace(ctx)
+
+		if ctxErr := ctx.Err(); ctxErr != nil && errors.Is(err, wasm.ErrAborted) {
+			return nil, fmt.Errorf("%w: %w", ErrParseCanceled, ctxErr)
+		}
+
+		if outOfMemory && !errors.Is(err, ErrOutOfMemory) {
+			return nil, fmt.Errorf("%w: %w", ErrOutOfMemory, err)
+		}
+
+		return nil, err
 	}
 
-	if !p.runtime.MemoryWrite(sourcePtr, source) {
-		return nil, fmt.Errorf("failed to write the source into memory: %w", err)
+	if p.recycleThreshold > 0 && p.runtime.MemorySize() > p.recycleThreshold {
+		p.stats.Recycles++
+		p.replace(ctx)
 	}
 
-	// put option into memory
+	return serializedBytes, nil
+}
+
+// replace swaps the instance for a fresh one now rather than on the next
+// parse, which shouldn't pay for this one.
+func (p *Parser) replace(ctx context.Context) {
+	p.aborted = p.runtime.Reinstantiate(context.WithoutCancel(ctx)) != nil
+}
+
+// serialize runs serialize over source in wasm memory and returns a copy of
+// its output. Everything allocated in wasm memory is freed before it returns,
+// whether it succeeds or not.
+func (p *Parser) serialize(ctx context.Context, source []byte, opts *parseOptions, serialize serializeFunc) (serialized []byte, err error) {
 	optBytes, err := opts.bytes()
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert options into bytes: %w", err)
 	}
 
-	optPtr, err := p.runtime.Calloc(ctx, 1, uint64(len(optBytes)))
+	var frees []func(ctx context.Context) error
+	defer func() {
+		// an aborted instance is replaced as a whole
+		if errors.Is(err, wasm.ErrAborted) {
+			return
+		}
+
+		// free even if the caller's context is done
+		ctx := context.WithoutCancel(ctx)
+		for i := len(frees) - 1; i >= 0; i-- {
+			if freeErr := frees[i](ctx); freeErr != nil && err == nil {
+				serialized, err = nil, fmt.Errorf("failed to free wasm memory: %w", freeErr)
+			}
+		}
+	}()
+
+	alloc := func(size uint64, data []byte) (uint64, error) {
+		ptr, err := p.runtime.Calloc(ctx, 1, size)
+		if err != nil {
+			return 0, err
+		}
+		if ptr == 0 {
+			return 0, fmt.Errorf("%w: %d bytes", ErrOutOfMemory, size)
+		}
+
+		frees = append(frees, func(ctx context.Context) error {
+			return p.runtime.Free(ctx, ptr)
+		})
+
+		if !p.runtime.MemoryWrite(ptr, data) {
+			return 0, fmt.Errorf("write of %d bytes at %d out of range", len(data), ptr)
+		}
+
+		return ptr, nil
+	}
+
+	sourcePtr, err := alloc(uint64(len(source)), source)
 	if err != nil {
-		return nil, fmt.Errorf("failed to allocate memory for options: %w", err)
+		return nil, fmt.Errorf("failed to put the source into memory: %w", err)
 	}
 
-	if !p.runtime.MemoryWrite(optPtr, optBytes) {
-		return nil, fmt.Errorf("failed to write the options into memory: %w", err)
+	optPtr, err := alloc(uint64(len(optBytes)), optBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to put the options into memory: %w", err)
 	}
 
-	// call the serialize parse function
 	bufferSizeOf, err := p.runtime.BufferSizeOf(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get the buffer size: %w", err)
 	}
 
-	bufferPtr, err := p.runtime.Calloc(ctx, bufferSizeOf, 1)
+	bufferPtr, err := alloc(bufferSizeOf, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get the buffer ptr: %w", err)
+		return nil, fmt.Errorf("failed to allocate the buffer: %w", err)
 	}
 
 	if err := p.runtime.BufferInit(ctx, bufferPtr); err != nil {
 		return nil, fmt.Errorf("failed to init the buffer: %w", err)
 	}
 
-	if _, err := p.runtime.SerializeParse(ctx, bufferPtr, sourcePtr, uint64(len(source)), optPtr); err != nil {
-		return nil, fmt.Errorf("failed to call the parse function: %w", err)
+	// frees the buffer's content, before the buffer itself
+	frees = append(frees, func(ctx context.Context) error {
+		return p.runtime.BufferFree(ctx, bufferPtr)
+	})
+
+	if _, err := serialize(ctx, bufferPtr, sourcePtr, uint64(len(source)), optPtr); err != nil {
+		return nil, fmt.Errorf("failed to call the serialize function: %w", err)
 	}
 
-	// read result from memory
 	bufferValue, err := p.runtime.BufferValue(ctx, bufferPtr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get the buffer value: %w", err)
@@ -105,32 +327,11 @@ func (p *Parser) parseWithOptions(ctx context.Context, source []byte, opts *pars
 		return nil, fmt.Errorf("failed to get the buffer length: %w", err)
 	}
 
-	serializedBytes, ok := p.runtime.MemoryRead(bufferValue, bufferLen)
+	view, ok := p.runtime.MemoryRead(bufferValue, bufferLen)
 	if !ok {
-		return nil, fmt.Errorf("failed to read the buffer content from memory: %w", err)
+		return nil, fmt.Errorf("failed to read the buffer content from memory: %d bytes at %d out of range", bufferLen, bufferValue)
 	}
 
-	// free memory
-	if err := p.runtime.BufferFree(ctx, bufferPtr); err != nil {
-		return nil, fmt.Errorf("failed to free memory for buffer ptr: %w", err)
-	}
-
-	if err := p.runtime.Free(ctx, sourcePtr); err != nil {
-		return nil, fmt.Errorf("failed to free memory for source ptr: %w", err)
-	}
-
-	if err := p.runtime.Free(ctx, bufferPtr); err != nil {
-		return nil, fmt.Errorf("failed to free memory for buffer ptr: %w", err)
-	}
-
-	if err := p.runtime.Free(ctx, optPtr); err != nil {
-		return nil, fmt.Errorf("failed to free memory for option ptr: %w", err)
-	}
-
-	result, err := deserialize(serializedBytes, source)
-	if err != nil {
-		return nil, fmt.Errorf("failed to deserialize the result: %w", err)
-	}
-
-	return result, nil
+	// the view is only valid until the buffer is freed
+	return bytes.Clone(view), nil
 }
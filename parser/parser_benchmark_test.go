@@ -7,7 +7,11 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/tjgurwara99/go-ruby-prism/parser"
@@ -77,3 +81,99 @@ func Benchmark_Parser(b *testing.B) {
 		})
 	}
 }
+
+func benchmarkSources() [][]byte {
+	sources := make([][]byte, 256)
+	for i := range sources {
+		sources[i] = []byte(fmt.Sprintf(`
+class Widget%d < Base
+  attr_reader :name, :size
+
+  def initialize(name, size = %d)
+    @name = name
+    @size = size
+  end
+
+  def to_s
+    "#{name} (#{size})"
+  end
+end
+`, i, i))
+	}
+
+	return sources
+}
+
+// Benchmark_NewParser compares the first parser of a process, which compiles
+// prism, with the later ones, which only instantiate it.
+func Benchmark_NewParser(b *testing.B) {
+	ctx := context.Background()
+
+	b.Run("cold", func(b *testing.B) {
+		for i := range b.N {
+			// an empty cache directory of its own gets a new compiled module
+			dir := filepath.Join(b.TempDir(), strconv.Itoa(i))
+			p, err := parser.NewParser(ctx, parser.WithCompilationCacheDir(dir))
+			if err != nil {
+				b.Fatalf("failed to create parser: %s", err)
+			}
+			p.Close(ctx)
+		}
+	})
+
+	b.Run("warm", func(b *testing.B) {
+		for range b.N {
+			p, err := parser.NewParser(ctx)
+			if err != nil {
+				b.Fatalf("failed to create parser: %s", err)
+			}
+			p.Close(ctx)
+		}
+	})
+}
+
+func Benchmark_ParserThroughput(b *testing.B) {
+	ctx := context.Background()
+	sources := benchmarkSources()
+
+	b.Run("single", func(b *testing.B) {
+		p, _ := parser.NewParser(ctx)
+		defer p.Close(ctx)
+
+		var mu sync.Mutex
+		var i atomic.Int64
+
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				source := sources[i.Add(1)%int64(len(sources))]
+
+				mu.Lock()
+				_, err := p.Parse(ctx, source)
+				mu.Unlock()
+
+				if err != nil {
+					b.Error(err)
+				}
+			}
+		})
+	})
+
+	b.Run("pool", func(b *testing.B) {
+		pool, _ := parser.NewParserPool(ctx, runtime.GOMAXPROCS(0))
+		defer pool.Close(ctx)
+
+		var i atomic.Int64
+
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				source := sources[i.Add(1)%int64(len(sources))]
+
+				if _, err := pool.Parse(ctx, source); err != nil {
+					b.Error(err)
+				}
+			}
+		})
+	})
+}
@@ -0,0 +1,91 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/tjgurwara99/go-ruby-prism/wasm"
+)
+
+// defaultRecycleThreshold is the memory size past which an instance is
+// replaced by default. Most sources need a few megabytes.
+const defaultRecycleThreshold = 256 << 20
+
+// ParserOption configures NewParser and NewParserPool. Open parsers and pools
+// with the same memory limit, compilation cache directory and wasm binary
+// share one compiled prism module, which is freed once the last of them is
+// closed. The module of the default options is kept for the life of the
+// process.
+type ParserOption func(*parserOptions) error
+
+type parserOptions struct {
+	engine           []wasm.EngineOption
+	memoryLimit      uint64
+	recycleThreshold uint64
+}
+
+// WithMemoryLimitPages caps the wasm memory of every prism instance at pages
+// of 64KiB. A parse needing more fails with ErrOutOfMemory, and the instance
+// is replaced for the next one. By default an instance may grow to 4GiB.
+func WithMemoryLimitPages(pages uint32) ParserOption {
+	return func(o *parserOptions) error {
+		o.engine = append(o.engine, wasm.WithMemoryLimitPages(pages))
+		o.memoryLimit = uint64(pages) * wasm.PageSize
+		return nil
+	}
+}
+
+// WithCompilationCacheDir keeps prism compiled to machine code in dir, so that
+// the next parser with these options, in this process or another one, skips
+// compiling it.
+func WithCompilationCacheDir(dir string) ParserOption {
+	return func(o *parserOptions) error {
+		o.engine = append(o.engine, wasm.WithCompilationCacheDir(dir))
+		return nil
+	}
+}
+
+// WithWasmBinary runs prism from binary instead of the build embedded in the
+// wasm package. NewParser and NewParserPool fail with a *VersionMismatchError
+// if its prism version is not one of SupportedPrismVersions, which for now is
+// only the embedded 0.24.0: reading the output of another prism version takes
+// nodes generated for it in this package. So binary can be another build of
+// 0.24.0, a debug build for instance, but not a newer prism. Its compiled code
+// is freed once the last parser or pool running it is closed.
+func WithWasmBinary(binary []byte) ParserOption {
+	return func(o *parserOptions) error {
+		o.engine = append(o.engine, wasm.WithWasmBinary(binary))
+		return nil
+	}
+}
+
+// WithWasmFile runs prism from the wasm binary in the file at path, like
+// WithWasmBinary.
+func WithWasmFile(path string) ParserOption {
+	return func(o *parserOptions) error {
+		o.engine = append(o.engine, wasm.WithWasmFile(path))
+		return nil
+	}
+}
+
+// WithRecycleThreshold replaces a prism instance after a parse that left its
+// wasm memory larger than bytes. Linear memory never shrinks, so this is what
+// returns the memory used by an unusually large source. It defaults to 256MiB,
+// 0 disables it.
+func WithRecycleThreshold(bytes uint64) ParserOption {
+	return func(o *parserOptions) error {
+		o.recycleThreshold = bytes
+		return nil
+	}
+}
+
+func newParserOptionsFrom(opts []ParserOption) (*parserOptions, error) {
+	options := &parserOptions{recycleThreshold: defaultRecycleThreshold}
+
+	for _, opt := range opts {
+		if err := opt(options); err != nil {
+			return nil, fmt.Errorf("invalid parser option: %w", err)
+		}
+	}
+
+	return options, nil
+}
@@ -0,0 +1,209 @@
+package parser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/tjgurwara99/go-ruby-prism/wasm"
+)
+
+var ErrPoolClosed = errors.New("parser pool is closed")
+
+// ParserPool parses sources concurrently. It hands out up to size instances of
+// the compiled prism module, each with its own linear memory, to concurrent
+// callers. Instances are created on demand and reused.
+type ParserPool struct {
+	engine  *wasm.Engine
+	version [3]byte
+	options *parserOptions
+	tokens  chan struct{}
+	// done is closed by Close, to wake up callers waiting for an instance
+	done chan struct{}
+
+	mu   sync.Mutex
+	idle []*Parser
+	// active counts the instances handed out and not yet released
+	active int
+	closed bool
+	// drained is closed once the pool is closed and no instance is active
+	drained chan struct{}
+}
+
+func NewParserPool(ctx context.Context, size int, opts ...ParserOption) (*ParserPool, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("invalid pool size: %d", size)
+	}
+
+	options, err := newParserOptionsFrom(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	engine, err := wasm.SharedEngine(ctx, options.engine...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create wasm engine: %w", err)
+	}
+
+	found, err := engine.Version(ctx)
+	if err != nil {
+		_ = engine.Close(ctx)
+		return nil, fmt.Errorf("failed to get the prism version: %w", err)
+	}
+
+	version, err := checkVersion(found)
+	if err != nil {
+		_ = engine.Close(ctx)
+		return nil, err
+	}
+
+	return &ParserPool{
+		engine:  engine,
+		version: version,
+		options: options,
+		tokens:  make(chan struct{}, size),
+		done:    make(chan struct{}),
+		drained: make(chan struct{}),
+	}, nil
+}
+
+// Parse parses source on one of the pooled instances, waiting for an instance
+// to become available if all of them are busy.
+func (pp *ParserPool) Parse(ctx context.Context, source []byte, opts ...ParseOption) (*ParseResult, error) {
+	p, err := pp.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := p.Parse(ctx, source, opts...)
+	pp.release(ctx, p, p.usable())
+
+	return result, err
+}
+
+// Lex lexes source on one of the pooled instances, like Parse.
+func (pp *ParserPool) Lex(ctx context.Context, source []byte, opts ...ParseOption) (*LexResult, error) {
+	p, err := pp.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := p.Lex(ctx, source, opts...)
+	pp.release(ctx, p, p.usable())
+
+	return result, err
+}
+
+func (pp *ParserPool) acquire(ctx context.Context) (*Parser, error) {
+	select {
+	case pp.tokens <- struct{}{}:
+	case <-pp.done:
+		return nil, ErrPoolClosed
+	case <-ctx.Done():
+		return nil, fmt.Errorf("failed to acquire a parser: %w: %w", ErrParseCanceled, ctx.Err())
+	}
+
+	pp.mu.Lock()
+	if pp.closed {
+		pp.mu.Unlock()
+		<-pp.tokens
+		return nil, ErrPoolClosed
+	}
+
+	pp.active++
+	if n := len(pp.idle); n > 0 {
+		p := pp.idle[n-1]
+		pp.idle = pp.idle[:n-1]
+		pp.mu.Unlock()
+		return p, nil
+	}
+	pp.mu.Unlock()
+
+	runtime, err := pp.engine.Instantiate(ctx)
+	if err != nil {
+		pp.release(ctx, nil, false)
+		return nil, fmt.Errorf("failed to instantiate wasm runtime: %w", err)
+	}
+
+	return newParser(runtime, pp.version, pp.options), nil
+}
+
+// release returns p to the pool, or closes it if reuse is unset or the pool
+// is closed. A parser replaces its instance after a failed call into prism,
+// so only one that couldn't needs discarding.
+func (pp *ParserPool) release(ctx context.Context, p *Parser, reuse bool) {
+	pp.mu.Lock()
+	if reuse && !pp.closed {
+		pp.idle = append(pp.idle, p)
+		p = nil
+	}
+
+	pp.active--
+	last := pp.closed && pp.active == 0
+	pp.mu.Unlock()
+
+	if p != nil {
+		_ = p.Close(ctx)
+	}
+
+	if last {
+		_ = pp.closeEngine(ctx)
+	}
+
+	<-pp.tokens
+}
+
+// Close closes the idle instances and waits for in-flight parses to finish,
+// closing their instances as they do. The compiled module is then released
+// once no instance uses it anymore. If ctx is done first, Close returns its
+// error and the in-flight parses still close their instances and release the
+// module when they finish. Parse calls made after Close return ErrPoolClosed.
+func (pp *ParserPool) Close(ctx context.Context) error {
+	pp.mu.Lock()
+	if pp.closed {
+		pp.mu.Unlock()
+		return nil
+	}
+
+	pp.closed = true
+	close(pp.done)
+
+	idle := pp.idle
+	pp.idle = nil
+	last := pp.active == 0
+	pp.mu.Unlock()
+
+	var errs []error
+	for _, p := range idle {
+		if err := p.Close(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if last {
+		if err := pp.closeEngine(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	select {
+	case <-pp.drained:
+	case <-ctx.Done():
+		errs = append(errs, fmt.Errorf("failed to wait for in-flight parses: %w", ctx.Err()))
+	}
+
+	return errors.Join(errs...)
+}
+
+// closeEngine is called once, by whichever of Close and release sees the pool
+// closed with no active instance.
+func (pp *ParserPool) closeEngine(ctx context.Context) error {
+	defer close(pp.drained)
+
+	if err := pp.engine.Close(context.WithoutCancel(ctx)); err != nil {
+		return fmt.Errorf("failed to close the wasm engine: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package parser
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParserPoolKeepsRecoveredParsers(t *testing.T) {
+	ctx := context.Background()
+	pool, err := NewParserPool(ctx, 1)
+	if err != nil {
+		t.Fatalf("failed to create pool: %s", err)
+	}
+	defer pool.Close(ctx)
+
+	canceled, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	_, err = pool.Parse(canceled, []byte(strings.Repeat("a = [1, 2, {b: 3}]\n", 200_000)))
+	if !errors.Is(err, ErrParseCanceled) {
+		t.Fatalf("expected ErrParseCanceled, got %v", err)
+	}
+
+	// a syntax error or an invalid option doesn't harm the instance either
+	if _, err := pool.Parse(ctx, []byte("foo"), WithVersion("1.8.7")); err == nil {
+		t.Fatalf("expected an invalid option to fail")
+	}
+
+	pool.mu.Lock()
+	idle := pool.idle
+	pool.mu.Unlock()
+
+	if len(idle) != 1 {
+		t.Fatalf("expected the parser to be kept, got %d idle parsers", len(idle))
+	}
+
+	if stats := idle[0].Stats(); stats.Aborts != 1 {
+		t.Errorf("expected the parser that recovered from the abort, got %+v", stats)
+	}
+
+	if _, err := pool.Parse(ctx, []byte("foo(1)")); err != nil {
+		t.Errorf("expected the kept parser to parse, got %s", err)
+	}
+}
@@ -0,0 +1,149 @@
+package parser_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+func TestParserPoolConcurrentParse(t *testing.T) {
+	ctx := context.Background()
+	pool, err := parser.NewParserPool(ctx, 4)
+	if err != nil {
+		t.Fatalf("failed to create pool: %s", err)
+	}
+	defer pool.Close(ctx)
+
+	var wg sync.WaitGroup
+	for i := range 64 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			name := fmt.Sprintf("method_%d", i)
+			source := fmt.Sprintf("def %s(a, b)\n  a + b * %d\nend\n", name, i)
+
+			result, err := pool.Parse(ctx, []byte(source))
+			if err != nil {
+				t.Errorf("failed to parse: %s", err)
+				return
+			}
+
+			def, ok := result.Value.(*parser.ProgramNode).Statements.Body[0].(*parser.DefNode)
+			if !ok {
+				t.Errorf("expected a DefNode")
+				return
+			}
+
+			if def.Name != name {
+				t.Errorf("expected def name %s, got %s", name, def.Name)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestParserPoolAcquireHonoursContext(t *testing.T) {
+	ctx := context.Background()
+	pool, err := parser.NewParserPool(ctx, 1)
+	if err != nil {
+		t.Fatalf("failed to create pool: %s", err)
+	}
+	defer pool.Close(ctx)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	// with a single slot most callers have to wait, and a canceled context
+	// must make them give up instead of blocking
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := pool.Parse(canceled, []byte("foo"))
+			if !errors.Is(err, parser.ErrParseCanceled) || !errors.Is(err, context.Canceled) {
+				t.Errorf("expected ErrParseCanceled wrapping context.Canceled, got %v", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestParserPoolClose(t *testing.T) {
+	ctx := context.Background()
+	pool, err := parser.NewParserPool(ctx, 2)
+	if err != nil {
+		t.Fatalf("failed to create pool: %s", err)
+	}
+
+	if _, err := pool.Parse(ctx, []byte("1 + 1")); err != nil {
+		t.Fatalf("failed to parse: %s", err)
+	}
+
+	closeCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	if err := pool.Close(closeCtx); err != nil {
+		t.Fatalf("failed to close pool: %s", err)
+	}
+
+	if _, err := pool.Parse(ctx, []byte("1 + 1")); !errors.Is(err, parser.ErrPoolClosed) {
+		t.Errorf("expected ErrPoolClosed, got %v", err)
+	}
+}
+
+func TestParserPoolCloseWhileParsing(t *testing.T) {
+	ctx := context.Background()
+	pool, err := parser.NewParserPool(ctx, 1)
+	if err != nil {
+		t.Fatalf("failed to create pool: %s", err)
+	}
+
+	// takes prism seconds, long enough to still be running when Close gives up
+	source := []byte(strings.Repeat("a = [1, 2, {b: 3}]\n", 200_000))
+
+	parsed := make(chan error)
+	go func() {
+		_, err := pool.Parse(ctx, source)
+		parsed <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	closeCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	closeErr := pool.Close(closeCtx)
+	parseErr := <-parsed
+
+	switch {
+	case errors.Is(closeErr, context.DeadlineExceeded) && parseErr == nil:
+		// Close gave up waiting and the parse finished anyway
+	case closeErr == nil && errors.Is(parseErr, parser.ErrPoolClosed):
+		// the parse hadn't started yet
+	default:
+		t.Errorf("unexpected close error %v and parse error %v", closeErr, parseErr)
+	}
+
+	if _, err := pool.Parse(ctx, []byte("1 + 1")); !errors.Is(err, parser.ErrPoolClosed) {
+		t.Errorf("expected ErrPoolClosed, got %v", err)
+	}
+
+	if err := pool.Close(ctx); err != nil {
+		t.Errorf("expected closing again to succeed, got %s", err)
+	}
+}
+
+func TestNewParserPoolInvalidSize(t *testing.T) {
+	if _, err := parser.NewParserPool(context.Background(), 0); err == nil {
+		t.Errorf("expected an error for a zero sized pool")
+	}
+}
@@ -1,6 +1,9 @@
 package parser
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 type ParseResult struct {
 	Value         Node
@@ -9,6 +12,7 @@ type ParseResult struct {
 	DataLocation  *Location
 	SynError      []*SyntaxError
 	SynWarnings   []*SyntaxWarning
+	Source        *Source
 }
 
 func NewParseResult(
@@ -18,6 +22,7 @@ func NewParseResult(
 	dataLocation *Location,
 	synError []*SyntaxError,
 	synWarnings []*SyntaxWarning,
+	source *Source,
 ) *ParseResult {
 	return &ParseResult{
 		Value:         value,
@@ -26,16 +31,81 @@ func NewParseResult(
 		DataLocation:  dataLocation,
 		SynError:      synError,
 		SynWarnings:   synWarnings,
+		Source:        source,
 	}
 }
 
+// MarshalJSON encodes the result without its source, see
+// ParseResultWithSource.
 func (p *ParseResult) MarshalJSON() ([]byte, error) {
-	return json.Marshal(map[string]interface{}{
+	return json.Marshal(p.jsonFields())
+}
+
+func (p *ParseResult) jsonFields() map[string]interface{} {
+	return map[string]interface{}{
 		"value":         p.Value,
 		"comments":      p.Comments,
 		"magicComments": p.MagicComments,
 		"dataLocation":  p.DataLocation,
 		"synError":      p.SynError,
 		"synWarnings":   p.SynWarnings,
-	})
+	}
+}
+
+// ParseResultWithSource encodes a result to JSON along with its source, which
+// the lines and columns of locations are computed from. Decoding it gives back
+// a result equal to the encoded one.
+type ParseResultWithSource struct {
+	*ParseResult
+}
+
+func (p ParseResultWithSource) MarshalJSON() ([]byte, error) {
+	fields := p.jsonFields()
+	fields["source"] = p.Source
+
+	return json.Marshal(fields)
+}
+
+func (p *ParseResultWithSource) UnmarshalJSON(data []byte) error {
+	p.ParseResult = &ParseResult{}
+	return p.ParseResult.UnmarshalJSON(data)
+}
+
+// UnmarshalJSON decodes a result encoded by MarshalJSON, or along with its
+// source by ParseResultWithSource, building the tree with the node types
+// named in it. Source is nil if the JSON doesn't hold it, in which case the
+// methods needing it leave out lines and columns. Like any Go string going
+// through JSON, string fields that aren't valid UTF-8 come back with
+// U+FFFD in place of the invalid bytes.
+func (p *ParseResult) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		Value         json.RawMessage  `json:"value"`
+		Comments      []*Comment       `json:"comments"`
+		MagicComments []*MagicComment  `json:"magicComments"`
+		DataLocation  *Location        `json:"dataLocation"`
+		SynError      []*SyntaxError   `json:"synError"`
+		SynWarnings   []*SyntaxWarning `json:"synWarnings"`
+		Source        *Source          `json:"source"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	value, err := UnmarshalNode(fields.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal value: %w", err)
+	}
+
+	*p = ParseResult{
+		Value:         value,
+		Comments:      fields.Comments,
+		MagicComments: fields.MagicComments,
+		DataLocation:  fields.DataLocation,
+		SynError:      fields.SynError,
+		SynWarnings:   fields.SynWarnings,
+		Source:        fields.Source,
+	}
+
+	return nil
 }
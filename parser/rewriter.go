@@ -0,0 +1,317 @@
+package parser
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var ErrConflictingEdits = errors.New("conflicting edits")
+
+type EditKind int
+
+const (
+	EditReplace EditKind = iota
+	EditInsertBefore
+	EditInsertAfter
+	EditRemove
+)
+
+func (k EditKind) String() string {
+	switch k {
+	case EditReplace:
+		return "replace"
+	case EditInsertBefore:
+		return "insert before"
+	case EditInsertAfter:
+		return "insert after"
+	case EditRemove:
+		return "remove"
+	}
+
+	return "unknown"
+}
+
+type Edit struct {
+	Kind EditKind
+	Loc  *Location
+	Text string
+}
+
+func (e *Edit) String() string {
+	return fmt.Sprintf("%s %d...%d %q", e.Kind, e.Loc.StartOffset, e.Loc.EndOffset(), e.Text)
+}
+
+// ConflictError is returned when an edit overlaps an edit that was already
+// recorded. It wraps ErrConflictingEdits.
+type ConflictError struct {
+	Edit     *Edit
+	Existing *Edit
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s: %s conflicts with %s", ErrConflictingEdits, e.Edit, e.Existing)
+}
+
+func (e *ConflictError) Unwrap() error {
+	return ErrConflictingEdits
+}
+
+// Rewriter collects edits to the source covered by locations and applies them
+// in one pass. Replacements and removals may not overlap each other and
+// insertions may not fall strictly inside a replaced or removed range. Edits
+// are checked as they are recorded, so a conflicting edit is rejected and the
+// rewriter stays usable.
+//
+// Insertions at the same offset are ordered like nested wrapping: later
+// InsertBefore calls end up further left and later InsertAfter calls further
+// right. Text inserted after a location comes before text inserted before a
+// location starting at the same offset.
+type Rewriter struct {
+	source  []byte
+	errors  []*SyntaxError
+	ranges  []*Edit
+	inserts []*Edit
+	edits   []*Edit
+}
+
+// NewRewriter creates a rewriter for the source of result. Syntax errors of
+// result are not reported as new by ApplyAndParse. A result without a source
+// gets a rewriter of an empty source, which rejects edits of its locations.
+func NewRewriter(result *ParseResult) *Rewriter {
+	var source []byte
+	if result.Source != nil {
+		source = result.Source.Source
+	}
+
+	r := NewRewriterFromSource(source)
+	r.errors = result.SynError
+
+	return r
+}
+
+func NewRewriterFromSource(source []byte) *Rewriter {
+	return &Rewriter{
+		source: source,
+	}
+}
+
+func (r *Rewriter) Replace(loc *Location, text string) error {
+	if loc != nil && loc.Length == 0 {
+		return r.InsertBefore(loc, text)
+	}
+
+	return r.addRange(&Edit{Kind: EditReplace, Loc: loc, Text: text})
+}
+
+func (r *Rewriter) Remove(loc *Location) error {
+	if loc != nil && loc.Length == 0 {
+		return r.check(loc)
+	}
+
+	return r.addRange(&Edit{Kind: EditRemove, Loc: loc})
+}
+
+func (r *Rewriter) InsertBefore(loc *Location, text string) error {
+	return r.addInsert(&Edit{Kind: EditInsertBefore, Loc: loc, Text: text})
+}
+
+func (r *Rewriter) InsertAfter(loc *Location, text string) error {
+	return r.addInsert(&Edit{Kind: EditInsertAfter, Loc: loc, Text: text})
+}
+
+// Wrap inserts before in front of loc and after behind it.
+func (r *Rewriter) Wrap(loc *Location, before string, after string) error {
+	if err := r.InsertBefore(loc, before); err != nil {
+		return err
+	}
+
+	return r.InsertAfter(loc, after)
+}
+
+// Edits returns the recorded edits in the order they were recorded.
+func (r *Rewriter) Edits() []*Edit {
+	return append([]*Edit{}, r.edits...)
+}
+
+func (r *Rewriter) check(loc *Location) error {
+	if loc == nil {
+		return fmt.Errorf("invalid edit: nil location")
+	}
+
+	if loc.EndOffset() > uint32(len(r.source)) || loc.EndOffset() < loc.StartOffset {
+		return fmt.Errorf("invalid edit: location %d...%d is outside of the source", loc.StartOffset, loc.EndOffset())
+	}
+
+	return nil
+}
+
+func (r *Rewriter) addRange(edit *Edit) error {
+	if err := r.check(edit.Loc); err != nil {
+		return err
+	}
+
+	start, end := edit.Loc.StartOffset, edit.Loc.EndOffset()
+
+	for _, existing := range r.ranges {
+		if start >= existing.Loc.EndOffset() || existing.Loc.StartOffset >= end {
+			continue
+		}
+
+		// recording the same edit twice is harmless
+		if start == existing.Loc.StartOffset && end == existing.Loc.EndOffset() && edit.Kind == existing.Kind && edit.Text == existing.Text {
+			return nil
+		}
+
+		return &ConflictError{Edit: edit, Existing: existing}
+	}
+
+	for _, existing := range r.inserts {
+		if offset := insertOffset(existing); start < offset && offset < end {
+			return &ConflictError{Edit: edit, Existing: existing}
+		}
+	}
+
+	r.ranges = append(r.ranges, edit)
+	r.edits = append(r.edits, edit)
+
+	return nil
+}
+
+func (r *Rewriter) addInsert(edit *Edit) error {
+	if err := r.check(edit.Loc); err != nil {
+		return err
+	}
+
+	offset := insertOffset(edit)
+	for _, existing := range r.ranges {
+		if existing.Loc.StartOffset < offset && offset < existing.Loc.EndOffset() {
+			return &ConflictError{Edit: edit, Existing: existing}
+		}
+	}
+
+	r.inserts = append(r.inserts, edit)
+	r.edits = append(r.edits, edit)
+
+	return nil
+}
+
+func insertOffset(edit *Edit) uint32 {
+	if edit.Kind == EditInsertAfter {
+		return edit.Loc.EndOffset()
+	}
+
+	return edit.Loc.StartOffset
+}
+
+// Apply returns the source with all edits applied. The original source is not
+// modified.
+func (r *Rewriter) Apply() []byte {
+	ranges := append([]*Edit{}, r.ranges...)
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].Loc.StartOffset < ranges[j].Loc.StartOffset
+	})
+
+	afters := make(map[uint32][]string)
+	befores := make(map[uint32][]string)
+	for _, edit := range r.inserts {
+		offset := insertOffset(edit)
+		if edit.Kind == EditInsertAfter {
+			afters[offset] = append(afters[offset], edit.Text)
+		} else {
+			befores[offset] = append([]string{edit.Text}, befores[offset]...)
+		}
+	}
+
+	offsets := make([]uint32, 0, len(afters)+len(befores))
+	for offset := range afters {
+		offsets = append(offsets, offset)
+	}
+	for offset := range befores {
+		if _, ok := afters[offset]; !ok {
+			offsets = append(offsets, offset)
+		}
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+
+	var out bytes.Buffer
+	out.Grow(len(r.source))
+
+	cursor := uint32(0)
+	for len(offsets) > 0 || len(ranges) > 0 {
+		next := uint32(len(r.source))
+		if len(offsets) > 0 {
+			next = min(next, offsets[0])
+		}
+		if len(ranges) > 0 {
+			next = min(next, ranges[0].Loc.StartOffset)
+		}
+
+		out.Write(r.source[cursor:next])
+		cursor = next
+
+		if len(offsets) > 0 && offsets[0] == cursor {
+			for _, text := range afters[cursor] {
+				out.WriteString(text)
+			}
+			for _, text := range befores[cursor] {
+				out.WriteString(text)
+			}
+			offsets = offsets[1:]
+		}
+
+		// insertions strictly inside a range are rejected when they are
+		// recorded, so skipping to its end loses nothing
+		if len(ranges) > 0 && ranges[0].Loc.StartOffset == cursor {
+			out.WriteString(ranges[0].Text)
+			cursor = ranges[0].Loc.EndOffset()
+			ranges = ranges[1:]
+		}
+	}
+
+	out.Write(r.source[cursor:])
+
+	return out.Bytes()
+}
+
+type RewriteResult struct {
+	Source []byte
+	Result *ParseResult
+	// NewErrors are the syntax errors of the rewritten source that the
+	// original source did not have.
+	NewErrors []*SyntaxError
+}
+
+// ApplyAndParse applies the edits and parses the rewritten source with p.
+func (r *Rewriter) ApplyAndParse(ctx context.Context, p *Parser, opts ...ParseOption) (*RewriteResult, error) {
+	source := r.Apply()
+
+	result, err := p.Parse(ctx, source, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse rewritten source: %w", err)
+	}
+
+	// errors move around with the edits, so they are matched by type
+	existing := make(map[SyntaxErrorType]int)
+	for _, synError := range r.errors {
+		existing[synError.Type]++
+	}
+
+	newErrors := make([]*SyntaxError, 0)
+	for _, synError := range result.SynError {
+		if existing[synError.Type] > 0 {
+			existing[synError.Type]--
+			continue
+		}
+
+		newErrors = append(newErrors, synError)
+	}
+
+	return &RewriteResult{
+		Source:    source,
+		Result:    result,
+		NewErrors: newErrors,
+	}, nil
+}
@@ -0,0 +1,185 @@
+package parser_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+func TestRewriterApply(t *testing.T) {
+	result := parse(t, "foo.bar(1, 2)\nbaz")
+	call := findCall(t, result.Value, "bar")
+	baz := findCall(t, result.Value, "baz")
+
+	r := parser.NewRewriter(result)
+
+	if err := r.Replace(call.Messageloc, "qux"); err != nil {
+		t.Fatalf("failed to replace: %s", err)
+	}
+
+	if err := r.Remove(call.Arguments.Arguments[1].Location()); err != nil {
+		t.Fatalf("failed to remove: %s", err)
+	}
+
+	if err := r.Wrap(baz.Location(), "puts(", ")"); err != nil {
+		t.Fatalf("failed to wrap: %s", err)
+	}
+
+	if err := r.InsertBefore(call.Location(), "x = "); err != nil {
+		t.Fatalf("failed to insert: %s", err)
+	}
+
+	expected := "x = foo.qux(1, )\nputs(baz)"
+	if got := string(r.Apply()); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	if string(result.Source.Source) != "foo.bar(1, 2)\nbaz" {
+		t.Errorf("expected the original source to be untouched")
+	}
+
+	if len(r.Edits()) != 5 {
+		t.Errorf("expected 5 edits, got %d", len(r.Edits()))
+	}
+}
+
+func TestRewriterInsertionOrder(t *testing.T) {
+	r := parser.NewRewriterFromSource([]byte("ab"))
+	a := parser.NewLocation(0, 1)
+	b := parser.NewLocation(1, 1)
+
+	for _, edit := range []func() error{
+		func() error { return r.Wrap(a, "(", ")") },
+		func() error { return r.Wrap(a, "[", "]") },
+		func() error { return r.InsertBefore(b, "<") },
+		func() error { return r.Replace(b, "B") },
+	} {
+		if err := edit(); err != nil {
+			t.Fatalf("failed to edit: %s", err)
+		}
+	}
+
+	expected := "[(a)]<B"
+	if got := string(r.Apply()); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestRewriterConflicts(t *testing.T) {
+	table := []struct {
+		name  string
+		first func(r *parser.Rewriter) error
+		next  func(r *parser.Rewriter) error
+	}{
+		{
+			name:  "overlapping replacements",
+			first: func(r *parser.Rewriter) error { return r.Replace(parser.NewLocation(0, 4), "x") },
+			next:  func(r *parser.Rewriter) error { return r.Replace(parser.NewLocation(2, 4), "y") },
+		},
+		{
+			name:  "nested removal",
+			first: func(r *parser.Rewriter) error { return r.Replace(parser.NewLocation(0, 6), "x") },
+			next:  func(r *parser.Rewriter) error { return r.Remove(parser.NewLocation(1, 2)) },
+		},
+		{
+			name:  "different replacements of the same range",
+			first: func(r *parser.Rewriter) error { return r.Replace(parser.NewLocation(0, 3), "x") },
+			next:  func(r *parser.Rewriter) error { return r.Replace(parser.NewLocation(0, 3), "y") },
+		},
+		{
+			name:  "insertion inside a replacement",
+			first: func(r *parser.Rewriter) error { return r.Replace(parser.NewLocation(0, 6), "x") },
+			next:  func(r *parser.Rewriter) error { return r.InsertAfter(parser.NewLocation(0, 3), "y") },
+		},
+		{
+			name:  "replacement around an insertion",
+			first: func(r *parser.Rewriter) error { return r.InsertBefore(parser.NewLocation(3, 1), "y") },
+			next:  func(r *parser.Rewriter) error { return r.Remove(parser.NewLocation(0, 6)) },
+		},
+	}
+
+	for _, v := range table {
+		r := parser.NewRewriterFromSource([]byte("foobar"))
+		if err := v.first(r); err != nil {
+			t.Fatalf("%s: failed to record the first edit: %s", v.name, err)
+		}
+
+		err := v.next(r)
+
+		var conflict *parser.ConflictError
+		if !errors.As(err, &conflict) || !errors.Is(err, parser.ErrConflictingEdits) {
+			t.Errorf("%s: expected a conflict, got %v", v.name, err)
+		}
+
+		if len(r.Edits()) != 1 {
+			t.Errorf("%s: expected the conflicting edit to be rejected", v.name)
+		}
+	}
+}
+
+func TestRewriterAcceptsAdjacentAndDuplicateEdits(t *testing.T) {
+	r := parser.NewRewriterFromSource([]byte("foobar"))
+
+	if err := r.Replace(parser.NewLocation(0, 3), "x"); err != nil {
+		t.Fatalf("failed to replace: %s", err)
+	}
+
+	if err := r.Replace(parser.NewLocation(0, 3), "x"); err != nil {
+		t.Errorf("expected the same replacement twice to be accepted, got %s", err)
+	}
+
+	if err := r.Replace(parser.NewLocation(3, 3), "y"); err != nil {
+		t.Errorf("expected adjacent replacements to be accepted, got %s", err)
+	}
+
+	if err := r.InsertAfter(parser.NewLocation(0, 3), "-"); err != nil {
+		t.Errorf("expected an insertion at the end of a replacement to be accepted, got %s", err)
+	}
+
+	if got := string(r.Apply()); got != "x-y" {
+		t.Errorf("expected x-y, got %q", got)
+	}
+
+	if err := r.Replace(parser.NewLocation(4, 10), "z"); err == nil {
+		t.Errorf("expected a location outside of the source to be rejected")
+	}
+}
+
+func TestRewriterApplyAndParse(t *testing.T) {
+	ctx := context.Background()
+	p, err := parser.NewParser(ctx)
+	if err != nil {
+		t.Fatalf("failed to create parser: %s", err)
+	}
+	defer p.Close(ctx)
+
+	result := parse(t, "foo(1)\nbar(")
+	if len(result.SynError) == 0 {
+		t.Fatalf("expected the source to have a syntax error")
+	}
+
+	r := parser.NewRewriter(result)
+	call := findCall(t, result.Value, "foo")
+	if err := r.Replace(call.Openingloc, "["); err != nil {
+		t.Fatalf("failed to replace: %s", err)
+	}
+
+	rewritten, err := r.ApplyAndParse(ctx, p)
+	if err != nil {
+		t.Fatalf("failed to apply and parse: %s", err)
+	}
+
+	if string(rewritten.Source) != "foo[1)\nbar(" {
+		t.Errorf("unexpected source %q", rewritten.Source)
+	}
+
+	if len(rewritten.NewErrors) == 0 {
+		t.Errorf("expected new syntax errors")
+	}
+
+	if len(rewritten.NewErrors) >= len(rewritten.Result.SynError) {
+		t.Errorf("expected the existing error not to be reported as new")
+	}
+}
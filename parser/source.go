@@ -0,0 +1,171 @@
+package parser
+
+import (
+	"encoding/json"
+	"sort"
+	"unicode/utf8"
+)
+
+// Source is the parsed source together with the line offsets prism computed
+// while parsing it. It converts byte offsets and locations into lines and
+// columns. Lines are numbered from the start line the source was parsed with
+// and columns start at 0.
+type Source struct {
+	Source      []byte
+	StartLine   int32
+	LineOffsets []uint32
+}
+
+func NewSource(source []byte, startLine int32, lineOffsets []uint32) *Source {
+	if len(lineOffsets) == 0 {
+		lineOffsets = []uint32{0}
+	}
+
+	return &Source{
+		Source:      source,
+		StartLine:   startLine,
+		LineOffsets: lineOffsets,
+	}
+}
+
+// MarshalJSON encodes the source bytes in base64, so that sources which
+// aren't valid UTF-8 survive the round trip.
+func (s *Source) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"source":      s.Source,
+		"startLine":   s.StartLine,
+		"lineOffsets": s.LineOffsets,
+	})
+}
+
+func (s *Source) UnmarshalJSON(data []byte) error {
+	var fields struct {
+		Source      []byte   `json:"source"`
+		StartLine   int32    `json:"startLine"`
+		LineOffsets []uint32 `json:"lineOffsets"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	*s = *NewSource(fields.Source, fields.StartLine, fields.LineOffsets)
+	return nil
+}
+
+// Line returns the line number the byte offset is on.
+func (s *Source) Line(offset uint32) int {
+	return int(s.StartLine) + s.lineIndex(offset)
+}
+
+// LineStart returns the byte offset of the start of the line offset is on.
+func (s *Source) LineStart(offset uint32) uint32 {
+	return s.LineOffsets[s.lineIndex(offset)]
+}
+
+// Column returns the number of bytes between the start of the line and offset.
+func (s *Source) Column(offset uint32) int {
+	return int(offset - s.LineStart(offset))
+}
+
+// CharacterColumn returns the number of characters between the start of the
+// line and offset, assuming the source is UTF-8.
+func (s *Source) CharacterColumn(offset uint32) int {
+	return utf8.RuneCount(s.slice(s.LineStart(offset), offset))
+}
+
+// CodeUnitsColumn returns the number of UTF-16 code units between the start of
+// the line and offset, which is what editors speaking LSP expect.
+func (s *Source) CodeUnitsColumn(offset uint32) int {
+	line := s.slice(s.LineStart(offset), offset)
+
+	units := 0
+	for len(line) > 0 {
+		r, size := utf8.DecodeRune(line)
+		if r >= 0x10000 {
+			units += 2
+		} else {
+			units++
+		}
+		line = line[size:]
+	}
+
+	return units
+}
+
+func (s *Source) StartLineOf(loc *Location) int {
+	return s.Line(loc.StartOffset)
+}
+
+func (s *Source) EndLineOf(loc *Location) int {
+	return s.Line(loc.EndOffset())
+}
+
+func (s *Source) StartColumnOf(loc *Location) int {
+	return s.Column(loc.StartOffset)
+}
+
+func (s *Source) EndColumnOf(loc *Location) int {
+	return s.Column(loc.EndOffset())
+}
+
+func (s *Source) StartCharacterColumnOf(loc *Location) int {
+	return s.CharacterColumn(loc.StartOffset)
+}
+
+func (s *Source) EndCharacterColumnOf(loc *Location) int {
+	return s.CharacterColumn(loc.EndOffset())
+}
+
+func (s *Source) StartCodeUnitsColumnOf(loc *Location) int {
+	return s.CodeUnitsColumn(loc.StartOffset)
+}
+
+func (s *Source) EndCodeUnitsColumnOf(loc *Location) int {
+	return s.CodeUnitsColumn(loc.EndOffset())
+}
+
+// Offset returns the byte offset of the given line and byte column. ok is
+// false if the line is not in the source or the column is past its end.
+func (s *Source) Offset(line int, column int) (offset uint32, ok bool) {
+	index := line - int(s.StartLine)
+	if index < 0 || index >= len(s.LineOffsets) || column < 0 {
+		return 0, false
+	}
+
+	end := uint32(len(s.Source))
+	if index+1 < len(s.LineOffsets) {
+		end = s.LineOffsets[index+1]
+	}
+
+	offset = s.LineOffsets[index] + uint32(column)
+	if offset > end {
+		return 0, false
+	}
+
+	return offset, true
+}
+
+// Slice returns the source covered by loc.
+func (s *Source) Slice(loc *Location) []byte {
+	return s.slice(loc.StartOffset, loc.EndOffset())
+}
+
+func (s *Source) lineIndex(offset uint32) int {
+	// index of the last line that starts at or before offset
+	return sort.Search(len(s.LineOffsets), func(i int) bool {
+		return s.LineOffsets[i] > offset
+	}) - 1
+}
+
+func (s *Source) slice(start, end uint32) []byte {
+	size := uint32(len(s.Source))
+	if start > size {
+		start = size
+	}
+	if end > size {
+		end = size
+	}
+
+	return s.Source[start:end]
+}
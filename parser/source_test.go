@@ -0,0 +1,57 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+func TestSourcePositions(t *testing.T) {
+	// "é" is two bytes and one UTF-16 code unit, "😀" is four bytes and two
+	// UTF-16 code units
+	source := "x = 1\ns = \"é😀\"; foo\n"
+	result := parse(t, source)
+
+	program := result.Value.(*parser.ProgramNode)
+	call := program.Statements.Body[2].(*parser.CallNode)
+
+	table := []struct {
+		name     string
+		got      int
+		expected int
+	}{
+		{name: "start line", got: result.Source.StartLineOf(call.Loc), expected: 2},
+		{name: "end line", got: result.Source.EndLineOf(call.Loc), expected: 2},
+		{name: "start column", got: result.Source.StartColumnOf(call.Loc), expected: 14},
+		{name: "end column", got: result.Source.EndColumnOf(call.Loc), expected: 17},
+		{name: "start character column", got: result.Source.StartCharacterColumnOf(call.Loc), expected: 10},
+		{name: "end character column", got: result.Source.EndCharacterColumnOf(call.Loc), expected: 13},
+		{name: "start code units column", got: result.Source.StartCodeUnitsColumnOf(call.Loc), expected: 11},
+		{name: "end code units column", got: result.Source.EndCodeUnitsColumnOf(call.Loc), expected: 14},
+	}
+
+	for _, v := range table {
+		if v.got != v.expected {
+			t.Errorf("expected %s to be %d, got %d", v.name, v.expected, v.got)
+		}
+	}
+
+	if string(result.Source.Slice(call.Loc)) != "foo" {
+		t.Errorf("expected slice to be foo, got %q", result.Source.Slice(call.Loc))
+	}
+}
+
+func TestSourceHonoursStartLine(t *testing.T) {
+	result := parse(t, "a\nb\n", parser.WithStartLine(10))
+
+	program := result.Value.(*parser.ProgramNode)
+	second := program.Statements.Body[1]
+
+	if line := result.Source.StartLineOf(second.Location()); line != 11 {
+		t.Errorf("expected second statement on line 11, got %d", line)
+	}
+
+	if result.Source.StartLine != 10 {
+		t.Errorf("expected start line 10, got %d", result.Source.StartLine)
+	}
+}
@@ -0,0 +1,111 @@
+package parser_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+func TestSyntaxErrorTypeNames(t *testing.T) {
+	if parser.HEREDOC_TERM.String() != "HEREDOC_TERM" {
+		t.Errorf("expected HEREDOC_TERM, got %s", parser.HEREDOC_TERM)
+	}
+
+	// unknown values keep their number, so that they don't collapse into one
+	// name
+	unknown := []struct {
+		got      fmt.Stringer
+		expected string
+	}{
+		{got: parser.SyntaxErrorType(-1), expected: "UNKNOWN_ERROR_-1"},
+		{got: parser.SyntaxErrorType(1000), expected: "UNKNOWN_ERROR_1000"},
+		{got: parser.SyntaxWarningType(1000), expected: "UNKNOWN_WARNING_1000"},
+		{got: parser.SyntaxErrorLevel(7), expected: "UNKNOWN_ERROR_LEVEL_7"},
+		{got: parser.SyntaxWarningLevel(7), expected: "UNKNOWN_WARNING_LEVEL_7"},
+	}
+	for _, v := range unknown {
+		if v.got.String() != v.expected {
+			t.Errorf("expected %s, got %s", v.expected, v.got)
+		}
+	}
+
+	for _, errType := range parser.SyntaxErrorTypes {
+		found, ok := parser.SyntaxErrorTypeByName(errType.String())
+		if !ok || found != errType {
+			t.Errorf("expected %s to round trip through its name", errType)
+		}
+	}
+
+	for _, warnType := range parser.SyntaxWarningTypes {
+		found, ok := parser.SyntaxWarningTypeByName(warnType.String())
+		if !ok || found != warnType {
+			t.Errorf("expected %s to round trip through its name", warnType)
+		}
+	}
+
+	if _, ok := parser.SyntaxErrorTypeByName("NOT_A_TYPE"); ok {
+		t.Errorf("expected NOT_A_TYPE to be unknown")
+	}
+}
+
+func TestSyntaxLevelNames(t *testing.T) {
+	table := []struct {
+		level    interface{ String() string }
+		expected string
+	}{
+		{level: parser.SyntaxErrorFatal, expected: "fatal"},
+		{level: parser.SyntaxErrorArgument, expected: "argument"},
+		{level: parser.SyntaxWarningDefault, expected: "default"},
+		{level: parser.SyntaxWarningVerbose, expected: "verbose"},
+	}
+
+	for _, v := range table {
+		if v.level.String() != v.expected {
+			t.Errorf("expected %s, got %s", v.expected, v.level)
+		}
+	}
+
+	if level, ok := parser.SyntaxWarningLevelByName("verbose"); !ok || level != parser.SyntaxWarningVerbose {
+		t.Errorf("expected verbose to be SyntaxWarningVerbose")
+	}
+}
+
+func TestSyntaxErrorTextMarshaling(t *testing.T) {
+	// config files list the diagnostics to suppress by name
+	var config struct {
+		Suppress []parser.SyntaxWarningType `json:"suppress"`
+		Level    parser.SyntaxErrorLevel    `json:"level"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"suppress": ["AMBIGUOUS_SLASH"], "level": "argument"}`), &config); err != nil {
+		t.Fatalf("failed to unmarshal: %s", err)
+	}
+
+	if len(config.Suppress) != 1 || config.Suppress[0] != parser.AMBIGUOUS_SLASH || config.Level != parser.SyntaxErrorArgument {
+		t.Errorf("unexpected config %+v", config)
+	}
+
+	if err := json.Unmarshal([]byte(`{"suppress": ["NOT_A_WARNING"]}`), &config); err == nil {
+		t.Errorf("expected an unknown name to fail")
+	}
+
+	if _, err := json.Marshal(parser.SyntaxErrorType(-1)); err == nil {
+		t.Errorf("expected an unknown type to fail to marshal")
+	}
+}
+
+func TestParseResultMarshalJSONUsesNames(t *testing.T) {
+	data, err := json.Marshal(parse(t, "p -a\n<<~EOS\nfoo", parser.WithScopes([]string{"a"})))
+	if err != nil {
+		t.Fatalf("failed to marshal: %s", err)
+	}
+
+	for _, name := range []string{`"Type":"HEREDOC_TERM"`, `"Level":"fatal"`, `"Type":"AMBIGUOUS_FIRST_ARGUMENT_MINUS"`, `"Level":"verbose"`} {
+		if !strings.Contains(string(data), name) {
+			t.Errorf("expected %s in %s", name, data)
+		}
+	}
+}
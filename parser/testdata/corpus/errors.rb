@@ -0,0 +1,8 @@
+def foo(a, a)
+  p -a
+  [1, 2
+  bar(1, 2 +)
+  x = 1
+end
+
+class
@@ -0,0 +1,14 @@
+# frozen_string_literal: true
+# encoding: utf-8
+
+# A comment
+=begin
+A block comment
+=end
+n = 123456789012345678901234567890 * -0x7fffffffffffffffffff
+f = 1.5e-3 + 3r + 2i
+inf = [1e400, -1e400]
+s = "café"
+puts n, f, inf, s, DATA.read
+__END__
+data
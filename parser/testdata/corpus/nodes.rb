@@ -0,0 +1,168 @@
+nil; true; false; self
+__FILE__; __LINE__; __ENCODING__
+
+1; -2; 0x1f; 0b101; 0o17; 1_000_000_000_000_000_000_000
+1.5; -2.25; 1e100; 3r; 2i; 1.5ri
+
+"plain"; 'single'; "tab\tnew\nline \"quoted\" \\ back #{1 + 2} #@x"
+"a#{b}c"; "#{}"; "x" "y"
+`ls -la`; `echo #{dir}`
+:sym; :"with space"; :"a#{b}"; :+; :[]=; :foo?; :@ivar; :$gvar
+%w[a b]; %i[c d]; %W[a#{b} c]
+
+/ab+c/ix; /a\/b\d/; /#{x}y/m; /a/o
+if /foo/ then 1 end
+if /#{foo}/ then 1 end
+
+x = <<~HEREDOC
+  heredoc #{interpolated}
+  body
+HEREDOC
+y = <<-'RAW'
+  raw \n text
+  RAW
+
+[1, [2, 3], *rest]; []
+{}; { a: 1, "b" => 2, c => 3, **opts }
+foo(a: 1, b:)
+
+1..2; 1...2; (1..); (..5); x..y
+if (a == 1)..(b == 2) then c end
+
+a; @a; @@a; $a; $~; $&; $1; A; A::B; ::C; a.b::C
+a = 1; @a = 2; @@a = 3; $a = 4; A = 5; A::B = 6; ::C = 7
+a += 1; a ||= 2; a &&= 3
+@a += 1; @a ||= 2; @a &&= 3
+@@a += 1; @@a ||= 2; @@a &&= 3
+$a -= 1; $a ||= 2; $a &&= 3
+A *= 1; A ||= 2; A &&= 3
+A::B <<= 1; A::B ||= 2; A::B &&= 3
+a.b += 1; a&.b ||= 2; a.b &&= 3
+a[1] += 1; a[1, 2] ||= 2; a[] &&= 3
+
+a, b = 1, 2
+a, (b, *c), d = foo
+*a, b = foo
+a, = foo
+a.b, c[1], A::B, @d, @@e, $f, G = 1, 2, 3, 4, 5, 6, 7
+
+foo; foo(); foo(1, 2); foo.bar; foo&.bar(1); Foo::bar
+foo.bar = 1; foo[1]; foo[1, 2] = 3; foo&.bar = 2
+a + b * c; (a + b) * c; a ** -b; -a ** 2; (-2) ** 2; !a; ~a; -a; +a
+a == b; a != b; a =~ b; a !~ b; a <=> b; a << b >> c
+not a; a and b; a or not b; a && b || c
+foo(*args, **opts, &blk)
+def foo(*, **, &) = [bar(&), bar(*), bar(**)]
+foo { |x| x }; foo do |x, (y, z), *r, k:, o: 1, **kr, &b; l| end
+foo { _1 + _2 }; foo { it }
+foo.bar(1) { }; foo[1] { 2 }
+->(x, y = 1) { x + y }; -> { }; ->(a) do a end
+
+def foo; end
+def foo(a, b = 1, *c, d, e:, f: 2, **g, &h) = a
+def self.foo(...) = bar(...)
+def foo(*, **, &) = bar(*, **, &)
+def foo(**nil); end
+def foo = super
+def foo(a) super(a) { } end
+def foo; yield; yield 1, 2; end
+def foo
+  bar
+rescue ArgumentError, TypeError => e
+  retry
+rescue
+  baz
+else
+  qux
+ensure
+  done
+end
+
+begin; a; end
+begin
+  a
+rescue => e
+  b
+ensure
+  c
+end
+x = begin; a; rescue; b; end
+a rescue b
+
+if a then b end; if a then b elsif c then d else e end
+unless a then b else c end
+b if a; b unless a; b while a; b until a
+begin; a; end while b
+begin; a; end until b
+while a; b; end; until a; b; end
+a ? b : c
+for i in 1..3 do p i end
+for a, b in c; end
+
+case a
+when 1, 2 then b
+when *c then d
+else e
+end
+
+case
+when a then b
+end
+
+case a
+in 1 | 2 then b
+in [1, *rest] then c
+in [*, 1, *post] then d
+in { name: String => name, age: Integer } then e
+in { name:, **nil } then f
+in { name:, **rest } then g
+in Foo[x, y] then h
+in Foo(a:) then i
+in ^x then j
+in ^(1 + 2) then k
+in x if x > 1 then l
+in x unless x then m
+in [a,] then n
+in 1.. then o
+in nil then p
+end
+
+a in b; a => [b, c]
+a => { "k": v }
+
+loop { break }; loop { break 1 }; loop { next 2 }; loop { redo }
+def foo; return; return 1; return 1, 2; end
+
+class Foo; end
+class Foo::Bar < Baz
+  def x = 1
+end
+module Foo; end
+class << self; def x; end; end
+
+alias foo bar; alias $a $b; alias :+ :-
+undef foo, :bar
+BEGIN { a }; END { b }
+defined?(a); defined? @a
+
+if a =~ /(?<named>x)/ then named end
+/(?<m>x)/ =~ a
+$x = a.b.c(1).d { e }.f
+a.!; a.()
+foo a, b
+foo bar(1) do end
+puts [1, 2].map { _1 * 2 }.sum if true
+
+a = b = c; x = if a then b end; p(not a); foo -1
+foo(<<~A, 1)
+  text
+A
+foo do
+  a
+rescue
+  b
+end
+x = (a; b); y = yield(1) + 2; z = a.b do 1 end
+"\e\x00é #{"nested #{deep}"}"; :"a\"b"; 1.0e-5; -0.0
+puts(a ? b : c, *d)
+-> { a rescue b }.()
@@ -0,0 +1,120 @@
+package parser
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// LexState is the state of the lexer after a token, a bit set that mirrors the
+// EXPR_* states of ruby's own lexer.
+type LexState uint32
+
+const (
+	LEX_STATE_BEG LexState = 1 << iota
+	LEX_STATE_END
+	LEX_STATE_ENDARG
+	LEX_STATE_ENDFN
+	LEX_STATE_ARG
+	LEX_STATE_CMDARG
+	LEX_STATE_MID
+	LEX_STATE_FNAME
+	LEX_STATE_DOT
+	LEX_STATE_CLASS
+	LEX_STATE_LABEL
+	LEX_STATE_LABELED
+	LEX_STATE_FITEM
+)
+
+var lexStateNames = []string{
+	"BEG",
+	"END",
+	"ENDARG",
+	"ENDFN",
+	"ARG",
+	"CMDARG",
+	"MID",
+	"FNAME",
+	"DOT",
+	"CLASS",
+	"LABEL",
+	"LABELED",
+	"FITEM",
+}
+
+func (s LexState) String() string {
+	if s == 0 {
+		return "NONE"
+	}
+
+	names := make([]string, 0)
+	for i, name := range lexStateNames {
+		if s&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+
+	return strings.Join(names, "|")
+}
+
+type Token struct {
+	Type     TokenType
+	Loc      *Location
+	LexState LexState
+}
+
+func NewToken(tokenType TokenType, loc *Location, lexState LexState) *Token {
+	return &Token{
+		Type:     tokenType,
+		Loc:      loc,
+		LexState: lexState,
+	}
+}
+
+func (t *Token) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"type":     t.Type.String(),
+		"loc":      t.Loc,
+		"lexState": t.LexState.String(),
+	})
+}
+
+type LexResult struct {
+	Tokens        []*Token
+	Comments      []*Comment
+	MagicComments []*MagicComment
+	DataLocation  *Location
+	SynError      []*SyntaxError
+	SynWarnings   []*SyntaxWarning
+	Source        *Source
+}
+
+func NewLexResult(
+	tokens []*Token,
+	comments []*Comment,
+	magicComments []*MagicComment,
+	dataLocation *Location,
+	synError []*SyntaxError,
+	synWarnings []*SyntaxWarning,
+	source *Source,
+) *LexResult {
+	return &LexResult{
+		Tokens:        tokens,
+		Comments:      comments,
+		MagicComments: magicComments,
+		DataLocation:  dataLocation,
+		SynError:      synError,
+		SynWarnings:   synWarnings,
+		Source:        source,
+	}
+}
+
+func (l *LexResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"tokens":        l.Tokens,
+		"comments":      l.Comments,
+		"magicComments": l.MagicComments,
+		"dataLocation":  l.DataLocation,
+		"synError":      l.SynError,
+		"synWarnings":   l.SynWarnings,
+	})
+}
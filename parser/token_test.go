@@ -0,0 +1,91 @@
+package parser_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+func lex(t *testing.T, source string, opts ...parser.ParseOption) *parser.LexResult {
+	t.Helper()
+
+	ctx := context.Background()
+	p, err := parser.NewParser(ctx)
+	if err != nil {
+		t.Fatalf("failed to create parser: %s", err)
+	}
+	defer p.Close(ctx)
+
+	result, err := p.Lex(ctx, []byte(source), opts...)
+	if err != nil {
+		t.Fatalf("failed to lex %q: %s", source, err)
+	}
+
+	return result
+}
+
+func TestLex(t *testing.T) {
+	source := "# greet\nputs <<~EOS\n  hi\nEOS\n"
+	result := lex(t, source)
+
+	expected := []struct {
+		tokenType parser.TokenType
+		text      string
+	}{
+		{tokenType: parser.TOKEN_COMMENT, text: "# greet\n"},
+		{tokenType: parser.TOKEN_IDENTIFIER, text: "puts"},
+		{tokenType: parser.TOKEN_HEREDOC_START, text: "<<~EOS"},
+		{tokenType: parser.TOKEN_STRING_CONTENT, text: "  hi\n"},
+		{tokenType: parser.TOKEN_HEREDOC_END, text: "EOS\n"},
+		{tokenType: parser.TOKEN_NEWLINE, text: "\n"},
+		{tokenType: parser.TOKEN_EOF, text: ""},
+	}
+
+	if len(result.Tokens) != len(expected) {
+		t.Fatalf("expected %d tokens, got %d", len(expected), len(result.Tokens))
+	}
+
+	for i, v := range expected {
+		token := result.Tokens[i]
+		if token.Type != v.tokenType {
+			t.Errorf("token %d: expected type %s, got %s", i, v.tokenType, token.Type)
+		}
+
+		if text := string(result.Source.Slice(token.Loc)); text != v.text {
+			t.Errorf("token %d: expected text %q, got %q", i, v.text, text)
+		}
+	}
+
+	if len(result.Comments) != 1 {
+		t.Errorf("expected 1 comment, got %d", len(result.Comments))
+	}
+}
+
+func TestLexState(t *testing.T) {
+	result := lex(t, "foo(:bar)")
+
+	if state := result.Tokens[1].LexState; state != parser.LEX_STATE_BEG|parser.LEX_STATE_LABEL {
+		t.Errorf("expected BEG|LABEL after the opening parenthesis, got %s", state)
+	}
+
+	if state := result.Tokens[1].LexState.String(); state != "BEG|LABEL" {
+		t.Errorf("expected lex state to print as BEG|LABEL, got %s", state)
+	}
+}
+
+func TestLexReportsErrors(t *testing.T) {
+	if result := lex(t, "foo("); len(result.SynError) == 0 {
+		t.Errorf("expected lexing an unterminated call to report an error")
+	}
+}
+
+func TestTokenTypeString(t *testing.T) {
+	if name := parser.TOKEN_KEYWORD_DEF.String(); name != "KEYWORD_DEF" {
+		t.Errorf("expected KEYWORD_DEF, got %s", name)
+	}
+
+	if name := parser.TokenType(0).String(); name != "UNKNOWN" {
+		t.Errorf("expected UNKNOWN, got %s", name)
+	}
+}
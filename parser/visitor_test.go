@@ -0,0 +1,77 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+type defCollector struct {
+	*parser.BaseVisitor
+	names []string
+	calls int
+}
+
+func newDefCollector() *defCollector {
+	v := &defCollector{}
+	v.BaseVisitor = parser.NewBaseVisitor(v)
+	return v
+}
+
+func (v *defCollector) VisitDefNode(node *parser.DefNode) {
+	v.names = append(v.names, node.Name)
+	v.VisitChildren(node)
+}
+
+func (v *defCollector) VisitCallNode(node *parser.CallNode) {
+	v.calls++
+	// deliberately not walking into the call
+}
+
+func TestBaseVisitor(t *testing.T) {
+	source := `
+class Foo
+  def bar
+    baz(qux)
+  end
+
+  module Inner
+    def self.quux = 1
+  end
+end
+`
+	v := newDefCollector()
+	parse(t, source).Value.Accept(v)
+
+	if len(v.names) != 2 || v.names[0] != "bar" || v.names[1] != "quux" {
+		t.Errorf("expected defs [bar quux], got %v", v.names)
+	}
+
+	// qux is a call too, but it sits inside baz which is not walked
+	if v.calls != 1 {
+		t.Errorf("expected 1 call to be visited, got %d", v.calls)
+	}
+}
+
+func TestBaseVisitorWithoutOverrides(t *testing.T) {
+	parse(t, "foo(1, [2, {a: 3}]) { |x| x }").Value.Accept(parser.NewBaseVisitor(nil))
+}
+
+type nodeTypeVisitor struct {
+	visited []parser.Node
+}
+
+func (v *nodeTypeVisitor) Visit(node parser.Node) {
+	v.visited = append(v.visited, node)
+}
+
+func TestAdaptNodeVisitor(t *testing.T) {
+	result := parse(t, "foo")
+
+	v := &nodeTypeVisitor{}
+	result.Value.Accept(parser.AdaptNodeVisitor(v))
+
+	if len(v.visited) != 1 || v.visited[0] != result.Value {
+		t.Errorf("expected only the program node to be visited, got %v", v.visited)
+	}
+}
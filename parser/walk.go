@@ -0,0 +1,91 @@
+package parser
+
+type WalkAction int
+
+const (
+	// WalkContinue walks into the children of the node.
+	WalkContinue WalkAction = iota
+	// WalkSkipChildren does not walk into the children of the node. The leave
+	// callback is still called for it.
+	WalkSkipChildren
+	// WalkStop ends the walk immediately. No other callback is called.
+	WalkStop
+)
+
+type WalkFunc func(node Node) WalkAction
+
+type walkFrame struct {
+	node     Node
+	children []Node
+	next     int
+}
+
+// Walk traverses the tree rooted at root in depth-first order, calling enter
+// before the children of a node are walked and leave after. Either callback
+// may be nil. The walk uses an explicit stack, so the depth of the tree is not
+// limited by the goroutine stack. Walk reports whether the walk ran to
+// completion, i.e. no callback returned WalkStop.
+func Walk(root Node, enter WalkFunc, leave WalkFunc) bool {
+	if root == nil {
+		return true
+	}
+
+	stack := make([]*walkFrame, 0, 32)
+
+	push := func(node Node) bool {
+		action := WalkContinue
+		if enter != nil {
+			action = enter(node)
+		}
+
+		switch action {
+		case WalkStop:
+			return false
+		case WalkSkipChildren:
+			stack = append(stack, &walkFrame{node: node})
+		default:
+			stack = append(stack, &walkFrame{node: node, children: node.Children()})
+		}
+
+		return true
+	}
+
+	if !push(root) {
+		return false
+	}
+
+	for len(stack) > 0 {
+		frame := stack[len(stack)-1]
+
+		if frame.next < len(frame.children) {
+			child := frame.children[frame.next]
+			frame.next++
+
+			if child != nil && !push(child) {
+				return false
+			}
+
+			continue
+		}
+
+		stack = stack[:len(stack)-1]
+
+		if leave != nil && leave(frame.node) == WalkStop {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Inspect traverses the tree rooted at root in depth-first order, calling f
+// for each node. If f returns false, the children of that node are skipped.
+func Inspect(root Node, f func(node Node) bool) {
+	Walk(root, func(node Node) WalkAction {
+		if f(node) {
+			return WalkContinue
+		}
+
+		return WalkSkipChildren
+	}, nil)
+}
@@ -0,0 +1,108 @@
+package parser_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+func nodeName(node parser.Node) string {
+	return strings.TrimPrefix(fmt.Sprintf("%T", node), "*parser.")
+}
+
+func TestWalkEnterAndLeave(t *testing.T) {
+	root := parse(t, "foo(1)").Value
+
+	var events []string
+	completed := parser.Walk(root, func(node parser.Node) parser.WalkAction {
+		events = append(events, "+"+nodeName(node))
+		return parser.WalkContinue
+	}, func(node parser.Node) parser.WalkAction {
+		events = append(events, "-"+nodeName(node))
+		return parser.WalkContinue
+	})
+
+	expected := []string{
+		"+ProgramNode", "+StatementsNode", "+CallNode", "+ArgumentsNode", "+IntegerNode",
+		"-IntegerNode", "-ArgumentsNode", "-CallNode", "-StatementsNode", "-ProgramNode",
+	}
+
+	if !completed {
+		t.Errorf("expected walk to complete")
+	}
+
+	if strings.Join(events, " ") != strings.Join(expected, " ") {
+		t.Errorf("expected events %v, got %v", expected, events)
+	}
+}
+
+func TestWalkSkipChildren(t *testing.T) {
+	root := parse(t, "def foo\n  bar\nend\nbaz").Value
+
+	var calls []string
+	parser.Walk(root, func(node parser.Node) parser.WalkAction {
+		switch node := node.(type) {
+		case *parser.DefNode:
+			return parser.WalkSkipChildren
+		case *parser.CallNode:
+			calls = append(calls, node.Name)
+		}
+
+		return parser.WalkContinue
+	}, nil)
+
+	if len(calls) != 1 || calls[0] != "baz" {
+		t.Errorf("expected only baz to be visited, got %v", calls)
+	}
+}
+
+func TestWalkStop(t *testing.T) {
+	root := parse(t, "a; b; c").Value
+
+	var calls []string
+	completed := parser.Walk(root, func(node parser.Node) parser.WalkAction {
+		if call, ok := node.(*parser.CallNode); ok {
+			calls = append(calls, call.Name)
+			if call.Name == "b" {
+				return parser.WalkStop
+			}
+		}
+
+		return parser.WalkContinue
+	}, func(node parser.Node) parser.WalkAction {
+		if _, ok := node.(*parser.ProgramNode); ok {
+			t.Errorf("expected leave not to be called after stopping")
+		}
+
+		return parser.WalkContinue
+	})
+
+	if completed {
+		t.Errorf("expected walk not to complete")
+	}
+
+	if strings.Join(calls, ",") != "a,b" {
+		t.Errorf("expected calls a,b, got %v", calls)
+	}
+}
+
+func TestWalkDeepTree(t *testing.T) {
+	const depth = 1_000_000
+
+	var root parser.Node = parser.NewNilNode(parser.NewLocation(0, 3))
+	for range depth {
+		root = parser.NewParenthesesNode(root, nil, nil, nil)
+	}
+
+	count := 0
+	parser.Inspect(root, func(node parser.Node) bool {
+		count++
+		return true
+	})
+
+	if count != depth+1 {
+		t.Errorf("expected %d nodes, got %d", depth+1, count)
+	}
+}
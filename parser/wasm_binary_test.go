@@ -0,0 +1,88 @@
+package parser_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+const prismWasmPath = "../wasm/prism.wasm"
+
+func TestWithWasmFile(t *testing.T) {
+	ctx := context.Background()
+	p, err := parser.NewParser(ctx, parser.WithWasmFile(prismWasmPath))
+	if err != nil {
+		t.Fatalf("failed to create parser: %s", err)
+	}
+	defer p.Close(ctx)
+
+	if version := p.Version(); version != "0.24.0" {
+		t.Errorf("expected prism 0.24.0, got %s", version)
+	}
+
+	assertParses(t, p)
+}
+
+func TestWithWasmBinaryVersionMismatch(t *testing.T) {
+	ctx := context.Background()
+	binary, err := os.ReadFile(prismWasmPath)
+	if err != nil {
+		t.Fatalf("failed to read prism: %s", err)
+	}
+
+	// a build of a version this package can't read, as far as pm_version
+	// tells
+	binary = bytes.Replace(binary, []byte("0.24.0\x00"), []byte("0.99.0\x00"), 1)
+
+	assertMismatch := func(t *testing.T, err error) {
+		t.Helper()
+
+		var mismatch *parser.VersionMismatchError
+		if !errors.As(err, &mismatch) {
+			t.Fatalf("expected a version mismatch, got %v", err)
+		}
+
+		if mismatch.Found != "0.99.0" || !slices.Equal(mismatch.Supported, parser.SupportedPrismVersions()) {
+			t.Errorf("unexpected versions %+v", mismatch)
+		}
+	}
+
+	t.Run("parser", func(t *testing.T) {
+		_, err := parser.NewParser(ctx, parser.WithWasmBinary(binary))
+		assertMismatch(t, err)
+	})
+
+	t.Run("pool", func(t *testing.T) {
+		_, err := parser.NewParserPool(ctx, 2, parser.WithWasmBinary(binary))
+		assertMismatch(t, err)
+	})
+
+	// the embedded build is unaffected
+	assertParses(t, newParser(t))
+}
+
+func TestInvalidWasmBinary(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]parser.ParserOption{
+		"empty":        parser.WithWasmBinary(nil),
+		"missing file": parser.WithWasmFile(filepath.Join(t.TempDir(), "missing.wasm")),
+		"not wasm":     parser.WithWasmBinary([]byte("puts 1")),
+		"not prism":    parser.WithWasmBinary([]byte("\x00asm\x01\x00\x00\x00")),
+		"directory":    parser.WithWasmFile(t.TempDir()),
+	}
+
+	for name, opt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parser.NewParser(ctx, opt); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
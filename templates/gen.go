@@ -5,3 +5,4 @@ package node
 //go:generate ruby ./template.rb gen_syntaxe_warn.go ../parser/gen_syntaxe_warn.go
 //go:generate ruby ./template.rb gen_loader_node.go ../parser/gen_loader_node.go
 //go:generate ruby ./template.rb gen_flags.go ../parser/gen_flags.go
+//go:generate ruby ./template.rb gen_token.go ../parser/gen_token.go
@@ -29,3 +29,22 @@ def gotype(field)
   else raise "Unknown field type: #{field.inspect}"
   end
 end
+
+def json_type(field)
+  case field
+  when Prism::Template::NodeField, Prism::Template::OptionalNodeField
+    field.ruby_type == "Node" ? "json.RawMessage" : gotype(field)
+  when Prism::Template::NodeListField then "json.RawMessage"
+  when Prism::Template::DoubleField then "jsonFloat"
+  else gotype(field)
+  end
+end
+
+# converts the value of field to and from its json_type
+def to_json_type(field, value)
+  field.is_a?(Prism::Template::DoubleField) ? "jsonFloat(#{value})" : value
+end
+
+def from_json_type(field, value)
+  field.is_a?(Prism::Template::DoubleField) ? "float64(#{value})" : value
+end
@@ -0,0 +1,1810 @@
+package unparser
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+var ErrMissingNode = errors.New("cannot unparse a missing node")
+
+type printer struct {
+	unparser *Unparser
+	b        strings.Builder
+	indent   int
+}
+
+func newPrinter(unparser *Unparser) *printer {
+	return &printer{unparser: unparser}
+}
+
+func (p *printer) String() string {
+	return p.b.String()
+}
+
+func (p *printer) write(parts ...string) {
+	for _, part := range parts {
+		p.b.WriteString(part)
+	}
+}
+
+func (p *printer) newline() {
+	p.b.WriteByte('\n')
+	p.b.WriteString(strings.Repeat("  ", p.indent))
+}
+
+// statement writes a node in statement position, where anything goes.
+func (p *printer) statement(node parser.Node) error {
+	return p.node(node)
+}
+
+// argument writes a node where an argument, an element or the value of an
+// assignment is expected. Keyword operators, modifiers and jumps are wrapped in
+// parentheses.
+func (p *printer) argument(node parser.Node) error {
+	if p.isStatementLike(node) {
+		return p.parenthesized(node)
+	}
+
+	return p.node(node)
+}
+
+// operand writes a node that is the receiver or operand of a method call or
+// operator. Anything that is not a primary expression is parenthesized.
+func (p *printer) operand(node parser.Node) error {
+	if !p.isPrimary(node) {
+		return p.parenthesized(node)
+	}
+
+	return p.node(node)
+}
+
+func (p *printer) parenthesized(node parser.Node) error {
+	p.write("(")
+	if err := p.node(node); err != nil {
+		return err
+	}
+	p.write(")")
+
+	return nil
+}
+
+func (p *printer) list(nodes []parser.Node, write func(parser.Node) error) error {
+	for i, node := range nodes {
+		if i > 0 {
+			p.write(", ")
+		}
+
+		if err := write(node); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *printer) arguments(arguments *parser.ArgumentsNode, block parser.Node) error {
+	nodes := make([]parser.Node, 0)
+	if arguments != nil {
+		nodes = append(nodes, arguments.Arguments...)
+	}
+
+	if block != nil {
+		nodes = append(nodes, block)
+	}
+
+	return p.list(nodes, p.argument)
+}
+
+// body writes statements on their own lines, one level deeper than the
+// current line, and leaves the cursor at the start of a new line at the
+// current level.
+func (p *printer) body(node parser.Node) error {
+	p.indent++
+	if node != nil {
+		if err := p.bodyStatements(node); err != nil {
+			return err
+		}
+	}
+	p.indent--
+	p.newline()
+
+	return nil
+}
+
+func (p *printer) bodyStatements(node parser.Node) error {
+	switch node := node.(type) {
+	case *parser.StatementsNode:
+		for _, statement := range node.Body {
+			p.newline()
+			if err := p.statement(statement); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case *parser.BeginNode:
+		// the implicit begin of def, class and do-block bodies with rescue
+		// or ensure clauses
+		if node.Beginkeywordloc == nil && !p.pristine(node) {
+			if node.Statements != nil {
+				if err := p.bodyStatements(node.Statements); err != nil {
+					return err
+				}
+			}
+
+			p.indent--
+			err := p.beginClauses(node)
+			p.indent++
+
+			return err
+		}
+	}
+
+	p.newline()
+	return p.statement(node)
+}
+
+func (p *printer) beginClauses(node *parser.BeginNode) error {
+	for rescue := node.Rescueclause; rescue != nil; rescue = rescue.Consequent {
+		p.newline()
+		if err := p.rescue(rescue); err != nil {
+			return err
+		}
+	}
+
+	if node.Elseclause != nil {
+		p.newline()
+		p.write("else")
+		p.indent++
+		if node.Elseclause.Statements != nil {
+			if err := p.bodyStatements(node.Elseclause.Statements); err != nil {
+				return err
+			}
+		}
+		p.indent--
+	}
+
+	if node.Ensureclause != nil {
+		p.newline()
+		p.write("ensure")
+		p.indent++
+		if node.Ensureclause.Statements != nil {
+			if err := p.bodyStatements(node.Ensureclause.Statements); err != nil {
+				return err
+			}
+		}
+		p.indent--
+	}
+
+	return nil
+}
+
+func (p *printer) rescue(node *parser.RescueNode) error {
+	p.write("rescue")
+	if len(node.Exceptions) > 0 {
+		p.write(" ")
+		if err := p.list(node.Exceptions, p.argument); err != nil {
+			return err
+		}
+	}
+
+	if node.Reference != nil {
+		p.write(" => ")
+		if err := p.node(node.Reference); err != nil {
+			return err
+		}
+	}
+
+	p.indent++
+	if node.Statements != nil {
+		if err := p.bodyStatements(node.Statements); err != nil {
+			return err
+		}
+	}
+	p.indent--
+
+	return nil
+}
+
+// inline writes statements on a single line separated by semicolons.
+func (p *printer) inline(node parser.Node) error {
+	statements, ok := node.(*parser.StatementsNode)
+	if !ok || p.pristine(node) {
+		return p.statement(node)
+	}
+
+	for i, statement := range statements.Body {
+		if i > 0 {
+			p.write("; ")
+		}
+
+		if err := p.statement(statement); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *printer) pristine(node parser.Node) bool {
+	return p.unparser.pristine(node)
+}
+
+func (p *printer) node(node parser.Node) error {
+	if node == nil {
+		return nil
+	}
+
+	if p.pristine(node) {
+		p.write(p.unparser.slice(node.Location()))
+		return nil
+	}
+
+	switch node := node.(type) {
+	case *parser.ProgramNode:
+		for i, statement := range node.Statements.Body {
+			if i > 0 {
+				p.newline()
+			}
+
+			if err := p.statement(statement); err != nil {
+				return err
+			}
+		}
+
+		p.write("\n")
+		return nil
+	case *parser.StatementsNode:
+		return p.inline(node)
+	case *parser.MissingNode:
+		return ErrMissingNode
+
+	// literals
+	case *parser.NilNode:
+		p.write("nil")
+	case *parser.TrueNode:
+		p.write("true")
+	case *parser.FalseNode:
+		p.write("false")
+	case *parser.SelfNode:
+		p.write("self")
+	case *parser.SourceFileNode:
+		p.write("__FILE__")
+	case *parser.SourceLineNode:
+		p.write("__LINE__")
+	case *parser.SourceEncodingNode:
+		p.write("__ENCODING__")
+	case *parser.IntegerNode:
+		p.write(integer(node))
+	case *parser.FloatNode:
+		p.write(float(node.Value))
+	case *parser.RationalNode:
+		if err := p.node(node.Numeric); err != nil {
+			return err
+		}
+		p.write("r")
+	case *parser.ImaginaryNode:
+		if err := p.node(node.Numeric); err != nil {
+			return err
+		}
+		p.write("i")
+	case *parser.StringNode:
+		p.write(`"`, escape(node.Unescaped, '"'), `"`)
+	case *parser.InterpolatedStringNode:
+		p.write(`"`)
+		if err := p.parts(node.Parts, '"'); err != nil {
+			return err
+		}
+		p.write(`"`)
+	case *parser.XStringNode:
+		p.write("`", escape(node.Unescaped, '`'), "`")
+	case *parser.InterpolatedXStringNode:
+		p.write("`")
+		if err := p.parts(node.Parts, '`'); err != nil {
+			return err
+		}
+		p.write("`")
+	case *parser.SymbolNode:
+		p.write(symbol(node.Unescaped))
+	case *parser.InterpolatedSymbolNode:
+		p.write(`:"`)
+		if err := p.parts(node.Parts, '"'); err != nil {
+			return err
+		}
+		p.write(`"`)
+	case *parser.RegularExpressionNode:
+		p.write("/", escapeRegexp(node.Unescaped), "/", regexpOptions(node.Flags))
+	case *parser.MatchLastLineNode:
+		p.write("/", escapeRegexp(node.Unescaped), "/", regexpOptions(node.Flags))
+	case *parser.InterpolatedRegularExpressionNode:
+		p.write("/")
+		if err := p.regexpParts(node.Parts); err != nil {
+			return err
+		}
+		p.write("/", regexpOptions(node.Flags))
+	case *parser.InterpolatedMatchLastLineNode:
+		p.write("/")
+		if err := p.regexpParts(node.Parts); err != nil {
+			return err
+		}
+		p.write("/", regexpOptions(node.Flags))
+	case *parser.EmbeddedStatementsNode:
+		p.write("#{")
+		if node.Statements != nil {
+			if err := p.inline(node.Statements); err != nil {
+				return err
+			}
+		}
+		p.write("}")
+	case *parser.EmbeddedVariableNode:
+		p.write("#")
+		return p.node(node.Variable)
+	case *parser.ArrayNode:
+		p.write("[")
+		if err := p.list(node.Elements, p.argument); err != nil {
+			return err
+		}
+		p.write("]")
+	case *parser.HashNode:
+		if len(node.Elements) == 0 {
+			p.write("{}")
+			return nil
+		}
+
+		p.write("{ ")
+		if err := p.list(node.Elements, p.argument); err != nil {
+			return err
+		}
+		p.write(" }")
+	case *parser.KeywordHashNode:
+		return p.list(node.Elements, p.argument)
+	case *parser.AssocNode:
+		return p.assoc(node)
+	case *parser.AssocSplatNode:
+		p.write("**")
+		return p.operandOrNil(node.Value)
+	case *parser.ImplicitNode:
+		return p.node(node.Value)
+	case *parser.RangeNode:
+		return p.rangeLike(node.Left, node.Right, node.Flags&parser.RANGE_EXCLUDE_END != 0)
+	case *parser.FlipFlopNode:
+		return p.rangeLike(node.Left, node.Right, node.Flags&parser.RANGE_EXCLUDE_END != 0)
+
+	// variables
+	case *parser.LocalVariableReadNode:
+		// prism names the implicit it parameter 0it
+		p.write(strings.TrimPrefix(node.Name, "0"))
+	case *parser.LocalVariableTargetNode:
+		p.write(node.Name)
+	case *parser.InstanceVariableReadNode:
+		p.write(node.Name)
+	case *parser.InstanceVariableTargetNode:
+		p.write(node.Name)
+	case *parser.ClassVariableReadNode:
+		p.write(node.Name)
+	case *parser.ClassVariableTargetNode:
+		p.write(node.Name)
+	case *parser.GlobalVariableReadNode:
+		p.write(node.Name)
+	case *parser.GlobalVariableTargetNode:
+		p.write(node.Name)
+	case *parser.BackReferenceReadNode:
+		p.write(node.Name)
+	case *parser.NumberedReferenceReadNode:
+		p.write("$", strconv.FormatUint(uint64(node.Number), 10))
+	case *parser.ConstantReadNode:
+		p.write(node.Name)
+	case *parser.ConstantTargetNode:
+		p.write(node.Name)
+	case *parser.ConstantPathNode:
+		return p.constantPath(node.Parent, node.Child)
+	case *parser.ConstantPathTargetNode:
+		return p.constantPath(node.Parent, node.Child)
+
+	// writes
+	case *parser.LocalVariableWriteNode:
+		return p.write_(node.Name, "=", node.Value)
+	case *parser.LocalVariableAndWriteNode:
+		return p.write_(node.Name, "&&=", node.Value)
+	case *parser.LocalVariableOrWriteNode:
+		return p.write_(node.Name, "||=", node.Value)
+	case *parser.LocalVariableOperatorWriteNode:
+		return p.write_(node.Name, node.Operator+"=", node.Value)
+	case *parser.InstanceVariableWriteNode:
+		return p.write_(node.Name, "=", node.Value)
+	case *parser.InstanceVariableAndWriteNode:
+		return p.write_(node.Name, "&&=", node.Value)
+	case *parser.InstanceVariableOrWriteNode:
+		return p.write_(node.Name, "||=", node.Value)
+	case *parser.InstanceVariableOperatorWriteNode:
+		return p.write_(node.Name, node.Operator+"=", node.Value)
+	case *parser.ClassVariableWriteNode:
+		return p.write_(node.Name, "=", node.Value)
+	case *parser.ClassVariableAndWriteNode:
+		return p.write_(node.Name, "&&=", node.Value)
+	case *parser.ClassVariableOrWriteNode:
+		return p.write_(node.Name, "||=", node.Value)
+	case *parser.ClassVariableOperatorWriteNode:
+		return p.write_(node.Name, node.Operator+"=", node.Value)
+	case *parser.GlobalVariableWriteNode:
+		return p.write_(node.Name, "=", node.Value)
+	case *parser.GlobalVariableAndWriteNode:
+		return p.write_(node.Name, "&&=", node.Value)
+	case *parser.GlobalVariableOrWriteNode:
+		return p.write_(node.Name, "||=", node.Value)
+	case *parser.GlobalVariableOperatorWriteNode:
+		return p.write_(node.Name, node.Operator+"=", node.Value)
+	case *parser.ConstantWriteNode:
+		return p.write_(node.Name, "=", node.Value)
+	case *parser.ConstantAndWriteNode:
+		return p.write_(node.Name, "&&=", node.Value)
+	case *parser.ConstantOrWriteNode:
+		return p.write_(node.Name, "||=", node.Value)
+	case *parser.ConstantOperatorWriteNode:
+		return p.write_(node.Name, node.Operator+"=", node.Value)
+	case *parser.ConstantPathWriteNode:
+		return p.targetWrite(node.Target, "=", node.Value)
+	case *parser.ConstantPathAndWriteNode:
+		return p.targetWrite(node.Target, "&&=", node.Value)
+	case *parser.ConstantPathOrWriteNode:
+		return p.targetWrite(node.Target, "||=", node.Value)
+	case *parser.ConstantPathOperatorWriteNode:
+		return p.targetWrite(node.Target, node.Operator+"=", node.Value)
+	case *parser.CallAndWriteNode:
+		return p.callWrite(node.Receiver, node.IsSafeNavigation(), node.Readname, "&&=", node.Value)
+	case *parser.CallOrWriteNode:
+		return p.callWrite(node.Receiver, node.IsSafeNavigation(), node.Readname, "||=", node.Value)
+	case *parser.CallOperatorWriteNode:
+		return p.callWrite(node.Receiver, node.IsSafeNavigation(), node.Readname, node.Operator+"=", node.Value)
+	case *parser.IndexAndWriteNode:
+		return p.indexWrite(node.Receiver, node.Arguments, node.Block, "&&=", node.Value)
+	case *parser.IndexOrWriteNode:
+		return p.indexWrite(node.Receiver, node.Arguments, node.Block, "||=", node.Value)
+	case *parser.IndexOperatorWriteNode:
+		return p.indexWrite(node.Receiver, node.Arguments, node.Block, node.Operator+"=", node.Value)
+	case *parser.MultiWriteNode:
+		if err := p.targets(node.Lefts, node.Rest, node.Rights); err != nil {
+			return err
+		}
+
+		p.write(" = ")
+		if array, ok := node.Value.(*parser.ArrayNode); ok && array.Openingloc == nil && !p.pristine(array) {
+			return p.list(array.Elements, p.argument)
+		}
+
+		return p.argument(node.Value)
+	case *parser.MultiTargetNode:
+		p.write("(")
+		if err := p.targets(node.Lefts, node.Rest, node.Rights); err != nil {
+			return err
+		}
+		p.write(")")
+	case *parser.CallTargetNode:
+		if err := p.operand(node.Receiver); err != nil {
+			return err
+		}
+		p.write(callOperator(node.IsSafeNavigation()), strings.TrimSuffix(node.Name, "="))
+	case *parser.IndexTargetNode:
+		if err := p.operand(node.Receiver); err != nil {
+			return err
+		}
+		p.write("[")
+		if err := p.arguments(node.Arguments, node.Block); err != nil {
+			return err
+		}
+		p.write("]")
+	case *parser.SplatNode:
+		p.write("*")
+		return p.operandOrNil(node.Expression)
+	case *parser.ImplicitRestNode:
+		// written by the enclosing target list as a trailing comma
+
+	// calls
+	case *parser.CallNode:
+		return p.call(node)
+	case *parser.ArgumentsNode:
+		return p.arguments(node, nil)
+	case *parser.BlockArgumentNode:
+		p.write("&")
+		return p.operandOrNil(node.Expression)
+	case *parser.ForwardingArgumentsNode:
+		p.write("...")
+	case *parser.BlockNode:
+		return p.block(node.Parameters, node.Body)
+	case *parser.LambdaNode:
+		p.write("->")
+		if params, ok := node.Parameters.(*parser.BlockParametersNode); ok {
+			p.write("(")
+			if err := p.blockParameters(params); err != nil {
+				return err
+			}
+			p.write(")")
+		}
+
+		p.write(" ")
+		return p.block(nil, node.Body)
+	case *parser.SuperNode:
+		p.write("super(")
+		if err := p.arguments(node.Arguments, blockArgument(node.Block)); err != nil {
+			return err
+		}
+		p.write(")")
+		return p.trailingBlock(node.Block)
+	case *parser.ForwardingSuperNode:
+		p.write("super")
+		if node.Block != nil {
+			return p.trailingBlock(node.Block)
+		}
+	case *parser.YieldNode:
+		p.write("yield")
+		if node.Arguments != nil {
+			p.write("(")
+			if err := p.arguments(node.Arguments, nil); err != nil {
+				return err
+			}
+			p.write(")")
+		}
+	case *parser.MatchWriteNode:
+		return p.node(node.Call)
+
+	// operators
+	case *parser.AndNode:
+		return p.binary(node.Left, "&&", node.Right)
+	case *parser.OrNode:
+		return p.binary(node.Left, "||", node.Right)
+	case *parser.DefinedNode:
+		p.write("defined?(")
+		if err := p.statement(node.Value); err != nil {
+			return err
+		}
+		p.write(")")
+	case *parser.ParenthesesNode:
+		p.write("(")
+		if statements, ok := node.Body.(*parser.StatementsNode); ok && len(statements.Body) == 1 && p.isEndless(statements.Body[0]) {
+			// the parentheses are already there
+			if err := p.rangeBody(statements.Body[0]); err != nil {
+				return err
+			}
+			p.write(")")
+			return nil
+		}
+
+		if node.Body != nil {
+			if err := p.inline(node.Body); err != nil {
+				return err
+			}
+		}
+		p.write(")")
+	case *parser.RescueModifierNode:
+		if err := p.operand(node.Expression); err != nil {
+			return err
+		}
+		p.write(" rescue ")
+		return p.operand(node.Rescueexpression)
+
+	// control flow
+	case *parser.IfNode:
+		p.write("if ")
+		return p.conditional(node.Predicate, node.Statements, node.Consequent)
+	case *parser.UnlessNode:
+		p.write("unless ")
+		var consequent parser.Node
+		if node.Consequent != nil {
+			consequent = node.Consequent
+		}
+		return p.conditional(node.Predicate, node.Statements, consequent)
+	case *parser.ElseNode:
+		p.write("else")
+		if node.Statements != nil {
+			p.indent++
+			err := p.bodyStatements(node.Statements)
+			p.indent--
+			return err
+		}
+	case *parser.WhileNode:
+		return p.loop("while", node.Predicate, node.Statements, node.IsBeginModifier())
+	case *parser.UntilNode:
+		return p.loop("until", node.Predicate, node.Statements, node.IsBeginModifier())
+	case *parser.ForNode:
+		p.write("for ")
+		if target, ok := node.Index.(*parser.MultiTargetNode); ok && !p.pristine(target) {
+			if err := p.targets(target.Lefts, target.Rest, target.Rights); err != nil {
+				return err
+			}
+		} else if err := p.node(node.Index); err != nil {
+			return err
+		}
+
+		p.write(" in ")
+		if err := p.argument(node.Collection); err != nil {
+			return err
+		}
+
+		if err := p.body(statementsOrNil(node.Statements)); err != nil {
+			return err
+		}
+		p.write("end")
+	case *parser.CaseNode:
+		return p.caseLike(node.Predicate, node.Conditions, node.Consequent)
+	case *parser.CaseMatchNode:
+		return p.caseLike(node.Predicate, node.Conditions, node.Consequent)
+	case *parser.WhenNode:
+		p.write("when ")
+		if err := p.list(node.Conditions, p.argument); err != nil {
+			return err
+		}
+
+		if node.Statements != nil {
+			p.indent++
+			err := p.bodyStatements(node.Statements)
+			p.indent--
+			return err
+		}
+	case *parser.InNode:
+		p.write("in ")
+		if err := p.inPattern(node.Pattern); err != nil {
+			return err
+		}
+
+		if node.Statements != nil {
+			p.indent++
+			err := p.bodyStatements(node.Statements)
+			p.indent--
+			return err
+		}
+	case *parser.BeginNode:
+		p.write("begin")
+		p.indent++
+		if node.Statements != nil {
+			if err := p.bodyStatements(node.Statements); err != nil {
+				return err
+			}
+		}
+		p.indent--
+
+		if err := p.beginClauses(node); err != nil {
+			return err
+		}
+		p.newline()
+		p.write("end")
+	case *parser.RescueNode:
+		return p.rescue(node)
+	case *parser.EnsureNode:
+		p.write("ensure")
+		if node.Statements != nil {
+			p.indent++
+			err := p.bodyStatements(node.Statements)
+			p.indent--
+			return err
+		}
+	case *parser.ReturnNode:
+		return p.jump("return", node.Arguments)
+	case *parser.BreakNode:
+		return p.jump("break", node.Arguments)
+	case *parser.NextNode:
+		return p.jump("next", node.Arguments)
+	case *parser.RedoNode:
+		p.write("redo")
+	case *parser.RetryNode:
+		p.write("retry")
+	case *parser.PreExecutionNode:
+		p.write("BEGIN { ")
+		if node.Statements != nil {
+			if err := p.inline(node.Statements); err != nil {
+				return err
+			}
+		}
+		p.write(" }")
+	case *parser.PostExecutionNode:
+		p.write("END { ")
+		if node.Statements != nil {
+			if err := p.inline(node.Statements); err != nil {
+				return err
+			}
+		}
+		p.write(" }")
+
+	// definitions
+	case *parser.DefNode:
+		return p.def(node)
+	case *parser.ParametersNode:
+		return p.parameters(node)
+	case *parser.BlockParametersNode:
+		p.write("|")
+		if err := p.blockParameters(node); err != nil {
+			return err
+		}
+		p.write("|")
+	case *parser.RequiredParameterNode:
+		p.write(node.Name)
+	case *parser.OptionalParameterNode:
+		p.write(node.Name, " = ")
+		return p.argument(node.Value)
+	case *parser.RestParameterNode:
+		p.write("*", deref(node.Name))
+	case *parser.RequiredKeywordParameterNode:
+		p.write(node.Name, ":")
+	case *parser.OptionalKeywordParameterNode:
+		p.write(node.Name, ": ")
+		return p.argument(node.Value)
+	case *parser.KeywordRestParameterNode:
+		p.write("**", deref(node.Name))
+	case *parser.NoKeywordsParameterNode:
+		p.write("**nil")
+	case *parser.ForwardingParameterNode:
+		p.write("...")
+	case *parser.BlockParameterNode:
+		p.write("&", deref(node.Name))
+	case *parser.BlockLocalVariableNode:
+		p.write(node.Name)
+	case *parser.NumberedParametersNode, *parser.ItParametersNode:
+		// implicit, nothing to write
+	case *parser.ClassNode:
+		p.write("class ")
+		if err := p.node(node.Constantpath); err != nil {
+			return err
+		}
+
+		if node.Superclass != nil {
+			p.write(" < ")
+			if err := p.operand(node.Superclass); err != nil {
+				return err
+			}
+		}
+
+		if err := p.body(node.Body); err != nil {
+			return err
+		}
+		p.write("end")
+	case *parser.ModuleNode:
+		p.write("module ")
+		if err := p.node(node.Constantpath); err != nil {
+			return err
+		}
+
+		if err := p.body(node.Body); err != nil {
+			return err
+		}
+		p.write("end")
+	case *parser.SingletonClassNode:
+		p.write("class << ")
+		if err := p.operand(node.Expression); err != nil {
+			return err
+		}
+
+		if err := p.body(node.Body); err != nil {
+			return err
+		}
+		p.write("end")
+	case *parser.AliasMethodNode:
+		p.write("alias ")
+		if err := p.node(node.Newname); err != nil {
+			return err
+		}
+		p.write(" ")
+		return p.node(node.Oldname)
+	case *parser.AliasGlobalVariableNode:
+		p.write("alias ")
+		if err := p.node(node.Newname); err != nil {
+			return err
+		}
+		p.write(" ")
+		return p.node(node.Oldname)
+	case *parser.UndefNode:
+		p.write("undef ")
+		return p.list(node.Names, p.node)
+
+	// patterns
+	case *parser.MatchPredicateNode:
+		if err := p.operand(node.Value); err != nil {
+			return err
+		}
+		p.write(" in ")
+		return p.pattern(node.Pattern)
+	case *parser.MatchRequiredNode:
+		if err := p.operand(node.Value); err != nil {
+			return err
+		}
+		p.write(" => ")
+		return p.pattern(node.Pattern)
+	case *parser.ArrayPatternNode:
+		if err := p.node(node.Constant); err != nil {
+			return err
+		}
+
+		_, implicit := node.Rest.(*parser.ImplicitRestNode)
+
+		elements := append([]parser.Node{}, node.Requireds...)
+		if node.Rest != nil && !implicit {
+			elements = append(elements, node.Rest)
+		}
+		elements = append(elements, node.Posts...)
+
+		p.write("[")
+		if err := p.list(elements, p.pattern); err != nil {
+			return err
+		}
+
+		// [a,] matches arrays of any length starting with a
+		if implicit {
+			p.write(",")
+		}
+		p.write("]")
+	case *parser.FindPatternNode:
+		if err := p.node(node.Constant); err != nil {
+			return err
+		}
+
+		elements := append(append([]parser.Node{node.Left}, node.Requireds...), node.Right)
+
+		p.write("[")
+		if err := p.list(elements, p.pattern); err != nil {
+			return err
+		}
+		p.write("]")
+	case *parser.HashPatternNode:
+		if err := p.node(node.Constant); err != nil {
+			return err
+		}
+
+		elements := append([]parser.Node{}, node.Elements...)
+		if node.Rest != nil {
+			elements = append(elements, node.Rest)
+		}
+
+		if node.Constant != nil {
+			p.write("(")
+		} else {
+			p.write("{")
+		}
+
+		if err := p.list(elements, p.pattern); err != nil {
+			return err
+		}
+
+		if node.Constant != nil {
+			p.write(")")
+		} else {
+			p.write("}")
+		}
+	case *parser.AlternationPatternNode:
+		if err := p.pattern(node.Left); err != nil {
+			return err
+		}
+		p.write(" | ")
+		return p.pattern(node.Right)
+	case *parser.CapturePatternNode:
+		if err := p.pattern(node.Value); err != nil {
+			return err
+		}
+		p.write(" => ")
+		return p.node(node.Target)
+	case *parser.PinnedVariableNode:
+		p.write("^")
+		return p.node(node.Variable)
+	case *parser.PinnedExpressionNode:
+		p.write("^(")
+		if err := p.statement(node.Expression); err != nil {
+			return err
+		}
+		p.write(")")
+
+	default:
+		return fmt.Errorf("cannot unparse node of type %T", node)
+	}
+
+	return nil
+}
+
+func (p *printer) operandOrNil(node parser.Node) error {
+	if node == nil {
+		return nil
+	}
+
+	return p.operand(node)
+}
+
+func (p *printer) binary(left parser.Node, operator string, right parser.Node) error {
+	if err := p.operand(left); err != nil {
+		return err
+	}
+
+	p.write(" ", operator, " ")
+	return p.operand(right)
+}
+
+func (p *printer) rangeLike(left parser.Node, right parser.Node, excludeEnd bool) error {
+	// a range without an end swallows the next line, so it is always
+	// parenthesized
+	if right == nil {
+		p.write("(")
+		defer p.write(")")
+	}
+
+	if left != nil {
+		if err := p.operand(left); err != nil {
+			return err
+		}
+	}
+
+	if excludeEnd {
+		p.write("...")
+	} else {
+		p.write("..")
+	}
+
+	if right != nil {
+		return p.operand(right)
+	}
+
+	return nil
+}
+
+func (p *printer) isEndless(node parser.Node) bool {
+	switch node := node.(type) {
+	case *parser.RangeNode:
+		return node.Right == nil && !p.pristine(node)
+	case *parser.FlipFlopNode:
+		return node.Right == nil && !p.pristine(node)
+	}
+
+	return false
+}
+
+// rangeBody writes an endless range without its parentheses.
+func (p *printer) rangeBody(node parser.Node) error {
+	left, flags := parser.Node(nil), parser.RangeFlags(0)
+	switch node := node.(type) {
+	case *parser.RangeNode:
+		left, flags = node.Left, node.Flags
+	case *parser.FlipFlopNode:
+		left, flags = node.Left, node.Flags
+	}
+
+	if left != nil {
+		if err := p.operand(left); err != nil {
+			return err
+		}
+	}
+
+	if flags&parser.RANGE_EXCLUDE_END != 0 {
+		p.write("...")
+	} else {
+		p.write("..")
+	}
+
+	return nil
+}
+
+func (p *printer) write_(name string, operator string, value parser.Node) error {
+	p.write(name, " ", operator, " ")
+	return p.argument(value)
+}
+
+func (p *printer) targetWrite(target parser.Node, operator string, value parser.Node) error {
+	if err := p.node(target); err != nil {
+		return err
+	}
+
+	p.write(" ", operator, " ")
+	return p.argument(value)
+}
+
+func (p *printer) callWrite(receiver parser.Node, safeNavigation bool, name string, operator string, value parser.Node) error {
+	if receiver != nil {
+		if err := p.operand(receiver); err != nil {
+			return err
+		}
+		p.write(callOperator(safeNavigation))
+	}
+
+	p.write(name, " ", operator, " ")
+	return p.argument(value)
+}
+
+func (p *printer) indexWrite(receiver parser.Node, arguments *parser.ArgumentsNode, block parser.Node, operator string, value parser.Node) error {
+	if err := p.operand(receiver); err != nil {
+		return err
+	}
+
+	p.write("[")
+	if err := p.arguments(arguments, block); err != nil {
+		return err
+	}
+
+	p.write("] ", operator, " ")
+	return p.argument(value)
+}
+
+func (p *printer) constantPath(parent parser.Node, child parser.Node) error {
+	if parent != nil {
+		if err := p.operand(parent); err != nil {
+			return err
+		}
+	}
+
+	p.write("::")
+	return p.node(child)
+}
+
+func (p *printer) targets(lefts []parser.Node, rest parser.Node, rights []parser.Node) error {
+	targets := append([]parser.Node{}, lefts...)
+
+	_, implicit := rest.(*parser.ImplicitRestNode)
+	if rest != nil && !implicit {
+		targets = append(targets, rest)
+	}
+	targets = append(targets, rights...)
+
+	if err := p.list(targets, p.node); err != nil {
+		return err
+	}
+
+	// a, = value and a single target both need a trailing comma
+	if implicit || len(targets) == 1 && rest == nil {
+		p.write(",")
+	}
+
+	return nil
+}
+
+func (p *printer) assoc(node *parser.AssocNode) error {
+	if symbol, ok := node.Key.(*parser.SymbolNode); ok && !p.pristine(symbol) && isLabel(symbol.Unescaped) {
+		p.write(symbol.Unescaped, ":")
+		if _, ok := node.Value.(*parser.ImplicitNode); ok {
+			return nil
+		}
+
+		p.write(" ")
+		return p.argument(node.Value)
+	}
+
+	if err := p.argument(node.Key); err != nil {
+		return err
+	}
+
+	if _, ok := node.Value.(*parser.ImplicitNode); ok {
+		return nil
+	}
+
+	if p.pristine(node.Key) && strings.HasSuffix(p.unparser.slice(node.Key.Location()), ":") {
+		p.write(" ")
+	} else {
+		p.write(" => ")
+	}
+
+	return p.argument(node.Value)
+}
+
+func (p *printer) call(node *parser.CallNode) error {
+	name := node.Name
+
+	if node.Receiver != nil && node.Arguments == nil && node.Block == nil {
+		switch name {
+		case "!", "~", "-@", "+@":
+			p.write(strings.TrimSuffix(name, "@"))
+			return p.unaryOperand(node.Receiver)
+		}
+	}
+
+	if node.Receiver != nil && node.Arguments != nil && len(node.Arguments.Arguments) == 1 && node.Block == nil && isBinaryOperator(name) {
+		return p.binary(node.Receiver, name, node.Arguments.Arguments[0])
+	}
+
+	if node.Receiver != nil && name == "[]" {
+		if err := p.operand(node.Receiver); err != nil {
+			return err
+		}
+
+		p.write("[")
+		if err := p.arguments(node.Arguments, blockArgument(node.Block)); err != nil {
+			return err
+		}
+		p.write("]")
+
+		return p.trailingBlock(node.Block)
+	}
+
+	if node.Receiver != nil && name == "[]=" && node.Arguments != nil && len(node.Arguments.Arguments) > 0 && node.Block == nil {
+		arguments := node.Arguments.Arguments
+
+		if err := p.operand(node.Receiver); err != nil {
+			return err
+		}
+
+		p.write("[")
+		if err := p.list(arguments[:len(arguments)-1], p.argument); err != nil {
+			return err
+		}
+
+		p.write("] = ")
+		return p.argument(arguments[len(arguments)-1])
+	}
+
+	if node.Receiver != nil && isSetter(name) && node.Arguments != nil && len(node.Arguments.Arguments) == 1 && node.Block == nil {
+		if err := p.operand(node.Receiver); err != nil {
+			return err
+		}
+
+		p.write(callOperator(node.IsSafeNavigation()), strings.TrimSuffix(name, "="), " = ")
+		return p.argument(node.Arguments.Arguments[0])
+	}
+
+	if node.Receiver != nil {
+		if err := p.operand(node.Receiver); err != nil {
+			return err
+		}
+		p.write(callOperator(node.IsSafeNavigation()))
+	}
+
+	p.write(name)
+
+	block := blockArgument(node.Block)
+	if node.Arguments != nil || block != nil || !node.IsVariableCall() && node.Receiver == nil && node.Block == nil {
+		p.write("(")
+		if err := p.arguments(node.Arguments, block); err != nil {
+			return err
+		}
+		p.write(")")
+	}
+
+	return p.trailingBlock(node.Block)
+}
+
+// unaryOperand keeps -1 from turning into a negative literal and -x ** 2 from
+// changing meaning.
+func (p *printer) unaryOperand(node parser.Node) error {
+	switch node.(type) {
+	case *parser.IntegerNode, *parser.FloatNode, *parser.RationalNode, *parser.ImaginaryNode:
+		return p.parenthesized(node)
+	}
+
+	return p.operand(node)
+}
+
+func blockArgument(block parser.Node) parser.Node {
+	if argument, ok := block.(*parser.BlockArgumentNode); ok {
+		return argument
+	}
+
+	return nil
+}
+
+func (p *printer) trailingBlock(block parser.Node) error {
+	node, ok := block.(*parser.BlockNode)
+	if !ok {
+		return nil
+	}
+
+	p.write(" ")
+	return p.node(node)
+}
+
+func (p *printer) block(parameters parser.Node, body parser.Node) error {
+	// braces can't hold rescue or ensure clauses
+	open, close := "{", "}"
+	if begin, ok := body.(*parser.BeginNode); ok && begin.Beginkeywordloc == nil && !p.pristine(begin) {
+		open, close = "do", "end"
+	}
+
+	p.write(open)
+	if params, ok := parameters.(*parser.BlockParametersNode); ok {
+		p.write(" ")
+		if err := p.node(params); err != nil {
+			return err
+		}
+	}
+
+	if body == nil {
+		p.write(" ", close)
+		return nil
+	}
+
+	if err := p.body(body); err != nil {
+		return err
+	}
+	p.write(close)
+
+	return nil
+}
+
+func (p *printer) blockParameters(node *parser.BlockParametersNode) error {
+	if p.pristine(node) {
+		// the slice includes the pipes or parentheses, which are written by
+		// the caller
+		text := p.unparser.slice(node.Location())
+		p.write(strings.TrimSpace(text[1 : len(text)-1]))
+		return nil
+	}
+
+	if node.Parameters != nil {
+		if err := p.node(node.Parameters); err != nil {
+			return err
+		}
+	}
+
+	if len(node.Locals) > 0 {
+		p.write("; ")
+		return p.list(node.Locals, p.node)
+	}
+
+	return nil
+}
+
+func (p *printer) parameters(node *parser.ParametersNode) error {
+	parameters := append([]parser.Node{}, node.Requireds...)
+	parameters = append(parameters, node.Optionals...)
+
+	_, implicit := node.Rest.(*parser.ImplicitRestNode)
+	if node.Rest != nil && !implicit {
+		parameters = append(parameters, node.Rest)
+	}
+
+	parameters = append(parameters, node.Posts...)
+	parameters = append(parameters, node.Keywords...)
+	if node.Keywordrest != nil {
+		parameters = append(parameters, node.Keywordrest)
+	}
+	if node.Block != nil {
+		parameters = append(parameters, node.Block)
+	}
+
+	if err := p.list(parameters, p.node); err != nil {
+		return err
+	}
+
+	// |a,| drops the rest of the yielded array
+	if implicit {
+		p.write(",")
+	}
+
+	return nil
+}
+
+func (p *printer) def(node *parser.DefNode) error {
+	p.write("def ")
+	if node.Receiver != nil {
+		if err := p.operand(node.Receiver); err != nil {
+			return err
+		}
+		p.write(".")
+	}
+
+	p.write(node.Name)
+	if node.Parameters != nil {
+		p.write("(")
+		if err := p.node(node.Parameters); err != nil {
+			return err
+		}
+		p.write(")")
+	}
+
+	if err := p.body(node.Body); err != nil {
+		return err
+	}
+	p.write("end")
+
+	return nil
+}
+
+func (p *printer) conditional(predicate parser.Node, statements *parser.StatementsNode, consequent parser.Node) error {
+	if err := p.statement(predicate); err != nil {
+		return err
+	}
+
+	p.indent++
+	if statements != nil {
+		if err := p.bodyStatements(statements); err != nil {
+			return err
+		}
+	}
+	p.indent--
+
+	for consequent != nil {
+		p.newline()
+
+		elsif, ok := consequent.(*parser.IfNode)
+		if !ok || p.pristine(elsif) {
+			if err := p.elseClause(consequent); err != nil {
+				return err
+			}
+			break
+		}
+
+		p.write("elsif ")
+		if err := p.statement(elsif.Predicate); err != nil {
+			return err
+		}
+
+		p.indent++
+		if elsif.Statements != nil {
+			if err := p.bodyStatements(elsif.Statements); err != nil {
+				return err
+			}
+		}
+		p.indent--
+
+		consequent = elsif.Consequent
+	}
+
+	p.newline()
+	p.write("end")
+
+	return nil
+}
+
+func (p *printer) elseClause(node parser.Node) error {
+	if node, ok := node.(*parser.ElseNode); ok && !p.pristine(node) {
+		return p.node(node)
+	}
+
+	// a pristine else clause or a node that isn't one
+	p.write("else")
+	p.indent++
+	p.newline()
+	err := p.statement(node)
+	p.indent--
+
+	return err
+}
+
+func (p *printer) loop(keyword string, predicate parser.Node, statements *parser.StatementsNode, beginModifier bool) error {
+	if beginModifier && statements != nil {
+		if err := p.inline(statements); err != nil {
+			return err
+		}
+
+		p.write(" ", keyword, " ")
+		return p.operand(predicate)
+	}
+
+	p.write(keyword, " ")
+	if err := p.statement(predicate); err != nil {
+		return err
+	}
+
+	if err := p.body(statementsOrNil(statements)); err != nil {
+		return err
+	}
+	p.write("end")
+
+	return nil
+}
+
+func (p *printer) caseLike(predicate parser.Node, conditions []parser.Node, consequent *parser.ElseNode) error {
+	p.write("case")
+	if predicate != nil {
+		p.write(" ")
+		if err := p.statement(predicate); err != nil {
+			return err
+		}
+	}
+
+	for _, condition := range conditions {
+		p.newline()
+		if err := p.node(condition); err != nil {
+			return err
+		}
+	}
+
+	if consequent != nil {
+		p.newline()
+		if err := p.elseClause(consequent); err != nil {
+			return err
+		}
+	}
+
+	p.newline()
+	p.write("end")
+
+	return nil
+}
+
+func (p *printer) inPattern(node parser.Node) error {
+	// in pattern if guard
+	switch guard := node.(type) {
+	case *parser.IfNode:
+		if !p.pristine(guard) && guard.Statements != nil && len(guard.Statements.Body) == 1 {
+			if err := p.pattern(guard.Statements.Body[0]); err != nil {
+				return err
+			}
+			p.write(" if ")
+			return p.operand(guard.Predicate)
+		}
+	case *parser.UnlessNode:
+		if !p.pristine(guard) && guard.Statements != nil && len(guard.Statements.Body) == 1 {
+			if err := p.pattern(guard.Statements.Body[0]); err != nil {
+				return err
+			}
+			p.write(" unless ")
+			return p.operand(guard.Predicate)
+		}
+	}
+
+	return p.pattern(node)
+}
+
+func (p *printer) pattern(node parser.Node) error {
+	if node == nil {
+		return nil
+	}
+
+	if assoc, ok := node.(*parser.AssocNode); ok && !p.pristine(assoc) {
+		// keys of hash patterns are always labels
+		if symbol, ok := assoc.Key.(*parser.SymbolNode); ok && !p.pristine(symbol) {
+			if isLabel(symbol.Unescaped) {
+				p.write(symbol.Unescaped, ":")
+			} else {
+				p.write(`"`, escape(symbol.Unescaped, '"'), `":`)
+			}
+		} else if err := p.node(assoc.Key); err != nil {
+			return err
+		}
+
+		if _, ok := assoc.Value.(*parser.ImplicitNode); ok || assoc.Value == nil {
+			return nil
+		}
+
+		p.write(" ")
+		return p.pattern(assoc.Value)
+	}
+
+	if _, ok := node.(*parser.ImplicitRestNode); ok {
+		return nil
+	}
+
+	return p.node(node)
+}
+
+func (p *printer) jump(keyword string, arguments *parser.ArgumentsNode) error {
+	p.write(keyword)
+	if arguments == nil || len(arguments.Arguments) == 0 {
+		return nil
+	}
+
+	p.write(" ")
+	return p.arguments(arguments, nil)
+}
+
+func (p *printer) parts(parts []parser.Node, quote byte) error {
+	for _, part := range parts {
+		switch part := part.(type) {
+		case *parser.StringNode:
+			p.write(escape(part.Unescaped, quote))
+		case *parser.InterpolatedStringNode:
+			if err := p.parts(part.Parts, quote); err != nil {
+				return err
+			}
+		default:
+			if err := p.node(part); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *printer) regexpParts(parts []parser.Node) error {
+	for _, part := range parts {
+		if str, ok := part.(*parser.StringNode); ok {
+			p.write(escapeRegexp(str.Unescaped))
+			continue
+		}
+
+		if err := p.node(part); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isPrimary reports whether node can be the receiver or operand of an
+// operator without parentheses.
+func (p *printer) isPrimary(node parser.Node) bool {
+	switch node := node.(type) {
+	case *parser.IntegerNode:
+		return node.Value.Sign() >= 0
+	case *parser.FloatNode:
+		return node.Value >= 0
+	case *parser.RationalNode, *parser.ImaginaryNode:
+		return !strings.HasPrefix(p.peek(node), "-")
+	case *parser.NilNode, *parser.TrueNode, *parser.FalseNode, *parser.SelfNode,
+		*parser.SourceFileNode, *parser.SourceLineNode, *parser.SourceEncodingNode,
+		*parser.StringNode, *parser.InterpolatedStringNode, *parser.XStringNode, *parser.InterpolatedXStringNode,
+		*parser.SymbolNode, *parser.InterpolatedSymbolNode,
+		*parser.RegularExpressionNode, *parser.InterpolatedRegularExpressionNode,
+		*parser.ArrayNode, *parser.HashNode, *parser.ParenthesesNode,
+		*parser.LocalVariableReadNode, *parser.InstanceVariableReadNode, *parser.ClassVariableReadNode,
+		*parser.GlobalVariableReadNode, *parser.BackReferenceReadNode, *parser.NumberedReferenceReadNode,
+		*parser.ConstantReadNode, *parser.ConstantPathNode, *parser.DefinedNode,
+		*parser.LambdaNode, *parser.SuperNode, *parser.ForwardingSuperNode,
+		*parser.LocalVariableTargetNode, *parser.InstanceVariableTargetNode, *parser.ClassVariableTargetNode,
+		*parser.GlobalVariableTargetNode, *parser.ConstantTargetNode, *parser.ConstantPathTargetNode,
+		*parser.CallTargetNode, *parser.IndexTargetNode, *parser.MultiTargetNode:
+		return true
+	case *parser.BeginNode:
+		return node.Beginkeywordloc != nil
+	case *parser.YieldNode:
+		return node.Arguments == nil || node.Lparenloc != nil || !p.pristine(node)
+	case *parser.CallNode:
+		if !p.pristine(node) {
+			if isBinaryOperator(node.Name) || isSetter(node.Name) || node.Name == "[]=" {
+				return false
+			}
+
+			switch node.Name {
+			case "!", "~", "-@", "+@":
+				return node.Receiver == nil
+			}
+
+			return true
+		}
+
+		text := p.unparser.slice(node.Location())
+		if strings.HasPrefix(text, "not") || strings.HasPrefix(text, "!") || strings.HasPrefix(text, "-") || strings.HasPrefix(text, "+") || strings.HasPrefix(text, "~") {
+			return false
+		}
+
+		if isBinaryOperator(node.Name) || isSetter(node.Name) || node.Name == "[]=" {
+			return false
+		}
+
+		if node.Arguments != nil && node.Closingloc == nil {
+			return false
+		}
+
+		if block, ok := node.Block.(*parser.BlockNode); ok {
+			return p.unparser.slice(block.Openingloc) == "{"
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// isStatementLike reports whether node can't be an argument without
+// parentheses.
+func (p *printer) isStatementLike(node parser.Node) bool {
+	switch node := node.(type) {
+	case *parser.AndNode:
+		return p.pristine(node) && p.unparser.slice(node.Operatorloc) == "and"
+	case *parser.OrNode:
+		return p.pristine(node) && p.unparser.slice(node.Operatorloc) == "or"
+	case *parser.CallNode:
+		return p.pristine(node) && node.Messageloc != nil && p.unparser.slice(node.Messageloc) == "not" ||
+			p.pristine(node) && node.Arguments != nil && node.Closingloc == nil && !isBinaryOperator(node.Name) && node.Name != "[]" && !isSetter(node.Name) && node.Name != "[]="
+	case *parser.IfNode:
+		return p.pristine(node) && node.Ifkeywordloc != nil && node.Endkeywordloc == nil
+	case *parser.UnlessNode:
+		return p.pristine(node) && node.Endkeywordloc == nil
+	case *parser.WhileNode:
+		return p.pristine(node) && node.Closingloc == nil || node.IsBeginModifier()
+	case *parser.UntilNode:
+		return p.pristine(node) && node.Closingloc == nil || node.IsBeginModifier()
+	case *parser.RescueModifierNode, *parser.MultiWriteNode, *parser.ReturnNode, *parser.BreakNode,
+		*parser.NextNode, *parser.RedoNode, *parser.RetryNode, *parser.AliasMethodNode,
+		*parser.AliasGlobalVariableNode, *parser.UndefNode, *parser.MatchRequiredNode, *parser.MatchPredicateNode:
+		return true
+	case *parser.YieldNode:
+		return p.pristine(node) && node.Arguments != nil && node.Lparenloc == nil
+	}
+
+	return false
+}
+
+// peek returns the source the node would be written as.
+func (p *printer) peek(node parser.Node) string {
+	peeker := newPrinter(p.unparser)
+	if err := peeker.node(node); err != nil {
+		return ""
+	}
+
+	return peeker.String()
+}
+
+func statementsOrNil(statements *parser.StatementsNode) parser.Node {
+	if statements == nil {
+		return nil
+	}
+
+	return statements
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func callOperator(safeNavigation bool) string {
+	if safeNavigation {
+		return "&."
+	}
+
+	return "."
+}
+
+var binaryOperators = map[string]bool{
+	"+": true, "-": true, "*": true, "/": true, "%": true, "**": true,
+	"==": true, "!=": true, "<": true, ">": true, "<=": true, ">=": true, "<=>": true, "===": true,
+	"=~": true, "!~": true, "&": true, "|": true, "^": true, "<<": true, ">>": true,
+}
+
+func isBinaryOperator(name string) bool {
+	return binaryOperators[name]
+}
+
+var identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func isSetter(name string) bool {
+	return strings.HasSuffix(name, "=") && identifier.MatchString(strings.TrimSuffix(name, "="))
+}
+
+var label = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*[?!]?$`)
+
+func isLabel(name string) bool {
+	return label.MatchString(name)
+}
+
+var plainSymbol = regexp.MustCompile(`^(?:[A-Za-z_][A-Za-z0-9_]*[?!=]?|@@?[A-Za-z_][A-Za-z0-9_]*|\$[A-Za-z_][A-Za-z0-9_]*|\$[0-9]+|\$[~*$?!@/\\;,.=:<>"&` + "`" + `'+]|\[\]=?|[-+]@|[!~]|\*\*|[-+*/%<>&|^]|<<|>>|<=>?|>=|===?|=~|!=|!~)$`)
+
+func symbol(name string) string {
+	if plainSymbol.MatchString(name) && !strings.HasSuffix(name, "?=") && !strings.HasSuffix(name, "!=") || name == "!=" {
+		return ":" + name
+	}
+
+	return `:"` + escape(name, '"') + `"`
+}
+
+func integer(node *parser.IntegerNode) string {
+	value := node.Value
+	prefix := ""
+	if value.Sign() < 0 {
+		prefix = "-"
+		value = value.Abs(value)
+		defer value.Neg(value)
+	}
+
+	switch {
+	case node.Flags&parser.INTEGER_BASE_HEXADECIMAL != 0:
+		return prefix + "0x" + value.Text(16)
+	case node.Flags&parser.INTEGER_BASE_BINARY != 0:
+		return prefix + "0b" + value.Text(2)
+	case node.Flags&parser.INTEGER_BASE_OCTAL != 0:
+		return prefix + "0o" + value.Text(8)
+	}
+
+	return prefix + value.Text(10)
+}
+
+func float(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "Float::INFINITY"
+	case math.IsInf(value, -1):
+		return "-Float::INFINITY"
+	case math.IsNaN(value):
+		return "Float::NAN"
+	}
+
+	text := strconv.FormatFloat(value, 'g', -1, 64)
+	if mantissa, exponent, ok := strings.Cut(text, "e"); ok {
+		if !strings.Contains(mantissa, ".") {
+			mantissa += ".0"
+		}
+
+		return mantissa + "e" + exponent
+	}
+
+	if !strings.Contains(text, ".") {
+		text += ".0"
+	}
+
+	return text
+}
+
+// escape returns s as the content of a double quoted string, backtick string
+// or quoted symbol delimited by quote.
+func escape(s string, quote byte) string {
+	var b strings.Builder
+
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+
+		switch {
+		case r == utf8.RuneError && size == 1:
+			fmt.Fprintf(&b, `\x%02X`, s[i])
+		case r == rune(quote) || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '#' && i+1 < len(s) && (s[i+1] == '{' || s[i+1] == '$' || s[i+1] == '@'):
+			b.WriteString(`\#`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == 0x1b:
+			b.WriteString(`\e`)
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&b, `\x%02X`, r)
+		default:
+			b.WriteRune(r)
+		}
+
+		i += size
+	}
+
+	return b.String()
+}
+
+// escapeRegexp returns s as the content of a /regexp/ literal. Regular
+// expression escapes are kept as they are, only unescaped slashes and
+// interpolation starts need escaping.
+func escapeRegexp(s string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case c == '\\' && i+1 < len(s):
+			b.WriteByte(c)
+			b.WriteByte(s[i+1])
+			i++
+		case c == '/':
+			b.WriteString(`\/`)
+		case c == '#' && i+1 < len(s) && (s[i+1] == '{' || s[i+1] == '$' || s[i+1] == '@'):
+			b.WriteString(`\#`)
+		case c == '\n':
+			b.WriteString(`\n`)
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
+
+func regexpOptions(flags parser.RegularExpressionFlags) string {
+	options := ""
+	if flags&parser.REGULAR_EXPRESSION_IGNORE_CASE != 0 {
+		options += "i"
+	}
+	if flags&parser.REGULAR_EXPRESSION_EXTENDED != 0 {
+		options += "x"
+	}
+	if flags&parser.REGULAR_EXPRESSION_MULTI_LINE != 0 {
+		options += "m"
+	}
+	if flags&parser.REGULAR_EXPRESSION_ONCE != 0 {
+		options += "o"
+	}
+	if flags&parser.REGULAR_EXPRESSION_EUC_JP != 0 {
+		options += "e"
+	}
+	if flags&parser.REGULAR_EXPRESSION_ASCII_8BIT != 0 {
+		options += "n"
+	}
+	if flags&parser.REGULAR_EXPRESSION_WINDOWS_31J != 0 {
+		options += "s"
+	}
+	if flags&parser.REGULAR_EXPRESSION_UTF_8 != 0 {
+		options += "u"
+	}
+
+	return options
+}
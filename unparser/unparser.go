@@ -0,0 +1,176 @@
+package unparser
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+)
+
+// Unparser turns a tree back into ruby source. Subtrees that are unchanged
+// since the tree was parsed are copied verbatim from the original source,
+// comments and formatting included. Everything else, including nodes built
+// with the NewXxxNode constructors, is synthesized.
+type Unparser struct {
+	source   []byte
+	original map[parser.Node]*snapshot
+}
+
+type snapshot struct {
+	fingerprint string
+	sliceable   bool
+}
+
+// NewUnparser records the state of every node in result so that later calls
+// to Unparse can tell which subtrees were modified. Without a source, like in
+// a result decoded from JSON without it, every node is synthesized.
+func NewUnparser(result *parser.ParseResult) *Unparser {
+	u := &Unparser{
+		original: make(map[parser.Node]*snapshot),
+	}
+
+	if result.Source == nil {
+		return u
+	}
+	u.source = result.Source.Source
+
+	if result.Value != nil {
+		u.record(result.Value)
+	}
+
+	return u
+}
+
+// Unparse returns ruby source for node, reusing the original source for
+// unmodified subtrees.
+func (u *Unparser) Unparse(node parser.Node) (string, error) {
+	// an untouched program is the whole original source, which also keeps
+	// leading comments and the __END__ section
+	if program, ok := node.(*parser.ProgramNode); ok && u.pristine(program) {
+		return string(u.source), nil
+	}
+
+	p := newPrinter(u)
+	if err := p.statement(node); err != nil {
+		return "", err
+	}
+
+	return p.String(), nil
+}
+
+// Unparse synthesizes ruby source for node without looking at any original
+// source.
+func Unparse(node parser.Node) (string, error) {
+	p := newPrinter(nil)
+	if err := p.statement(node); err != nil {
+		return "", err
+	}
+
+	return p.String(), nil
+}
+
+// record snapshots every node below root and returns the byte range covered
+// by root and all of its descendants.
+func (u *Unparser) record(root parser.Node) (uint32, uint32) {
+	start, end := uint32(1<<32-1), uint32(0)
+	extend := func(s, e uint32) {
+		start = min(start, s)
+		end = max(end, e)
+	}
+
+	for _, loc := range locations(root) {
+		extend(loc.StartOffset, loc.EndOffset())
+	}
+
+	for _, child := range root.NamedChildren() {
+		if child.Node != nil {
+			extend(u.record(child.Node))
+		}
+	}
+
+	loc := root.Location()
+	u.original[root] = &snapshot{
+		fingerprint: fingerprint(root),
+		// heredoc bodies sit outside the location of the node that opens
+		// them, so such nodes can't be copied from a single slice
+		sliceable: loc != nil && start >= loc.StartOffset && end <= loc.EndOffset(),
+	}
+
+	return start, end
+}
+
+// pristine reports whether node and all of its descendants are unchanged and
+// can be copied from the original source.
+func (u *Unparser) pristine(node parser.Node) bool {
+	if u == nil || node == nil {
+		return false
+	}
+
+	snap, ok := u.original[node]
+	if !ok || !snap.sliceable || snap.fingerprint != fingerprint(node) {
+		return false
+	}
+
+	for _, child := range node.NamedChildren() {
+		if child.Node != nil && !u.pristine(child.Node) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (u *Unparser) slice(loc *parser.Location) string {
+	return string(u.source[loc.StartOffset:loc.EndOffset()])
+}
+
+var locationType = reflect.TypeOf((*parser.Location)(nil))
+
+func locations(node parser.Node) []*parser.Location {
+	value := reflect.ValueOf(node).Elem()
+
+	locs := make([]*parser.Location, 0)
+	for i := range value.NumField() {
+		field := value.Field(i)
+		if field.Type() == locationType && !field.IsNil() {
+			locs = append(locs, field.Interface().(*parser.Location))
+		}
+	}
+
+	return locs
+}
+
+// fingerprint describes the shallow state of node: its scalar fields by value
+// and its children by identity.
+func fingerprint(node parser.Node) string {
+	value := reflect.ValueOf(node).Elem()
+
+	var b strings.Builder
+	for i := range value.NumField() {
+		field := value.Field(i)
+
+		switch {
+		case field.Kind() == reflect.Pointer && field.IsNil():
+			b.WriteString("nil")
+		case field.Type() == locationType:
+			loc := field.Interface().(*parser.Location)
+			fmt.Fprintf(&b, "%d:%d", loc.StartOffset, loc.Length)
+		case field.Kind() == reflect.Interface || field.Kind() == reflect.Pointer && field.Type().Implements(nodeType):
+			fmt.Fprintf(&b, "%p", field.Interface())
+		case field.Kind() == reflect.Slice && field.Type().Elem() == nodeType:
+			for j := range field.Len() {
+				fmt.Fprintf(&b, "%p,", field.Index(j).Interface())
+			}
+		default:
+			// strings, numbers, flags, *string, []string and *big.Int
+			fmt.Fprintf(&b, "%v", reflect.Indirect(field).Interface())
+		}
+
+		b.WriteByte(';')
+	}
+
+	return b.String()
+}
+
+var nodeType = reflect.TypeOf((*parser.Node)(nil)).Elem()
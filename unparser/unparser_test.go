@@ -0,0 +1,328 @@
+package unparser_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tjgurwara99/go-ruby-prism/parser"
+	"github.com/tjgurwara99/go-ruby-prism/unparser"
+)
+
+func newParser(t *testing.T) *parser.Parser {
+	t.Helper()
+
+	ctx := context.Background()
+	p, err := parser.NewParser(ctx)
+	if err != nil {
+		t.Fatalf("failed to create parser: %s", err)
+	}
+	t.Cleanup(func() { p.Close(ctx) })
+
+	return p
+}
+
+func parse(t *testing.T, p *parser.Parser, source string) *parser.ParseResult {
+	t.Helper()
+
+	result, err := p.Parse(context.Background(), []byte(source))
+	if err != nil {
+		t.Fatalf("failed to parse %q: %s", source, err)
+	}
+
+	if len(result.SynError) > 0 {
+		t.Fatalf("unexpected syntax error in %q: %s", source, result.SynError[0].Message)
+	}
+
+	return result
+}
+
+func corpus(t *testing.T) []string {
+	t.Helper()
+
+	data, err := os.ReadFile("testdata/corpus.rb")
+	if err != nil {
+		t.Fatalf("failed to read corpus: %s", err)
+	}
+
+	return strings.Split(strings.TrimSpace(string(data)), "\n\n")
+}
+
+// shape returns the tree as generic JSON without locations and flags, which
+// differ between the original and the synthesized source.
+func shape(t *testing.T, node parser.Node) interface{} {
+	t.Helper()
+
+	data, err := json.Marshal(node)
+	if err != nil {
+		t.Fatalf("failed to marshal: %s", err)
+	}
+
+	var tree interface{}
+	if err := json.Unmarshal(data, &tree); err != nil {
+		t.Fatalf("failed to unmarshal: %s", err)
+	}
+
+	return normalize(tree)
+}
+
+func normalize(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		// synthesized code adds parentheses around nested expressions
+		if value["nodeName"] == "ParenthesesNode" {
+			if body, ok := value["body"].(map[string]interface{}); ok {
+				if statements, ok := body["body"].([]interface{}); ok && len(statements) == 1 {
+					return normalize(statements[0])
+				}
+			}
+		}
+
+		// adjacent string literals and heredoc lines become a single string
+		if value["nodeName"] == "InterpolatedStringNode" {
+			parts := mergeStrings(value["parts"].([]interface{}))
+			if len(parts) == 1 && parts[0].(map[string]interface{})["nodeName"] == "StringNode" {
+				return normalize(parts[0])
+			}
+
+			value["parts"] = parts
+		}
+
+		for key, v := range value {
+			if key == "loc" || strings.HasSuffix(key, "Loc") || key == "flags" {
+				delete(value, key)
+				continue
+			}
+
+			value[key] = normalize(v)
+		}
+	case []interface{}:
+		for i, v := range value {
+			value[i] = normalize(v)
+		}
+	}
+
+	return value
+}
+
+func mergeStrings(parts []interface{}) []interface{} {
+	merged := make([]interface{}, 0, len(parts))
+
+	for _, part := range parts {
+		node := part.(map[string]interface{})
+		if node["nodeName"] == "InterpolatedStringNode" {
+			merged = append(merged, mergeStrings(node["parts"].([]interface{}))...)
+			continue
+		}
+
+		if node["nodeName"] == "StringNode" && len(merged) > 0 {
+			last := merged[len(merged)-1].(map[string]interface{})
+			if last["nodeName"] == "StringNode" {
+				last["unescaped"] = last["unescaped"].(string) + node["unescaped"].(string)
+				continue
+			}
+		}
+
+		copied := make(map[string]interface{}, len(node))
+		for key, v := range node {
+			copied[key] = v
+		}
+		merged = append(merged, copied)
+	}
+
+	return merged
+}
+
+func TestUnparseSynthesizesEquivalentSource(t *testing.T) {
+	p := newParser(t)
+
+	for _, source := range corpus(t) {
+		result := parse(t, p, source)
+
+		synthesized, err := unparser.Unparse(result.Value)
+		if err != nil {
+			t.Errorf("failed to unparse %q: %s", source, err)
+			continue
+		}
+
+		reparsed := parse(t, p, synthesized)
+		if !reflect.DeepEqual(shape(t, result.Value), shape(t, reparsed.Value)) {
+			t.Errorf("unparsing %q changed its meaning, got:\n%s", source, synthesized)
+			continue
+		}
+
+		// synthesized source is stable
+		again, err := unparser.Unparse(reparsed.Value)
+		if err != nil {
+			t.Errorf("failed to unparse %q: %s", synthesized, err)
+		} else if again != synthesized {
+			t.Errorf("expected unparsing to be stable, got:\n%s\nthen:\n%s", synthesized, again)
+		}
+	}
+}
+
+func TestUnparserSlicesUnmodifiedStatements(t *testing.T) {
+	p := newParser(t)
+
+	for _, source := range corpus(t) {
+		result := parse(t, p, source)
+		u := unparser.NewUnparser(result)
+
+		// a fresh program node is synthesized, its statements are copied
+		program := result.Value.(*parser.ProgramNode)
+		copied := parser.NewProgramNode(program.Locals, parser.NewStatementsNode(program.Statements.Body, nil), nil)
+
+		unparsed, err := u.Unparse(copied)
+		if err != nil {
+			t.Errorf("failed to unparse %q: %s", source, err)
+			continue
+		}
+
+		reparsed := parse(t, p, unparsed)
+		if !reflect.DeepEqual(shape(t, result.Value), shape(t, reparsed.Value)) {
+			t.Errorf("unparsing %q changed its meaning, got:\n%s", source, unparsed)
+		}
+	}
+}
+
+func TestUnparserReturnsUnmodifiedSource(t *testing.T) {
+	source := "# frozen_string_literal: true\n\nfoo  bar, baz # comment\n__END__\ndata\n"
+	result := parse(t, newParser(t), source)
+
+	unparsed, err := unparser.NewUnparser(result).Unparse(result.Value)
+	if err != nil {
+		t.Fatalf("failed to unparse: %s", err)
+	}
+
+	if unparsed != source {
+		t.Errorf("expected the original source, got %q", unparsed)
+	}
+}
+
+func TestUnparserWithoutSource(t *testing.T) {
+	data, err := json.Marshal(parse(t, newParser(t), "foo  bar, baz\n"))
+	if err != nil {
+		t.Fatalf("failed to marshal: %s", err)
+	}
+
+	// JSON leaves the source out by default
+	var result parser.ParseResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("failed to unmarshal: %s", err)
+	}
+
+	unparsed, err := unparser.NewUnparser(&result).Unparse(result.Value)
+	if err != nil {
+		t.Fatalf("failed to unparse: %s", err)
+	}
+
+	if expected := "foo(bar, baz)\n"; unparsed != expected {
+		t.Errorf("expected %q, got %q", expected, unparsed)
+	}
+}
+
+func TestUnparserSynthesizesModifiedNodes(t *testing.T) {
+	source := "def foo(a,b)\n  bar( a )\n  baz   a,b\nend\n"
+	result := parse(t, newParser(t), source)
+	u := unparser.NewUnparser(result)
+
+	def := result.Value.(*parser.ProgramNode).Statements.Body[0].(*parser.DefNode)
+	def.Body.(*parser.StatementsNode).Body[1].(*parser.CallNode).Name = "qux"
+
+	unparsed, err := u.Unparse(result.Value)
+	if err != nil {
+		t.Fatalf("failed to unparse: %s", err)
+	}
+
+	expected := "def foo(a,b)\n  bar( a )\n  qux(a, b)\nend\n"
+	if unparsed != expected {
+		t.Errorf("expected %q, got %q", expected, unparsed)
+	}
+}
+
+func TestUnparserWithoutLocationFields(t *testing.T) {
+	source := "def foo(a,b)\n  bar( a )\n  baz   a,b\nend\n"
+	result := parse(t, newParser(t), source)
+
+	// as loaded from a blob with only semantic fields, in which nodes keep
+	// their own location
+	locationType := reflect.TypeOf((*parser.Location)(nil))
+	parser.Walk(result.Value, func(node parser.Node) parser.WalkAction {
+		value := reflect.ValueOf(node).Elem()
+		for i := range value.NumField() {
+			if value.Type().Field(i).Name != "Loc" && value.Field(i).Type() == locationType {
+				value.Field(i).SetZero()
+			}
+		}
+
+		return parser.WalkContinue
+	}, nil)
+
+	u := unparser.NewUnparser(result)
+
+	unparsed, err := u.Unparse(result.Value)
+	if err != nil {
+		t.Fatalf("failed to unparse: %s", err)
+	}
+
+	if unparsed != source {
+		t.Errorf("expected the original source, got %q", unparsed)
+	}
+
+	def := result.Value.(*parser.ProgramNode).Statements.Body[0].(*parser.DefNode)
+	if def.Defkeywordloc != nil {
+		t.Fatalf("expected the location fields to be cleared")
+	}
+	def.Body.(*parser.StatementsNode).Body[1].(*parser.CallNode).Name = "qux"
+
+	unparsed, err = u.Unparse(result.Value)
+	if err != nil {
+		t.Fatalf("failed to unparse: %s", err)
+	}
+
+	expected := "def foo(a,b)\n  bar( a )\n  qux(a, b)\nend\n"
+	if unparsed != expected {
+		t.Errorf("expected %q, got %q", expected, unparsed)
+	}
+}
+
+func TestUnparseConstructedNodes(t *testing.T) {
+	// 1 + x
+	call := parser.NewCallNode(
+		0,
+		parser.NewIntegerNode(parser.INTEGER_BASE_DECIMAL, big.NewInt(1), nil),
+		nil,
+		"+",
+		nil,
+		nil,
+		parser.NewArgumentsNode(0, []parser.Node{parser.NewLocalVariableReadNode("x", 0, nil)}, nil),
+		nil,
+		nil,
+		nil,
+	)
+
+	// (1 + x).abs
+	abs := parser.NewCallNode(0, call, nil, "abs", nil, nil, nil, nil, nil, nil)
+
+	unparsed, err := unparser.Unparse(abs)
+	if err != nil {
+		t.Fatalf("failed to unparse: %s", err)
+	}
+
+	if unparsed != "(1 + x).abs" {
+		t.Errorf("expected (1 + x).abs, got %q", unparsed)
+	}
+}
+
+func TestUnparseMissingNode(t *testing.T) {
+	program := parser.NewProgramNode(nil, parser.NewStatementsNode([]parser.Node{parser.NewMissingNode(nil)}, nil), nil)
+
+	if _, err := unparser.Unparse(program); !errors.Is(err, unparser.ErrMissingNode) {
+		t.Errorf("expected ErrMissingNode, got %v", err)
+	}
+}
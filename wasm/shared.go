@@ -0,0 +1,102 @@
+package wasm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/tetratelabs/wazero"
+)
+
+var shared struct {
+	mu      sync.Mutex
+	engines map[engineKey]*Engine
+}
+
+// memoryCache keeps the embedded build compiled for the life of the process,
+// whatever the other options of the engines compiling it. Compiled code stays
+// in the cache after the engines are closed, which is why other binaries don't
+// go through it.
+var memoryCache = sync.OnceValue(wazero.NewCompilationCache)
+
+// compilationCache returns the cache an engine compiles with, nil for none,
+// and whether the engine owns it and closes it along with itself.
+func compilationCache(options *engineOptions) (wazero.CompilationCache, bool, error) {
+	if options.cacheDir != "" {
+		cache, err := wazero.NewCompilationCacheWithDir(options.cacheDir)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to open the compilation cache: %w", err)
+		}
+
+		return cache, true, nil
+	}
+
+	if options.binary == nil {
+		return memoryCache(), false, nil
+	}
+
+	return nil, false, nil
+}
+
+// defaultEngineKey identifies the engine of the default options, which is
+// kept for the life of the process once created.
+var defaultEngineKey = sync.OnceValue(func() engineKey {
+	options, _ := newEngineOptionsFrom(nil)
+	return options.key()
+})
+
+// SharedEngine returns the engine of the process for opts, creating it on
+// first use, so that instantiating prism after the first time costs no
+// compilation. Every call must be matched by a call to Close once the caller
+// is done with the engine and its instances. The engine is closed when its
+// last user closes it, except for the engine of the default options which is
+// kept for the life of the process.
+func SharedEngine(ctx context.Context, opts ...EngineOption) (*Engine, error) {
+	options, err := newEngineOptionsFrom(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	key := options.key()
+
+	// creating the engine under the lock makes concurrent callers wait for
+	// a single compilation
+	shared.mu.Lock()
+	defer shared.mu.Unlock()
+
+	if engine, ok := shared.engines[key]; ok {
+		engine.refs++
+		return engine, nil
+	}
+
+	// the engine outlives the call that happens to create it
+	engine, err := newEngine(context.WithoutCancel(ctx), options)
+	if err != nil {
+		return nil, err
+	}
+	engine.shared = true
+	engine.key = key
+	engine.refs = 1
+
+	if shared.engines == nil {
+		shared.engines = make(map[engineKey]*Engine)
+	}
+	shared.engines[key] = engine
+
+	return engine, nil
+}
+
+// release drops a reference to the shared engine e and reports whether it
+// was the last one, in which case e must be closed.
+func (e *Engine) release() bool {
+	shared.mu.Lock()
+	defer shared.mu.Unlock()
+
+	e.refs--
+	if e.refs > 0 || e.key == defaultEngineKey() {
+		return false
+	}
+
+	delete(shared.engines, e.key)
+	return true
+}
@@ -0,0 +1,140 @@
+package wasm
+
+import (
+	"bytes"
+	"context"
+	"testing"
+)
+
+func sharedEngine(t *testing.T, opts ...EngineOption) *Engine {
+	t.Helper()
+
+	engine, err := SharedEngine(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("failed to create engine: %s", err)
+	}
+
+	return engine
+}
+
+func isShared(engine *Engine) bool {
+	shared.mu.Lock()
+	defer shared.mu.Unlock()
+
+	return shared.engines[engine.key] == engine
+}
+
+func TestSharedEngineReleasedByLastUser(t *testing.T) {
+	ctx := context.Background()
+
+	first := sharedEngine(t, WithMemoryLimitPages(1000))
+	second := sharedEngine(t, WithMemoryLimitPages(1000))
+	if first != second {
+		t.Fatalf("expected engines with the same options to be shared")
+	}
+
+	if err := first.Close(ctx); err != nil {
+		t.Fatalf("failed to close engine: %s", err)
+	}
+
+	if !isShared(second) {
+		t.Fatalf("expected the engine to be kept for its other user")
+	}
+
+	runtime, err := second.Instantiate(ctx)
+	if err != nil {
+		t.Fatalf("failed to instantiate the engine after a user closed it: %s", err)
+	}
+	runtime.Close(ctx)
+
+	if err := second.Close(ctx); err != nil {
+		t.Fatalf("failed to close engine: %s", err)
+	}
+
+	if isShared(second) {
+		t.Errorf("expected the engine to be released by its last user")
+	}
+
+	third := sharedEngine(t, WithMemoryLimitPages(1000))
+	defer third.Close(ctx)
+
+	if third == first {
+		t.Errorf("expected a closed engine to be replaced")
+	}
+}
+
+func TestSharedEngineOfDefaultOptionsIsKept(t *testing.T) {
+	ctx := context.Background()
+
+	engine := sharedEngine(t)
+	if err := engine.Close(ctx); err != nil {
+		t.Fatalf("failed to close engine: %s", err)
+	}
+
+	if !isShared(engine) {
+		t.Errorf("expected the engine of the default options to be kept")
+	}
+
+	again := sharedEngine(t)
+	defer again.Close(ctx)
+
+	if again != engine {
+		t.Errorf("expected the engine of the default options to be reused")
+	}
+}
+
+func TestRuntimeReleasesItsEngine(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	runtime, err := NewRuntime(ctx, WithCompilationCacheDir(dir))
+	if err != nil {
+		t.Fatalf("failed to create runtime: %s", err)
+	}
+
+	engine := runtime.engine
+	if engine.cache == nil {
+		t.Errorf("expected the engine to own the cache of its directory")
+	}
+
+	// the engine is kept while the runtime is open
+	if err := runtime.Reinstantiate(ctx); err != nil {
+		t.Fatalf("failed to reinstantiate: %s", err)
+	}
+
+	if err := runtime.Close(ctx); err != nil {
+		t.Fatalf("failed to close runtime: %s", err)
+	}
+
+	// closing twice releases the engine once
+	_ = runtime.Close(ctx)
+
+	if isShared(engine) {
+		t.Errorf("expected closing the runtime to release its engine")
+	}
+}
+
+func TestSharedEngineOfBinaryReleased(t *testing.T) {
+	ctx := context.Background()
+
+	// a build of its own, as far as the hash tells
+	binary := append(bytes.Clone(prismWasm), 0x00, 0x06, 0x04, 't', 'e', 's', 't', 0x00)
+
+	engine := sharedEngine(t, WithWasmBinary(binary))
+	if engine.BinaryHash() == prismWasmHash() {
+		t.Fatalf("expected the engine to run the given binary")
+	}
+
+	// the cache of the embedded build would keep the compiled code
+	if cache, _, err := compilationCache(&engineOptions{binary: binary}); cache != nil || err != nil {
+		t.Errorf("expected no compilation cache, got %v, %v", cache, err)
+	}
+
+	if err := engine.Close(ctx); err != nil {
+		t.Fatalf("failed to close engine: %s", err)
+	}
+
+	if isShared(engine) {
+		t.Errorf("expected the engine of a binary to be released by its last user")
+	}
+}
@@ -1,18 +1,51 @@
 package wasm
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	_ "embed"
+	"errors"
 	"fmt"
+	"os"
+	"sync"
 
 	"github.com/tetratelabs/wazero"
 	"github.com/tetratelabs/wazero/api"
 	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"github.com/tetratelabs/wazero/sys"
 )
 
 //go:embed prism.wasm
 var prismWasm []byte
 
+var prismWasmHash = sync.OnceValue(func() [sha256.Size]byte {
+	return sha256.Sum256(prismWasm)
+})
+
+// requiredExports are the functions a prism binary must export.
+var requiredExports = []string{
+	"calloc",
+	"free",
+	"pm_version",
+	"pm_serialize_parse",
+	"pm_serialize_lex",
+	"pm_buffer_init",
+	"pm_buffer_sizeof",
+	"pm_buffer_value",
+	"pm_buffer_length",
+	"pm_buffer_free",
+	"pm_prettyprint",
+}
+
+// maxVersionLength bounds the version string read from a prism binary.
+const maxVersionLength = 32
+
+// ErrAborted is wrapped by the errors of calls that closed the module, like
+// calls cut short when their context is done. An aborted runtime must be
+// reinstantiated before it is used again.
+var ErrAborted = errors.New("wasm module aborted")
+
 type Memory struct {
 	mem api.Memory
 }
@@ -29,6 +62,11 @@ func (m *Memory) Read(offset uint64, byteCount uint64) ([]byte, bool) {
 	return m.mem.Read(uint32(offset), uint32(byteCount))
 }
 
+// Size returns the size of the memory in bytes. Linear memory only grows.
+func (m *Memory) Size() uint64 {
+	return uint64(m.mem.Size())
+}
+
 type ModFunc struct {
 	fn api.Function
 }
@@ -39,6 +77,12 @@ func NewModFunc(mod api.Module, name string) *ModFunc {
 
 func (f *ModFunc) Call(ctx context.Context, params ...uint64) (uint64, error) {
 	result, err := f.fn.Call(ctx, params...)
+
+	var exitErr *sys.ExitError
+	if errors.As(err, &exitErr) {
+		return 0, fmt.Errorf("%w: %w", ErrAborted, err)
+	}
+
 	if err != nil {
 		return 0, fmt.Errorf("failed to call the wasm func: %w", err)
 	}
@@ -50,47 +94,340 @@ func (f *ModFunc) Call(ctx context.Context, params ...uint64) (uint64, error) {
 	}
 }
 
+type Engine struct {
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+	// cache is the compilation cache the engine closes along with itself
+	cache wazero.CompilationCache
+	// shared engines are closed by their last user, see SharedEngine
+	shared bool
+	key    engineKey
+	// refs counts the users of a shared engine, guarded by shared.mu
+	refs int
+	// binaryHash is the SHA-256 of the wasm binary
+	binaryHash [sha256.Size]byte
+
+	versionOnce sync.Once
+	version     string
+	versionErr  error
+}
+
+// maxMemoryPages is the most pages a 32 bit linear memory can have.
+const maxMemoryPages = 65536
+
+// PageSize is the size of a page of linear memory.
+const PageSize = 65536
+
+type EngineOption func(*engineOptions) error
+
+type engineOptions struct {
+	memoryLimitPages uint32
+	cacheDir         string
+	binary           []byte
+}
+
+// engineKey identifies the engines that can be shared.
+type engineKey struct {
+	memoryLimitPages uint32
+	cacheDir         string
+	binary           [sha256.Size]byte
+}
+
+func newEngineOptionsFrom(opts []EngineOption) (*engineOptions, error) {
+	options := &engineOptions{memoryLimitPages: maxMemoryPages}
+	for _, opt := range opts {
+		if err := opt(options); err != nil {
+			return nil, fmt.Errorf("invalid engine option: %w", err)
+		}
+	}
+
+	return options, nil
+}
+
+func (o *engineOptions) key() engineKey {
+	return engineKey{memoryLimitPages: o.memoryLimitPages, cacheDir: o.cacheDir, binary: o.binaryHash()}
+}
+
+func (o *engineOptions) binaryHash() [sha256.Size]byte {
+	if o.binary == nil {
+		return prismWasmHash()
+	}
+
+	return sha256.Sum256(o.binary)
+}
+
+// WithWasmBinary runs binary instead of the prism build embedded in this
+// package. It must be prism compiled for WASI, exporting the functions of
+// the embedded build. This package doesn't check its prism version, the
+// parser package only supports builds of the embedded version.
+func WithWasmBinary(binary []byte) EngineOption {
+	return func(o *engineOptions) error {
+		if len(binary) == 0 {
+			return errors.New("empty wasm binary")
+		}
+
+		o.binary = binary
+		return nil
+	}
+}
+
+// WithWasmFile runs the prism build in the file at path, like WithWasmBinary.
+func WithWasmFile(path string) EngineOption {
+	return func(o *engineOptions) error {
+		binary, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read the wasm binary: %w", err)
+		}
+
+		return WithWasmBinary(binary)(o)
+	}
+}
+
+// WithMemoryLimitPages caps the linear memory of every instance of the engine
+// at pages of PageSize bytes. Allocations past the cap fail inside prism.
+func WithMemoryLimitPages(pages uint32) EngineOption {
+	return func(o *engineOptions) error {
+		if pages == 0 || pages > maxMemoryPages {
+			return fmt.Errorf("invalid memory limit: %d pages", pages)
+		}
+
+		o.memoryLimitPages = pages
+		return nil
+	}
+}
+
+// WithCompilationCacheDir keeps the machine code prism compiles to in dir, so
+// that later processes load it instead of compiling prism again.
+func WithCompilationCacheDir(dir string) EngineOption {
+	return func(o *engineOptions) error {
+		if dir == "" {
+			return errors.New("empty compilation cache directory")
+		}
+
+		o.cacheDir = dir
+		return nil
+	}
+}
+
+// NewEngine returns an engine of its own, which the caller must close. Calls
+// into instances of the engine are aborted as soon as their context is done,
+// closing the instance. The embedded build is only compiled the first time an
+// engine is created in the process, see WithCompilationCacheDir to skip that
+// as well and to reuse the compiled code of other binaries.
+func NewEngine(ctx context.Context, opts ...EngineOption) (*Engine, error) {
+	options, err := newEngineOptionsFrom(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newEngine(ctx, options)
+}
+
+func newEngine(ctx context.Context, options *engineOptions) (*Engine, error) {
+	cache, ownsCache, err := compilationCache(options)
+	if err != nil {
+		return nil, err
+	}
+
+	config := wazero.NewRuntimeConfig().
+		WithCloseOnContextDone(true).
+		WithMemoryLimitPages(options.memoryLimitPages)
+	if cache != nil {
+		config = config.WithCompilationCache(cache)
+	}
+	runtime := wazero.NewRuntimeWithConfig(ctx, config)
+
+	// closes what was created so far when the engine can't be
+	fail := func(err error) (*Engine, error) {
+		_ = runtime.Close(ctx)
+		if ownsCache {
+			_ = cache.Close(ctx)
+		}
+
+		return nil, err
+	}
+
+	wasi_snapshot_preview1.MustInstantiate(ctx, runtime)
+	binary := options.binary
+	if binary == nil {
+		binary = prismWasm
+	}
+
+	compiled, err := runtime.CompileModule(ctx, binary)
+	if err != nil {
+		return fail(fmt.Errorf("failed to compile prism: %w", err))
+	}
+
+	exports := compiled.ExportedFunctions()
+	for _, name := range requiredExports {
+		if _, ok := exports[name]; !ok {
+			return fail(fmt.Errorf("not a prism binary: %s is not exported", name))
+		}
+	}
+
+	engine := &Engine{
+		runtime:    runtime,
+		compiled:   compiled,
+		binaryHash: options.binaryHash(),
+	}
+	if ownsCache {
+		engine.cache = cache
+	}
+
+	return engine, nil
+}
+
+// Instantiate creates a new prism module instance with its own linear memory.
+// Instances share the compiled code of the engine and must be closed before
+// the engine.
+func (e *Engine) Instantiate(ctx context.Context) (*Runtime, error) {
+	mod, err := e.instantiateModule(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	runtime := newRuntime(mod)
+	runtime.engine = e
+	return runtime, nil
+}
+
+func (e *Engine) instantiateModule(ctx context.Context) (api.Module, error) {
+	// modules are anonymous so the same compiled module can be instantiated
+	// more than once in the same runtime
+	mod, err := e.runtime.InstantiateModule(ctx, e.compiled, wazero.NewModuleConfig().WithName(""))
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate prism: %w", err)
+	}
+
+	return mod, nil
+}
+
+// BinaryHash returns the SHA-256 of the wasm binary the engine runs, which
+// tells apart builds reporting the same prism version.
+func (e *Engine) BinaryHash() [sha256.Size]byte {
+	return e.binaryHash
+}
+
+// Version returns the version of prism compiled into the engine.
+func (e *Engine) Version(ctx context.Context) (string, error) {
+	e.versionOnce.Do(func() {
+		// remembered for every caller, so not tied to this one's context
+		ctx := context.WithoutCancel(ctx)
+		runtime, err := e.Instantiate(ctx)
+		if err != nil {
+			e.versionErr = err
+			return
+		}
+		defer runtime.Close(ctx)
+
+		e.version, e.versionErr = runtime.Version(ctx)
+	})
+
+	return e.version, e.versionErr
+}
+
+// Close closes the engine and frees its compiled code. A shared engine is
+// only closed by its last user, see SharedEngine.
+func (e *Engine) Close(ctx context.Context) error {
+	if e.shared && !e.release() {
+		return nil
+	}
+
+	if err := e.runtime.Close(ctx); err != nil {
+		return fmt.Errorf("failed to close the runtime: %w", err)
+	}
+
+	if e.cache != nil {
+		if err := e.cache.Close(ctx); err != nil {
+			return fmt.Errorf("failed to close the compilation cache: %w", err)
+		}
+	}
+
+	return nil
+}
+
 type Runtime struct {
-	runtime             wazero.Runtime
+	engine *Engine
+	// releaseEngine is set when closing the runtime releases its engine
+	releaseEngine       bool
+	mod                 api.Module
 	mem                 *Memory
 	modCalloc           *ModFunc
 	modFree             *ModFunc
 	modPmSerializeParse *ModFunc
+	modPmSerializeLex   *ModFunc
 	modPmBufferInit     *ModFunc
 	modPmBufferSizeof   *ModFunc
 	modPmBufferValue    *ModFunc
 	modPmBufferLength   *ModFunc
 	modPmBufferFree     *ModFunc
 	modPmPrettyPrint    *ModFunc
+	modPmVersion        *ModFunc
 }
 
-func NewRuntime(ctx context.Context) (*Runtime, error) {
-	runtime := wazero.NewRuntime(ctx)
+// NewRuntime instantiates prism on the shared engine for opts. Closing the
+// runtime releases the engine.
+func NewRuntime(ctx context.Context, opts ...EngineOption) (*Runtime, error) {
+	engine, err := SharedEngine(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
 
-	wasi_snapshot_preview1.MustInstantiate(ctx, runtime)
-	mod, err := runtime.Instantiate(ctx, prismWasm)
+	runtime, err := engine.Instantiate(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to instantiate prism: %w", err)
+		_ = engine.Close(ctx)
+		return nil, err
 	}
+	runtime.releaseEngine = true
 
+	return runtime, nil
+}
+
+func newRuntime(mod api.Module) *Runtime {
 	return &Runtime{
-		runtime:             runtime,
+		mod:                 mod,
 		mem:                 NewMemory(mod.Memory()),
 		modCalloc:           NewModFunc(mod, "calloc"),
 		modFree:             NewModFunc(mod, "free"),
 		modPmSerializeParse: NewModFunc(mod, "pm_serialize_parse"),
+		modPmSerializeLex:   NewModFunc(mod, "pm_serialize_lex"),
 		modPmBufferInit:     NewModFunc(mod, "pm_buffer_init"),
 		modPmBufferSizeof:   NewModFunc(mod, "pm_buffer_sizeof"),
 		modPmBufferValue:    NewModFunc(mod, "pm_buffer_value"),
 		modPmBufferLength:   NewModFunc(mod, "pm_buffer_length"),
 		modPmBufferFree:     NewModFunc(mod, "pm_buffer_free"),
 		modPmPrettyPrint:    NewModFunc(mod, "pm_prettyprint"),
-	}, nil
+		modPmVersion:        NewModFunc(mod, "pm_version"),
+	}
+}
+
+// Reinstantiate replaces the module of the runtime with a fresh instance of
+// the same engine, which recovers a runtime from ErrAborted.
+func (r *Runtime) Reinstantiate(ctx context.Context) error {
+	// closing a module that was aborted is a no-op
+	_ = r.mod.Close(ctx)
+
+	mod, err := r.engine.instantiateModule(ctx)
+	if err != nil {
+		return err
+	}
+
+	engine, releaseEngine := r.engine, r.releaseEngine
+	*r = *newRuntime(mod)
+	r.engine, r.releaseEngine = engine, releaseEngine
+
+	return nil
 }
 
 func (r *Runtime) Close(ctx context.Context) error {
-	if err := r.runtime.Close(ctx); err != nil {
-		return fmt.Errorf("failed to close the runtime: %w", err)
+	if err := r.mod.Close(ctx); err != nil {
+		return fmt.Errorf("failed to close the module: %w", err)
+	}
+
+	if r.releaseEngine {
+		// closing twice must not release the engine twice
+		r.releaseEngine = false
+		return r.engine.Close(ctx)
 	}
 
 	return nil
@@ -136,6 +473,35 @@ func (r *Runtime) SerializeParse(ctx context.Context, bufferPtr, sourcePtr, sour
 	return r.modPmSerializeParse.Call(ctx, bufferPtr, sourcePtr, sourceLen, optPtr)
 }
 
+func (r *Runtime) SerializeLex(ctx context.Context, bufferPtr, sourcePtr, sourceLen, optPtr uint64) (uint64, error) {
+	return r.modPmSerializeLex.Call(ctx, bufferPtr, sourcePtr, sourceLen, optPtr)
+}
+
+// BinaryHash returns the SHA-256 of the wasm binary the runtime runs.
+func (r *Runtime) BinaryHash() [sha256.Size]byte {
+	return r.engine.binaryHash
+}
+
+// Version returns the version of prism, as reported by pm_version.
+func (r *Runtime) Version(ctx context.Context) (string, error) {
+	ptr, err := r.modPmVersion.Call(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	version, ok := r.MemoryRead(ptr, min(maxVersionLength, r.MemorySize()-ptr))
+	if !ok {
+		return "", fmt.Errorf("failed to read the version at %d", ptr)
+	}
+
+	end := bytes.IndexByte(version, 0)
+	if end < 0 {
+		return "", errors.New("version is not terminated")
+	}
+
+	return string(version[:end]), nil
+}
+
 func (r *Runtime) MemoryWrite(ptr uint64, data []byte) bool {
 	return r.mem.Write(ptr, data)
 }
@@ -143,3 +509,8 @@ func (r *Runtime) MemoryWrite(ptr uint64, data []byte) bool {
 func (r *Runtime) MemoryRead(offset uint64, byteCount uint64) ([]byte, bool) {
 	return r.mem.Read(offset, byteCount)
 }
+
+// MemorySize returns the size of the linear memory of the instance in bytes.
+func (r *Runtime) MemorySize() uint64 {
+	return r.mem.Size()
+}